@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"songs/internal/app/config"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/pgrepo"
+	"songs/internal/app/service"
+	pg "songs/internal/pkg"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// runAPIKey manages API keys, e.g. to issue the first admin key of a deployment
+func runAPIKey(cfg config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: apikey create -name <name> -role reader|editor|admin | apikey list | apikey revoke <id>")
+	}
+
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+	keys := service.NewAPIKeyService(pgrepo.NewAPIKeyRepo(pgDB))
+	ctx := context.Background()
+
+	switch args[0] {
+	case "create":
+		fs := flag.NewFlagSet("apikey create", flag.ContinueOnError)
+		name := fs.String("name", "", "name of the key owner")
+		role := fs.String("role", string(domain.RoleReader), "role: reader, editor or admin")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		key, secret, err := keys.IssueKey(ctx, *name, domain.Role(*role))
+		if err != nil {
+			return fmt.Errorf("issue key: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "Issued key %d (%s) with role %s. Store it now, it is not shown again:\n", key.ID, key.Name, key.Role)
+		fmt.Println(secret)
+		return nil
+	case "list":
+		list, err := keys.ListKeys(ctx)
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tNAME\tPREFIX\tROLE\tCREATED\tREVOKED")
+		for _, key := range list {
+			revoked := "-"
+			if key.RevokedAt != nil {
+				revoked = key.RevokedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", key.ID, key.Name, key.Prefix, key.Role, key.CreatedAt.Format(time.RFC3339), revoked)
+		}
+		return tw.Flush()
+	case "revoke":
+		if len(args) != 2 {
+			return errors.New("usage: apikey revoke <id>")
+		}
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid key id %q", args[1])
+		}
+		if err := keys.RevokeKey(ctx, id); err != nil {
+			return fmt.Errorf("revoke key %d: %w", id, err)
+		}
+		fmt.Fprintf(os.Stderr, "Revoked key %d\n", id)
+		return nil
+	default:
+		return fmt.Errorf("unknown apikey command %q", args[0])
+	}
+}
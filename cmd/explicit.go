@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"songs/internal/app/config"
+	"songs/internal/app/repository/pgrepo"
+	"songs/internal/app/service"
+	pg "songs/internal/pkg"
+	"songs/internal/pkg/explicit"
+)
+
+func runScanExplicit(cfg config.Config, args []string) error {
+	fs := flag.NewFlagSet("scan-explicit", flag.ContinueOnError)
+	batchSize := fs.Int("batch", 500, "songs read at once")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *batchSize <= 0 {
+		return fmt.Errorf("-batch must be positive")
+	}
+
+	explicitScanner, err := explicit.Load(cfg.ExplicitWordlists)
+	if err != nil {
+		return fmt.Errorf("load explicit wordlists: %w", err)
+	}
+
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+
+	songService := service.NewAuditedSongService(
+		service.NewSongService(pgrepo.NewSongRepo(pgDB), nil, explicitScanner, nil),
+		service.NewAuditService(pgrepo.NewAuditRepo(pgDB), cfg.AuditRetention),
+		pgrepo.NewTxManager(pgDB),
+	)
+	changed, err := songService.ScanExplicit(context.Background(), *batchSize)
+	if err != nil {
+		return fmt.Errorf("explicit scan failed after %d songs: %w", changed, err)
+	}
+
+	log.Printf("Explicit scan completed: %d songs changed", changed)
+	return nil
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"songs/internal/app/config"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/pgrepo"
+	"songs/internal/app/service"
+	pg "songs/internal/pkg"
+	"songs/internal/pkg/explicit"
+	"songs/internal/pkg/langdetect"
+	"strings"
+	"time"
+)
+
+func runImport(cfg config.Config, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	file := fs.String("file", "-", "file to read, - for stdin")
+	format := fs.String("format", "", "payload format: csv or ndjson (detected from the file extension when omitted)")
+	dryRun := fs.Bool("dry-run", false, "validate rows without writing")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	importFormat, err := resolveImportFormat(*format, *file)
+	if err != nil {
+		return err
+	}
+
+	in, closeFn, err := openInput(*file)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+
+	explicitScanner, err := explicit.Load(cfg.ExplicitWordlists)
+	if err != nil {
+		return fmt.Errorf("load explicit wordlists: %w", err)
+	}
+
+	songRepo := pgrepo.NewSongRepo(pgDB)
+	songService := service.NewSongService(songRepo, langdetect.New(), explicitScanner, nil)
+	auditService := service.NewAuditService(pgrepo.NewAuditRepo(pgDB), cfg.AuditRetention)
+	importService := service.NewImportService(songRepo, pgrepo.NewGroupRepo(pgDB), songService, auditService, pgrepo.NewTxManager(pgDB))
+	report, err := importService.Import(context.Background(), in, importFormat, *dryRun)
+	if err != nil {
+		return fmt.Errorf("import failed: %w", err)
+	}
+
+	for _, row := range report.Rows {
+		if row.Status != domain.ImportRowCreated {
+			log.Printf("row %d: %s: %s (%s)", row.Row, row.Status, row.Message, row.Slug)
+		}
+	}
+	log.Printf("Import completed (dry run: %t): %d created, %d skipped, %d failed",
+		report.DryRun, report.Created, report.Skipped, report.Failed)
+	if report.Failed > 0 {
+		return fmt.Errorf("%d rows failed to import", report.Failed)
+	}
+	return nil
+}
+
+func resolveImportFormat(format, path string) (domain.ImportFormat, error) {
+	if format == "" {
+		format = "ndjson"
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			format = "csv"
+		}
+	}
+
+	switch strings.ToLower(format) {
+	case "csv":
+		return domain.ImportFormatCSV, nil
+	case "ndjson", "jsonl":
+		return domain.ImportFormatNDJSON, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func runExport(cfg config.Config, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	file := fs.String("file", "-", "file to write, - for stdout")
+	format := fs.String("format", "", "output format: csv or ndjson (detected from the file extension when omitted)")
+	groupID := fs.String("group-id", "", "only export songs of this group")
+	title := fs.String("title", "", "only export songs whose title contains this text")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	exportFormat, err := resolveImportFormat(*format, *file)
+	if err != nil {
+		return err
+	}
+
+	out, closeFn, err := openOutput(*file)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+
+	groupRepo := pgrepo.NewGroupRepo(pgDB)
+	songService := service.NewSongService(pgrepo.NewSongRepo(pgDB), nil, nil, nil)
+
+	filter := make(map[string]string)
+	if *groupID != "" {
+		filter["group_id"] = *groupID
+	}
+	if *title != "" {
+		filter["title"] = *title
+	}
+
+	// Rows are written in the import format, so an export can be imported back
+	w := bufio.NewWriter(out)
+	writeRecord := newRecordWriter(w, exportFormat)
+
+	ctx := context.Background()
+	groupNames := make(map[int]string)
+	exported := 0
+	err = songService.ExportSongs(ctx, filter, func(song *domain.Song) error {
+		name, ok := groupNames[song.GroupID]
+		if !ok {
+			group, err := groupRepo.GetGroup(ctx, song.GroupID)
+			if err != nil {
+				return fmt.Errorf("group %d: %w", song.GroupID, err)
+			}
+			name = group.Name
+			groupNames[song.GroupID] = name
+		}
+
+		exported++
+		return writeRecord(songRecord{
+			Group:       name,
+			Title:       song.Title,
+			ReleaseDate: song.ReleaseDate.Format(time.RFC3339),
+			Text:        song.Text,
+			Link:        song.Link,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+	if err := writeRecord(songRecord{}); err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("write output: %w", err)
+	}
+
+	log.Printf("Export completed: %d songs", exported)
+	return nil
+}
+
+// newRecordWriter returns a function writing records in the given format.
+// Calling it with a zero record flushes any pending output.
+func newRecordWriter(w io.Writer, format domain.ImportFormat) func(songRecord) error {
+	if format == domain.ImportFormatCSV {
+		cw := csv.NewWriter(w)
+		headerWritten := false
+		return func(rec songRecord) error {
+			if !headerWritten {
+				headerWritten = true
+				if err := cw.Write([]string{"group", "title", "release_date", "text", "link"}); err != nil {
+					return err
+				}
+			}
+			if rec == (songRecord{}) {
+				cw.Flush()
+				return cw.Error()
+			}
+			return cw.Write([]string{rec.Group, rec.Title, rec.ReleaseDate, rec.Text, rec.Link})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	return func(rec songRecord) error {
+		if rec == (songRecord{}) {
+			return nil
+		}
+		return enc.Encode(rec)
+	}
+}
+
+func openInput(path string) (io.Reader, func(), error) {
+	if path == "-" {
+		return os.Stdin, func() {}, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open input: %w", err)
+	}
+	return f, func() { _ = f.Close() }, nil
+}
+
+func openOutput(path string) (io.Writer, func(), error) {
+	if path == "-" {
+		return os.Stdout, func() {}, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create output: %w", err)
+	}
+	return f, func() { _ = f.Close() }, nil
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"songs/internal/app/config"
+	"songs/internal/app/repository/pgrepo"
+	"songs/internal/app/service"
+	pg "songs/internal/pkg"
+	"songs/internal/pkg/langdetect"
+)
+
+func runDetectLanguages(cfg config.Config, args []string) error {
+	fs := flag.NewFlagSet("detect-languages", flag.ContinueOnError)
+	all := fs.Bool("all", false, "detect again the songs that already have a language")
+	batchSize := fs.Int("batch", 500, "songs read at once")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *batchSize <= 0 {
+		return fmt.Errorf("-batch must be positive")
+	}
+
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+
+	songService := service.NewAuditedSongService(
+		service.NewSongService(pgrepo.NewSongRepo(pgDB), langdetect.New(), nil, nil),
+		service.NewAuditService(pgrepo.NewAuditRepo(pgDB), cfg.AuditRetention),
+		pgrepo.NewTxManager(pgDB),
+	)
+	updated, err := songService.DetectLanguages(context.Background(), *all, *batchSize)
+	if err != nil {
+		return fmt.Errorf("language detection failed after %d songs: %w", updated, err)
+	}
+
+	log.Printf("Language detection completed: %d songs updated", updated)
+	return nil
+}
@@ -1,112 +1,65 @@
 package main
 
 import (
-	"errors"
 	"fmt"
-	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"log"
 	"os"
-	"os/signal"
 	"songs/internal/app/config"
-	"songs/internal/app/repository/pgrepo"
-	"songs/internal/app/service"
-	"songs/internal/app/transport/grpc"
-	"songs/internal/app/transport/http"
-	pg "songs/internal/pkg"
-	"sync"
-	"syscall"
 )
 
+// command is a single CLI subcommand
+type command struct {
+	name  string
+	usage string
+	run   func(cfg config.Config, args []string) error
+}
+
+var commands = []command{
+	{name: "serve", usage: "run HTTP and gRPC servers (default)", run: runServe},
+	{name: "migrate", usage: "manage schema: migrate up|down|status|force <version>|goto <version>", run: runMigrate},
+	{name: "seed", usage: "load sample groups and songs", run: runSeed},
+	{name: "import", usage: "import songs from CSV or NDJSON: import [-file path] [-format csv|ndjson] [-dry-run]", run: runImport},
+	{name: "export", usage: "export songs as CSV or NDJSON: export [-file path] [-format csv|ndjson] [-group-id id] [-title text]", run: runExport},
+	{name: "apikey", usage: "manage API keys: apikey create -name <name> -role reader|editor|admin | list | revoke <id>", run: runAPIKey},
+	{name: "detect-languages", usage: "detect the language of songs without one: detect-languages [-all] [-batch n]", run: runDetectLanguages},
+	{name: "scan-explicit", usage: "flag explicit songs again with the current wordlists: scan-explicit [-batch n]", run: runScanExplicit},
+	{name: "verify", usage: "check database connectivity and schema version", run: runVerify},
+}
+
 func main() {
-	if err := run(); err != nil {
+	if err := run(os.Args[1:]); err != nil {
 		log.Fatal(err)
 	}
 	os.Exit(0)
 }
 
-func run() error {
+func run(args []string) error {
 	// read config
 	cfg := config.Read()
 
-	pgDB, err := pg.Dial(cfg.DSN)
-	if err != nil {
-		return fmt.Errorf("pg.Dial failed: %w", err)
+	name := "serve"
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
 	}
 
-	if pgDB != nil {
-		if err := runPgMigrations(cfg.MigrationsPath, cfg.DSN); err != nil {
-			return fmt.Errorf("runPgMigrations failed: %w", err)
-		}
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage()
+		return nil
 	}
 
-	// Initialize repo
-	songRepo := pgrepo.NewSongRepo(pgDB)
-	// Initialize the song service
-	songService := service.NewSongService(songRepo)
-
-	// Create servers
-	httpServer := http.NewServer(cfg.HTTPAddr, songService)
-	grpcServer := grpc.NewServer(cfg.GRPCAddr, songService)
-
-	// Channel for graceful shutdown
-	shutdown := make(chan os.Signal, 1)
-	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
-
-	// WaitGroup for tracking running servers
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
-
-	// Start HTTP server
-	go func() {
-		defer wg.Done()
-		if err := httpServer.Run(); err != nil {
-			log.Printf("HTTP server error: %v", err)
-		}
-	}()
-
-	// Start gRPC server
-	go func() {
-		defer wg.Done()
-		if err := grpcServer.Run(); err != nil {
-			log.Printf("gRPC server error: %v", err)
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd.run(cfg, args)
 		}
-	}()
-
-	// Wait for shutdown signal
-	<-shutdown
-	log.Println("Shutting down servers...")
-
-	// Graceful shutdown
-	//httpServer.Stop()
-	grpcServer.Stop()
-
-	wg.Wait()
-	log.Println("Servers stopped")
-
-	return nil
-}
-
-func runPgMigrations(path, dsn string) error {
-	if path == "" {
-		return errors.New("no migrations path provided")
-	}
-	if dsn == "" {
-		return errors.New("no DSN provided")
 	}
 
-	log.Println("Initializing migrations")
-	m, err := migrate.New(path, dsn)
-	if err != nil {
-		return err
-	}
+	printUsage()
+	return fmt.Errorf("unknown command %q", name)
+}
 
-	log.Println("Running migrations")
-	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-		return err
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [arguments]\n\nCommands:\n", os.Args[0])
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.name, cmd.usage)
 	}
-
-	log.Println("Migrations completed successfully")
-	return nil
 }
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"songs/internal/app/config"
+	"songs/internal/app/migrations"
+	"strconv"
+)
+
+func runMigrate(cfg config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down|status|force <version>|goto <version>")
+	}
+
+	m, err := migrations.New(cfg.MigrationsPath, cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("migrations.New failed: %w", err)
+	}
+	defer func() {
+		_ = m.Close()
+	}()
+
+	switch args[0] {
+	case "up":
+		err = m.Up()
+	case "down":
+		err = m.Down()
+	case "status":
+	case "force", "goto":
+		if len(args) < 2 {
+			return fmt.Errorf("migrate %s requires a version", args[0])
+		}
+		version, convErr := strconv.Atoi(args[1])
+		if convErr != nil || version < 0 {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		if args[0] == "force" {
+			err = m.Force(version)
+		} else {
+			err = m.Goto(uint(version))
+		}
+	default:
+		return fmt.Errorf("unknown migrate action %q", args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	return printMigrationStatus(m)
+}
+
+func printMigrationStatus(m *migrations.Migrator) error {
+	version, dirty, latest, err := m.Status()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Schema version: %d (dirty: %t, latest known: %d)", version, dirty, latest)
+	return nil
+}
+
+// runPgMigrations checks that the schema can be served by this binary and
+// optionally applies pending migrations
+func runPgMigrations(path, dsn string, apply bool) error {
+	log.Println("Initializing migrations")
+	m, err := migrations.New(path, dsn)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = m.Close()
+	}()
+
+	if err := m.CheckSchema(); err != nil {
+		return err
+	}
+	if !apply {
+		return nil
+	}
+
+	log.Println("Running migrations")
+	if err := m.Up(); err != nil {
+		return err
+	}
+
+	log.Println("Migrations completed successfully")
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"songs/internal/app/config"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/pgrepo"
+	"songs/internal/app/service"
+	pg "songs/internal/pkg"
+	"songs/internal/pkg/explicit"
+	"songs/internal/pkg/langdetect"
+)
+
+// seedSongs is the sample catalog loaded by the seed command
+var seedSongs = []songRecord{
+	{
+		Group:       "Muse",
+		Title:       "Supermassive Black Hole",
+		ReleaseDate: "2006-07-16T00:00:00Z",
+		Text:        "Ooh baby, don't you know I suffer?\nOoh baby, can you hear me moan?\n\nYou caught me under false pretenses\nHow long before you let me go?",
+		Link:        "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+	},
+	{
+		Group:       "Muse",
+		Title:       "Hysteria",
+		ReleaseDate: "2003-12-01T00:00:00Z",
+		Text:        "It's bugging me, grating me\nAnd twisting me around\n\nYeah, I'm endlessly caving in\nAnd turning inside out",
+		Link:        "https://www.youtube.com/watch?v=3dm_5qWWDV8",
+	},
+	{
+		Group:       "Queen",
+		Title:       "Bohemian Rhapsody",
+		ReleaseDate: "1975-10-31T00:00:00Z",
+		Text:        "Is this the real life?\nIs this just fantasy?\n\nCaught in a landslide\nNo escape from reality",
+		Link:        "https://www.youtube.com/watch?v=fJ9rUzIMcZQ",
+	},
+}
+
+// runSeed imports the sample catalog, skipping the songs already there, so
+// the seeded songs and groups are analyzed and audited like any import
+func runSeed(cfg config.Config, _ []string) error {
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+
+	explicitScanner, err := explicit.Load(cfg.ExplicitWordlists)
+	if err != nil {
+		return fmt.Errorf("load explicit wordlists: %w", err)
+	}
+	songRepo := pgrepo.NewSongRepo(pgDB)
+	songService := service.NewSongService(songRepo, langdetect.New(), explicitScanner, nil)
+	auditService := service.NewAuditService(pgrepo.NewAuditRepo(pgDB), cfg.AuditRetention)
+	importService := service.NewImportService(songRepo, pgrepo.NewGroupRepo(pgDB), songService, auditService, pgrepo.NewTxManager(pgDB))
+
+	var catalog bytes.Buffer
+	writeRecord := newRecordWriter(&catalog, domain.ImportFormatNDJSON)
+	for _, rec := range seedSongs {
+		if err := writeRecord(rec); err != nil {
+			return fmt.Errorf("seed song %q: %w", rec.Title, err)
+		}
+	}
+
+	report, err := importService.Import(context.Background(), &catalog, domain.ImportFormatNDJSON, false)
+	if err != nil {
+		return fmt.Errorf("seed failed: %w", err)
+	}
+	for _, row := range report.Rows {
+		if row.Status == domain.ImportRowFailed {
+			return fmt.Errorf("seed song %q: %s", seedSongs[row.Row-1].Title, row.Message)
+		}
+	}
+
+	log.Printf("Seed completed: %d songs created", report.Created)
+	return nil
+}
+
+// songRecord is the flat song representation used by seed, import and export
+type songRecord struct {
+	Group       string `json:"group"`
+	Title       string `json:"title"`
+	ReleaseDate string `json:"release_date"`
+	Text        string `json:"text"`
+	Link        string `json:"link"`
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	nethttp "net/http"
+	"os"
+	"os/signal"
+	"songs/internal/app/config"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/pgrepo"
+	"songs/internal/app/service"
+	"songs/internal/app/transport"
+	"songs/internal/app/transport/grpc"
+	"songs/internal/app/transport/http"
+	"songs/internal/app/transport/middleware"
+	pg "songs/internal/pkg"
+	"songs/internal/pkg/explicit"
+	"songs/internal/pkg/langdetect"
+	"songs/internal/pkg/shingle"
+	"songs/internal/pkg/similarity"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+func runServe(cfg config.Config, args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	migrateUp := fs.Bool("migrate", true, "apply pending migrations before serving")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+
+	if pgDB != nil {
+		if err := runPgMigrations(cfg.MigrationsPath, cfg.DSN, *migrateUp); err != nil {
+			return fmt.Errorf("runPgMigrations failed: %w", err)
+		}
+	}
+
+	// Initialize repos
+	songRepo := pgrepo.NewSongRepo(pgDB)
+	groupRepo := pgrepo.NewGroupRepo(pgDB)
+	txManager := pgrepo.NewTxManager(pgDB)
+	idempotencyRepo := pgrepo.NewIdempotencyRepo(pgDB)
+	apiKeyRepo := pgrepo.NewAPIKeyRepo(pgDB)
+	playlistRepo := pgrepo.NewPlaylistRepo(pgDB)
+	albumRepo := pgrepo.NewAlbumRepo(pgDB)
+	tagRepo := pgrepo.NewTagRepo(pgDB)
+	genreRepo := pgrepo.NewGenreRepo(pgDB)
+	relationRepo := pgrepo.NewRelationRepo(pgDB)
+	lyricsRepo := pgrepo.NewLyricsRepo(pgDB)
+	songEventRepo := pgrepo.NewSongEventRepo(pgDB)
+	outboxRepo := pgrepo.NewOutboxRepo(pgDB)
+	webhookRepo := pgrepo.NewWebhookRepo(pgDB)
+	auditRepo := pgrepo.NewAuditRepo(pgDB)
+	explicitScanner, err := explicit.Load(cfg.ExplicitWordlists)
+	if err != nil {
+		return fmt.Errorf("load explicit wordlists: %w", err)
+	}
+
+	// Initialize the services
+	nearDuplicateMode := domain.NearDuplicateMode(cfg.NearDuplicateMode)
+	if !nearDuplicateMode.Valid() {
+		return fmt.Errorf("NEAR_DUPLICATE_MODE must be off, warn or reject, got %q", cfg.NearDuplicateMode)
+	}
+	if cfg.NearDuplicateThreshold <= 0 || cfg.NearDuplicateThreshold > 1 {
+		return fmt.Errorf("NEAR_DUPLICATE_THRESHOLD must be above 0 and at most 1, got %v", cfg.NearDuplicateThreshold)
+	}
+	nearDuplicateService := service.NewNearDuplicateService(shingle.New(), songRepo, nearDuplicateMode, cfg.NearDuplicateThreshold)
+	similarityService := service.NewSimilarityService(similarity.New(), songRepo)
+	changeFeed := service.NewChangeFeedService(songEventRepo, cfg.SongEventsRetention)
+	auditService := service.NewAuditService(auditRepo, cfg.AuditRetention)
+	songService := service.NewAuditedSongService(
+		service.NewSongService(songRepo, langdetect.New(), explicitScanner, nearDuplicateService, changeFeed),
+		auditService,
+		txManager,
+	)
+	idempotencyService := service.NewIdempotencyService(idempotencyRepo, cfg.IdempotencyTTL)
+	webhookService := service.NewWebhookService(webhookRepo, &nethttp.Client{Timeout: webhookTimeout}, cfg.WebhookRetention)
+	outboxSinks, err := newOutboxSinks(cfg)
+	if err != nil {
+		return err
+	}
+	// Partner webhooks are fed by the outbox whatever the configured sinks
+	outboxSinks = append(outboxSinks, webhookService)
+	outboxService := service.NewOutboxService(outboxRepo, outboxSinks, cfg.OutboxRetention, cfg.OutboxStuckAfter)
+	services := transport.Services{
+		Songs:          songService,
+		Import:         service.NewImportService(songRepo, groupRepo, songService, auditService, txManager),
+		Batch:          service.NewBatchService(songService, txManager),
+		Duplicates:     service.NewDuplicateService(songRepo, txManager, auditService),
+		Playlists:      service.NewPlaylistService(playlistRepo, songRepo, txManager),
+		Albums:         service.NewAlbumService(albumRepo, songRepo, txManager, auditService),
+		Tags:           service.NewTagService(tagRepo, txManager),
+		Genres:         service.NewGenreService(genreRepo, songRepo, txManager),
+		Relations:      service.NewRelationService(relationRepo, songRepo, txManager),
+		Lyrics:         service.NewLyricsService(lyricsRepo, songRepo, songService, txManager),
+		Stats:          service.NewStatsService(songRepo, groupRepo),
+		Similarity:     similarityService,
+		NearDuplicates: nearDuplicateService,
+		ChangeFeed:     changeFeed,
+		Outbox:         outboxService,
+		Webhooks:       webhookService,
+		Audit:          auditService,
+		Idempotency:    idempotencyService,
+	}
+	if cfg.AuthEnabled {
+		apiKeyService := service.NewAPIKeyService(apiKeyRepo)
+		services.APIKeys = apiKeyService
+
+		var jwtAuth service.Authenticator
+		if cfg.JWTJWKS != "" {
+			if jwtAuth, err = newJWTAuthenticator(cfg); err != nil {
+				return err
+			}
+		}
+		services.Auth = service.NewCredentialAuthenticator(apiKeyService, jwtAuth)
+	} else {
+		log.Println("WARNING: authentication is disabled, every caller has full access")
+	}
+
+	// Purge expired idempotency keys and song events in the background
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go runPeriodically(ctx, idempotencyPurgeInterval, "expired idempotency keys", idempotencyService.PurgeExpired)
+	go runPeriodically(ctx, songEventsPurgeInterval, "song events", changeFeed.PurgeEvents)
+	go runPeriodically(ctx, outboxPurgeInterval, "delivered outbox events", outboxService.PurgeDelivered)
+	go runPeriodically(ctx, webhookPurgeInterval, "webhook deliveries", webhookService.PurgeDeliveries)
+	go runPeriodically(ctx, auditPurgeInterval, "audit log entries", auditService.Purge)
+
+	// Deliver the events of the outbox to the sinks, and post them to the
+	// subscribed partners
+	go outboxService.Run(ctx, cfg.OutboxRelayInterval)
+	go webhookService.Run(ctx, cfg.WebhookDeliveryInterval)
+
+	// Index the songs for recommendations and near duplicates in the
+	// background, then follow the change feed; until done, only the songs
+	// written since are compared
+	go indexSongs(ctx, "recommendations", changeFeed, similarityService.BuildIndex, similarityService.SongChanged)
+	go indexSongs(ctx, "near duplicates", changeFeed, nearDuplicateService.BuildIndex, nearDuplicateService.SongChanged)
+
+	if services.TrustedProxies, err = trustedProxies(cfg.TrustedProxies); err != nil {
+		return err
+	}
+	if err := setupRateLimits(ctx, cfg, pgrepo.NewRateLimitRepo(pgDB), &services); err != nil {
+		return err
+	}
+
+	// Create servers
+	httpServer := http.NewServer(cfg.HTTPAddr, services)
+	grpcServer := grpc.NewServer(cfg.GRPCAddr, services)
+
+	// Channel for graceful shutdown
+	shutdown := make(chan os.Signal, 1)
+	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
+
+	// WaitGroup for tracking running servers
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	// Start HTTP server
+	go func() {
+		defer wg.Done()
+		if err := httpServer.Run(); err != nil {
+			log.Printf("HTTP server error: %v", err)
+		}
+	}()
+
+	// Start gRPC server
+	go func() {
+		defer wg.Done()
+		if err := grpcServer.Run(); err != nil {
+			log.Printf("gRPC server error: %v", err)
+		}
+	}()
+
+	// Wait for shutdown signal
+	<-shutdown
+	log.Println("Shutting down servers...")
+
+	// Graceful shutdown
+	//httpServer.Stop()
+	grpcServer.Stop()
+
+	wg.Wait()
+	log.Println("Servers stopped")
+
+	return nil
+}
+
+// idempotencyPurgeInterval is how often expired idempotency keys are removed
+const idempotencyPurgeInterval = time.Hour
+
+// songEventsPurgeInterval is how often song events past their retention are removed
+const songEventsPurgeInterval = time.Hour
+
+// outboxPurgeInterval is how often delivered outbox events past their retention are removed
+const outboxPurgeInterval = time.Hour
+
+// webhookPurgeInterval is how often webhook deliveries past their retention are removed
+const webhookPurgeInterval = time.Hour
+
+// auditPurgeInterval is how often audit log entries past their retention are removed
+const auditPurgeInterval = time.Hour
+
+// rateLimitPurgeInterval is how often idle shared rate limit buckets are removed
+const rateLimitPurgeInterval = 10 * time.Minute
+
+// runPeriodically calls purge every interval until ctx is done, logging how
+// many records of the given kind it removed
+func runPeriodically(ctx context.Context, interval time.Duration, what string, purge func(ctx context.Context) (int64, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := purge(ctx)
+			if err != nil {
+				log.Printf("purge %s: %v", what, err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("purged %d %s", n, what)
+			}
+		}
+	}
+}
+
+// indexSongs fills an in-memory index of the songs, logging how many were
+// indexed, then keeps it up to date with the events of the change feed
+// recorded from the start until ctx is done
+func indexSongs(ctx context.Context, what string, feed *service.ChangeFeedService, build func(ctx context.Context) (int, error), apply func(ctx context.Context, event domain.SongEvent)) {
+	afterSeq, err := feed.Position(ctx)
+	if err != nil {
+		log.Printf("index songs for %s: %v", what, err)
+		return
+	}
+	n, err := build(ctx)
+	if err != nil {
+		log.Printf("index songs for %s: %v", what, err)
+		return
+	}
+	log.Printf("indexed %d songs for %s", n, what)
+
+	feed.Follow(ctx, afterSeq, func(event domain.SongEvent) {
+		apply(ctx, event)
+	})
+}
+
+// setupRateLimits builds the request limiter and, with authentication, the
+// throttle of invalid credentials, both keeping their buckets in the
+// configured store
+func setupRateLimits(ctx context.Context, cfg config.Config, repo *pgrepo.RateLimitRepo, services *transport.Services) error {
+	var limiters []*service.RateLimiter
+	if cfg.RateLimitRPS > 0 {
+		if cfg.RateLimitBurst <= 0 {
+			return fmt.Errorf("RATE_LIMIT_BURST must be positive, got %d", cfg.RateLimitBurst)
+		}
+		limiter, err := newRateLimiter(cfg.RateLimitStore, repo, cfg.RateLimitBurst, cfg.RateLimitRPS)
+		if err != nil {
+			return err
+		}
+		services.RateLimit = limiter
+		limiters = append(limiters, limiter)
+	}
+	if services.Auth != nil && cfg.AuthFailureBurst > 0 {
+		if cfg.AuthFailureRefill <= 0 {
+			return fmt.Errorf("AUTH_FAILURE_REFILL must be positive, got %s", cfg.AuthFailureRefill)
+		}
+		limiter, err := newRateLimiter(cfg.RateLimitStore, repo, cfg.AuthFailureBurst, 1/cfg.AuthFailureRefill.Seconds())
+		if err != nil {
+			return err
+		}
+		services.AuthThrottle = middleware.NewAuthThrottle(limiter)
+		limiters = append(limiters, limiter)
+	}
+
+	if cfg.RateLimitStore == "postgres" && len(limiters) > 0 {
+		// Buckets refill fully within the longest window of the limiters
+		// sharing the table
+		var idle time.Duration
+		for _, limiter := range limiters {
+			idle = max(idle, limiter.Window())
+		}
+		go runPeriodically(ctx, rateLimitPurgeInterval, "idle rate limit buckets", func(ctx context.Context) (int64, error) {
+			return repo.DeleteIdle(ctx, idle)
+		})
+	}
+	return nil
+}
+
+// newRateLimiter builds a limiter on the named bucket store. Memory stores
+// are not shared, each limiter sweeping its buckets by its own refill rate.
+func newRateLimiter(store string, repo *pgrepo.RateLimitRepo, capacity int, rate float64) (*service.RateLimiter, error) {
+	switch store {
+	case "memory":
+		return service.NewRateLimiter(service.NewMemoryRateLimitStore(), capacity, rate), nil
+	case "postgres":
+		return service.NewRateLimiter(repo, capacity, rate), nil
+	default:
+		return nil, fmt.Errorf("RATE_LIMIT_STORE must be memory or postgres, got %q", store)
+	}
+}
+
+// outboxWebhookTimeout bounds a delivery of the webhook sink
+const outboxWebhookTimeout = 10 * time.Second
+
+// webhookTimeout bounds a request to a partner webhook
+const webhookTimeout = 10 * time.Second
+
+// newOutboxSinks builds the configured sinks of the outbox relay
+func newOutboxSinks(cfg config.Config) ([]service.OutboxSink, error) {
+	var sinks []service.OutboxSink
+	for _, name := range strings.Split(cfg.OutboxSinks, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case "log":
+			sinks = append(sinks, service.NewLogSink())
+		case "webhook":
+			if cfg.OutboxWebhookURL == "" {
+				return nil, fmt.Errorf("OUTBOX_WEBHOOK_URL is required by the webhook sink")
+			}
+			sinks = append(sinks, service.NewWebhookSink(cfg.OutboxWebhookURL, &nethttp.Client{Timeout: outboxWebhookTimeout}))
+		case "file":
+			sink, err := service.NewFileSink(cfg.OutboxFile)
+			if err != nil {
+				return nil, fmt.Errorf("OUTBOX_FILE: %w", err)
+			}
+			sinks = append(sinks, sink)
+		default:
+			return nil, fmt.Errorf("OUTBOX_SINKS must list log, webhook or file, got %q", name)
+		}
+	}
+	return sinks, nil
+}
+
+// trustedProxies parses the comma separated IPs and CIDRs of TRUSTED_PROXIES
+func trustedProxies(list string) ([]string, error) {
+	var proxies []string
+	for _, proxy := range strings.Split(list, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return nil, fmt.Errorf("TRUSTED_PROXIES must list IPs or CIDRs, got %q", proxy)
+		}
+		proxies = append(proxies, proxy)
+	}
+	return proxies, nil
+}
+
+// newJWTAuthenticator validates bearer JWTs against the configured key set
+func newJWTAuthenticator(cfg config.Config) (*service.JWTAuthenticator, error) {
+	roleMap, err := service.ParseRoleMap(cfg.JWTRoleMap)
+	if err != nil {
+		return nil, fmt.Errorf("JWT_ROLE_MAP: %w", err)
+	}
+
+	jwks := service.NewJWKS(cfg.JWTJWKS, cfg.JWKSRefresh)
+	if err := jwks.Refresh(context.Background()); err != nil {
+		// Keep serving API keys; the key set is retried on the next token
+		log.Printf("WARNING: %v", err)
+	}
+
+	return service.NewJWTAuthenticator(jwks, service.JWTConfig{
+		Issuer:     cfg.JWTIssuer,
+		Audience:   cfg.JWTAudience,
+		RolesClaim: cfg.JWTRolesClaim,
+		RoleMap:    roleMap,
+	}), nil
+}
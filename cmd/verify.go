@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"songs/internal/app/config"
+	"songs/internal/app/migrations"
+	"songs/internal/app/repository/pgrepo"
+	"songs/internal/app/service"
+	pg "songs/internal/pkg"
+)
+
+func runVerify(cfg config.Config, _ []string) error {
+	pgDB, err := pg.Dial(cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("pg.Dial failed: %w", err)
+	}
+
+	sqlDB, err := pgDB.DB()
+	if err != nil {
+		return fmt.Errorf("get sql.DB: %w", err)
+	}
+	ctx := context.Background()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("database unreachable: %w", err)
+	}
+	log.Println("Database: ok")
+
+	m, err := migrations.New(cfg.MigrationsPath, cfg.DSN)
+	if err != nil {
+		return fmt.Errorf("migrations.New failed: %w", err)
+	}
+	defer func() {
+		_ = m.Close()
+	}()
+
+	if err := m.CheckSchema(); err != nil {
+		return err
+	}
+	version, _, latest, err := m.Status()
+	if err != nil {
+		return fmt.Errorf("schema version: %w", err)
+	}
+	if version < latest {
+		return fmt.Errorf("schema version %d is behind latest known %d, run migrate up", version, latest)
+	}
+	log.Printf("Schema version: %d", version)
+
+	groups, err := pgrepo.NewGroupRepo(pgDB).CountGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("count groups: %w", err)
+	}
+	_, songs, err := service.NewSongService(pgrepo.NewSongRepo(pgDB), nil, nil, nil).GetSongs(ctx, map[string]string{}, 1, 1)
+	if err != nil {
+		return fmt.Errorf("count songs: %w", err)
+	}
+	log.Printf("Catalog: %d groups, %d songs", groups, songs)
+
+	return nil
+}
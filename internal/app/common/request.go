@@ -1,6 +1,9 @@
 package common
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 // RequestReader interface for reading request parameters
 type RequestReader interface {
@@ -16,6 +19,12 @@ type RequestReader interface {
 	// DecodeBody decodes the request body into a structure
 	DecodeBody(interface{}) error
 
+	// Body returns the raw request body
+	Body() io.Reader
+
+	// Header returns the value of the request header
+	Header(name string) string
+
 	// Context returns the request context
 	Context() context.Context
 }
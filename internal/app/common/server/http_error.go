@@ -8,8 +8,10 @@ import (
 )
 
 type ErrorResponse struct {
-	Slug       string `json:"slug"`
-	Error      string `json:"error,omitempty"`
+	Slug  string `json:"slug"`
+	Error string `json:"error,omitempty"`
+	// Location points at the resource the error is about, e.g. the existing one of a conflict
+	Location   string `json:"location,omitempty"`
 	httpStatus int
 }
 
@@ -22,10 +24,39 @@ func BadRequest(slug string, err error, w http.ResponseWriter) {
 	httpRespondWithError(err, slug, w, "Bad Request", http.StatusBadRequest)
 }
 
+// Unauthorized responds with 401 asking for bearer credentials
+func Unauthorized(slug string, err error, w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="songs"`)
+	httpRespondWithError(err, slug, w, "Unauthorized", http.StatusUnauthorized)
+}
+
+func Forbidden(slug string, err error, w http.ResponseWriter) {
+	httpRespondWithError(err, slug, w, "Forbidden", http.StatusForbidden)
+}
+
 func NotFound(slug string, err error, w http.ResponseWriter) {
 	httpRespondWithError(err, slug, w, "Not Found", http.StatusNotFound)
 }
 
+func Conflict(slug string, err error, w http.ResponseWriter) {
+	httpRespondWithError(err, slug, w, "Conflict", http.StatusConflict)
+}
+
+// ConflictAt responds with 409 pointing at the conflicting resource, both in
+// the Location header and in the body
+func ConflictAt(slug string, err error, location string, w http.ResponseWriter) {
+	w.Header().Set("Location", location)
+	respondWithError(err, ErrorResponse{Slug: slug, Location: location, httpStatus: http.StatusConflict}, w, "Conflict")
+}
+
+func UnprocessableEntity(slug string, err error, w http.ResponseWriter) {
+	httpRespondWithError(err, slug, w, "Unprocessable Entity", http.StatusUnprocessableEntity)
+}
+
+func TooManyRequests(slug string, err error, w http.ResponseWriter) {
+	httpRespondWithError(err, slug, w, "Too Many Requests", http.StatusTooManyRequests)
+}
+
 func InternalError(slug string, err error, w http.ResponseWriter) {
 	httpRespondWithError(err, slug, w, "Internal Server Error", http.StatusInternalServerError)
 }
@@ -36,18 +67,17 @@ func RespondWithError(err error, w http.ResponseWriter) {
 }
 
 func httpRespondWithError(err error, slug string, w http.ResponseWriter, msg string, status int) {
-	log.Printf("error: %s, slug: %s, msg: %s", err, slug, msg)
+	respondWithError(err, ErrorResponse{Slug: slug, httpStatus: status}, w, msg)
+}
 
-	resp := ErrorResponse{
-		Slug:       slug,
-		httpStatus: status,
-	}
+func respondWithError(err error, resp ErrorResponse, w http.ResponseWriter, msg string) {
+	log.Printf("error: %s, slug: %s, msg: %s", err, resp.Slug, msg)
 
 	if os.Getenv("DEBUG_ERRORS") != "" && err != nil {
 		resp.Error = err.Error()
 	}
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(status)
+	w.WriteHeader(resp.httpStatus)
 	_ = json.NewEncoder(w).Encode(resp)
 }
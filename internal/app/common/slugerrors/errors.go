@@ -3,9 +3,13 @@ package slugerrors
 type ErrorType string
 
 const (
-	ErrorTypeBadRequest ErrorType = "bad_request"
-	ErrorTypeNotFound   ErrorType = "not_found"
-	ErrorTypeInternal   ErrorType = "internal"
+	ErrorTypeBadRequest   ErrorType = "bad_request"
+	ErrorTypeUnauthorized ErrorType = "unauthorized"
+	ErrorTypeForbidden    ErrorType = "forbidden"
+	ErrorTypeNotFound     ErrorType = "not_found"
+	ErrorTypeConflict     ErrorType = "conflict"
+	ErrorTypeRateLimited  ErrorType = "rate_limited"
+	ErrorTypeInternal     ErrorType = "internal"
 )
 
 type SlugError interface {
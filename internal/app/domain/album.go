@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// AlbumType is the kind of a release
+type AlbumType string
+
+const (
+	AlbumTypeAlbum  AlbumType = "album"
+	AlbumTypeSingle AlbumType = "single"
+	AlbumTypeEP     AlbumType = "ep"
+)
+
+// Valid reports whether t is a known album type
+func (t AlbumType) Valid() bool {
+	switch t {
+	case AlbumTypeAlbum, AlbumTypeSingle, AlbumTypeEP:
+		return true
+	}
+	return false
+}
+
+// Album is a release of a group. Its tracks link songs in disc and track order.
+type Album struct {
+	ID          int
+	GroupID     int
+	Title       string
+	ReleaseDate time.Time
+	Type        AlbumType
+	CoverURL    string
+	// Tracks is nil when the track listing was not loaded
+	Tracks []AlbumTrack
+}
+
+// AlbumTrack is a song at a disc and track number of an album. With
+// InheritReleaseDate the song takes the release date of the album and
+// follows it when the album changes; a song inherits from one album at most.
+type AlbumTrack struct {
+	DiscNumber         int
+	TrackNumber        int
+	InheritReleaseDate bool
+	SongID             int
+	// Song is only set when tracks are read back
+	Song *Song
+}
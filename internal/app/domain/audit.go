@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AuditResource is the kind of record an audited change applies to
+type AuditResource string
+
+const (
+	AuditSong  AuditResource = "song"
+	AuditGroup AuditResource = "group"
+)
+
+// AuditAction is the operation of an audited change
+type AuditAction string
+
+const (
+	AuditCreate AuditAction = "create"
+	AuditUpdate AuditAction = "update"
+	AuditPatch  AuditAction = "patch"
+	AuditDelete AuditAction = "delete"
+)
+
+// Transport is how a request reached the service
+type Transport string
+
+const (
+	TransportHTTP Transport = "http"
+	TransportGRPC Transport = "grpc"
+)
+
+// AuditEntry records a change to a song or group: who made it, through
+// which request, and the record before and after
+type AuditEntry struct {
+	ID   int64
+	Time time.Time
+	// Actor is the subject of the caller, empty for anonymous calls and
+	// commands run on the server
+	Actor     string
+	ActorName string
+	RequestID string
+	// Transport is empty for commands run on the server
+	Transport  Transport
+	Resource   AuditResource
+	ResourceID int
+	Action     AuditAction
+	// Before and After are the JSON documents of the record, nil before it
+	// was created and after it was deleted
+	Before []byte
+	After  []byte
+}
+
+// AuditFilter selects audit entries; zero fields match every entry
+type AuditFilter struct {
+	Resource   AuditResource
+	ResourceID int
+	Actor      string
+	Action     AuditAction
+	Transport  Transport
+	RequestID  string
+	// Since and Until bound the time of the entries, Until excluded
+	Since time.Time
+	Until time.Time
+}
+
+// RequestInfo identifies the request a change is made in
+type RequestInfo struct {
+	ID        string
+	Transport Transport
+}
+
+type requestInfoKey struct{}
+
+// WithRequestInfo returns a context carrying the request being served
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext returns the request being served, zero outside requests
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
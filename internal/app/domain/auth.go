@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Role grants access to a set of operations. Roles are ordered: every role
+// can do everything the roles below it can.
+type Role string
+
+const (
+	// RoleReader can read songs
+	RoleReader Role = "reader"
+	// RoleEditor can also create, change and delete songs
+	RoleEditor Role = "editor"
+	// RoleAdmin can also manage API keys
+	RoleAdmin Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleReader: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// Valid reports whether r is a known role
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Allows reports whether r grants the permissions of required
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleRanks[r] >= roleRanks[required]
+}
+
+// APIKey is a stored API key. Only the hash of the secret is kept; the secret
+// itself is shown once, when the key is issued.
+type APIKey struct {
+	ID        int
+	Name      string
+	Prefix    string
+	Hash      string
+	Role      Role
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	// Subject identifies the caller across requests, e.g. "apikey:12"
+	Subject string
+	Name    string
+	Role    Role
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated caller
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated caller, or nil for anonymous requests
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
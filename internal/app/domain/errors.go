@@ -31,7 +31,7 @@ var (
 
 	ErrDuplicate = slugerrors.NewError(
 		"duplicate-entry",
-		slugerrors.ErrorTypeBadRequest,
+		slugerrors.ErrorTypeConflict,
 		"duplicate entry",
 	)
 
@@ -47,6 +47,180 @@ var (
 		"validation failed",
 	)
 
+	ErrUnsupportedFormat = slugerrors.NewError(
+		"unsupported-format",
+		slugerrors.ErrorTypeBadRequest,
+		"unsupported format",
+	)
+
+	ErrBatchAborted = slugerrors.NewError(
+		"batch-aborted",
+		slugerrors.ErrorTypeBadRequest,
+		"batch aborted because another item failed",
+	)
+
+	ErrBatchTooLarge = slugerrors.NewError(
+		"batch-too-large",
+		slugerrors.ErrorTypeBadRequest,
+		"too many items in batch",
+	)
+
+	ErrIdempotencyKeyReused = slugerrors.NewError(
+		"idempotency-key-reused",
+		slugerrors.ErrorTypeBadRequest,
+		"idempotency key was already used with a different request",
+	)
+
+	ErrIdempotencyInProgress = slugerrors.NewError(
+		"idempotency-request-in-progress",
+		slugerrors.ErrorTypeConflict,
+		"a request with this idempotency key is still in progress",
+	)
+
+	ErrMergeMismatch = slugerrors.NewError(
+		"merge-mismatch",
+		slugerrors.ErrorTypeBadRequest,
+		"only songs of the same group with the same normalized title can be merged",
+	)
+
+	ErrSongNotFound = slugerrors.NewError(
+		"song-not-found",
+		slugerrors.ErrorTypeNotFound,
+		"song not found",
+	)
+
+	ErrGroupNotFound = slugerrors.NewError(
+		"group-not-found",
+		slugerrors.ErrorTypeNotFound,
+		"group not found",
+	)
+
+	ErrInvalidCredit = slugerrors.NewError(
+		"invalid-credit",
+		slugerrors.ErrorTypeBadRequest,
+		"credits need a positive artist ID and a role of primary, featured, composer, lyricist or producer",
+	)
+
+	ErrInvalidTag = slugerrors.NewError(
+		"invalid-tag",
+		slugerrors.ErrorTypeBadRequest,
+		"tags must be 1 to 64 characters without commas",
+	)
+
+	ErrGenreNotFound = slugerrors.NewError(
+		"genre-not-found",
+		slugerrors.ErrorTypeNotFound,
+		"genre not found",
+	)
+
+	ErrInvalidRelation = slugerrors.NewError(
+		"invalid-relation",
+		slugerrors.ErrorTypeBadRequest,
+		"relations need two different songs and a type of cover, remix, live or translation",
+	)
+
+	ErrRelationCycle = slugerrors.NewError(
+		"relation-cycle",
+		slugerrors.ErrorTypeConflict,
+		"the original is already a version of the song",
+	)
+
+	ErrInvalidLanguage = slugerrors.NewError(
+		"invalid-language",
+		slugerrors.ErrorTypeBadRequest,
+		"language must be a BCP-47 tag such as en or pt-BR",
+	)
+
+	ErrLyricsNotFound = slugerrors.NewError(
+		"lyrics-not-found",
+		slugerrors.ErrorTypeNotFound,
+		"no lyrics in the requested language",
+	)
+
+	ErrOriginalLyrics = slugerrors.NewError(
+		"original-lyrics",
+		slugerrors.ErrorTypeConflict,
+		"the original lyrics cannot be removed; mark another variant as original first",
+	)
+
+	ErrInvalidAlbumType = slugerrors.NewError(
+		"invalid-album-type",
+		slugerrors.ErrorTypeBadRequest,
+		"type must be one of album, single or ep",
+	)
+
+	ErrInvalidTrack = slugerrors.NewError(
+		"invalid-track",
+		slugerrors.ErrorTypeBadRequest,
+		"tracks need positive disc and track numbers, each used once, and songs listed once",
+	)
+
+	ErrReleaseDateInherited = slugerrors.NewError(
+		"release-date-already-inherited",
+		slugerrors.ErrorTypeConflict,
+		"song already inherits its release date from another album",
+	)
+
+	ErrInvalidPosition = slugerrors.NewError(
+		"invalid-position",
+		slugerrors.ErrorTypeBadRequest,
+		"position is outside the playlist",
+	)
+
+	ErrUnauthenticated = slugerrors.NewError(
+		"unauthenticated",
+		slugerrors.ErrorTypeUnauthorized,
+		"missing or invalid credentials",
+	)
+
+	ErrForbidden = slugerrors.NewError(
+		"forbidden",
+		slugerrors.ErrorTypeForbidden,
+		"insufficient permissions",
+	)
+
+	ErrInvalidRole = slugerrors.NewError(
+		"invalid-role",
+		slugerrors.ErrorTypeBadRequest,
+		"role must be one of reader, editor or admin",
+	)
+
+	ErrRateLimited = slugerrors.NewError(
+		"rate-limited",
+		slugerrors.ErrorTypeRateLimited,
+		"rate limit exceeded",
+	)
+
+	ErrNearDuplicate = slugerrors.NewError(
+		"near-duplicate-lyrics",
+		slugerrors.ErrorTypeConflict,
+		"lyrics nearly the same as those of an existing song",
+	)
+
+	ErrOutboxEventNotFound = slugerrors.NewError(
+		"outbox-event-not-found",
+		slugerrors.ErrorTypeNotFound,
+		"pending outbox event not found",
+	)
+
+	ErrInvalidWebhook = slugerrors.NewError(
+		"invalid-webhook",
+		slugerrors.ErrorTypeBadRequest,
+		"webhooks need an http(s) URL and event types among created, updated and deleted",
+	)
+
+	ErrWebhookNotFound = slugerrors.NewError(
+		"webhook-not-found",
+		slugerrors.ErrorTypeNotFound,
+		"webhook subscription not found",
+	)
+
+	ErrWebhookDeliveryNotFound = slugerrors.NewError(
+		"webhook-delivery-not-found",
+		slugerrors.ErrorTypeNotFound,
+		"webhook delivery not found",
+	)
+
 	ErrInternal = slugerrors.NewError(
 		"internal-error",
 		slugerrors.ErrorTypeInternal,
@@ -0,0 +1,14 @@
+package domain
+
+// MaxGenreNameLength bounds the length of a genre name in characters
+const MaxGenreNameLength = 64
+
+// Genre is a node of the genre tree, e.g. Punk under Rock. ParentID is 0 for
+// top-level genres and Path spells the chain from the top, e.g. "Rock > Punk".
+type Genre struct {
+	ID        int
+	ParentID  int
+	Name      string
+	Path      string
+	SongCount int64
+}
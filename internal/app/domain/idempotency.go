@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord remembers the response of a write request sent with an
+// Idempotency-Key, so retries of the same request get the same response
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string
+	Completed   bool
+	StatusCode  int
+	ContentType string
+	Response    []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// MaxLyricsCreditLength bounds the translator and source of lyrics
+const MaxLyricsCreditLength = 255
+
+// Lyrics are the lyrics of a song in one language. A song has at most one
+// original variant, whose text is always the song's Text; the others are
+// translations of it.
+type Lyrics struct {
+	SongID int
+	// Language is a canonical BCP-47 tag such as "en" or "pt-BR"; it is empty
+	// for songs whose lyrics have no known language yet
+	Language   string
+	Text       string
+	Original   bool
+	Translator string
+	// Source tells where the lyrics come from, such as a URL
+	Source    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AlignedLine pairs a line of the original lyrics with the line at the
+// same place in a translation; either is empty when the other runs longer
+type AlignedLine struct {
+	// Verse and Line are 1-based
+	Verse       int
+	Line        int
+	Original    string
+	Translation string
+}
+
+// AlignedLyrics lays a translation side by side with the original lyrics
+type AlignedLyrics struct {
+	Original    Lyrics
+	Translation Lyrics
+	Lines       []AlignedLine
+}
+
+// NormalizeLanguage parses a BCP-47 language tag into its canonical form
+func NormalizeLanguage(tag string) (string, error) {
+	parsed, err := language.Parse(strings.TrimSpace(tag))
+	if err != nil || parsed == language.Und {
+		return "", ErrInvalidLanguage
+	}
+	return parsed.String(), nil
+}
+
+// ParseAcceptLanguage returns the languages of an Accept-Language header,
+// most preferred first. Malformed headers and wildcards yield no preference.
+func ParseAcceptLanguage(header string) []string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+
+	preferences := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag != language.Und {
+			preferences = append(preferences, tag.String())
+		}
+	}
+	return preferences
+}
+
+// SplitVerses splits lyrics into verses separated by blank lines
+func SplitVerses(text string) []string {
+	return strings.Split(text, "\n\n")
+}
+
+// PageVerses returns a page of the verses of lyrics with their total number
+func PageVerses(text string, page, size int) ([]string, int) {
+	verses := SplitVerses(text)
+	total := len(verses)
+
+	start := (page - 1) * size
+	if start >= total {
+		return []string{}, total
+	}
+	return verses[start:min(start+size, total)], total
+}
+
+// AlignLyrics pairs the lines of a translation with the lines of the
+// original, verse by verse, so a short verse does not shift the following ones
+func AlignLyrics(original, translation Lyrics) *AlignedLyrics {
+	aligned := &AlignedLyrics{Original: original, Translation: translation}
+
+	originalVerses := SplitVerses(original.Text)
+	translatedVerses := SplitVerses(translation.Text)
+	for v := 0; v < max(len(originalVerses), len(translatedVerses)); v++ {
+		var originalLines, translatedLines []string
+		if v < len(originalVerses) {
+			originalLines = strings.Split(originalVerses[v], "\n")
+		}
+		if v < len(translatedVerses) {
+			translatedLines = strings.Split(translatedVerses[v], "\n")
+		}
+
+		for l := 0; l < max(len(originalLines), len(translatedLines)); l++ {
+			line := AlignedLine{Verse: v + 1, Line: l + 1}
+			if l < len(originalLines) {
+				line.Original = originalLines[l]
+			}
+			if l < len(translatedLines) {
+				line.Translation = translatedLines[l]
+			}
+			aligned.Lines = append(aligned.Lines, line)
+		}
+	}
+	return aligned
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// DefaultTopWords is the number of most frequent words reported by default
+	DefaultTopWords = 10
+	// MaxTopWords bounds the number of most frequent words reported
+	MaxTopWords = 100
+
+	// readingWordsPerMinute is the pace of silent reading
+	readingWordsPerMinute = 200
+	// singingWordsPerMinute is the pace of sung lyrics, pauses and
+	// instrumental parts left out
+	singingWordsPerMinute = 120
+)
+
+// WordCount is the number of occurrences of a word
+type WordCount struct {
+	Word  string
+	Count int
+}
+
+// LyricsStats describes the content of lyrics
+type LyricsStats struct {
+	// Lines counts the non-blank lines
+	Lines int
+	Words int
+	// Characters counts every character but line breaks
+	Characters         int
+	CharactersNoSpaces int
+	// Verses is the number of verses GetSongVerses pages through
+	Verses      int
+	UniqueWords int
+	// UniqueWordRatio is UniqueWords over Words, 0 without words
+	UniqueWordRatio float64
+	// TopWords are the most frequent words, stopwords left out, most frequent first
+	TopWords    []WordCount
+	ReadingTime time.Duration
+	SingingTime time.Duration
+}
+
+// GroupStats sums up the lyrics of the songs of a group
+type GroupStats struct {
+	GroupID int
+	Songs   int
+	LyricsStats
+	// AverageWords is the mean number of words per song
+	AverageWords float64
+}
+
+// LyricsTally accumulates the statistics of one or more lyrics
+type LyricsTally struct {
+	stats LyricsStats
+	// words counts every word, content only the words that are not stopwords
+	words   map[string]int
+	content map[string]int
+}
+
+// NewLyricsTally returns an empty tally
+func NewLyricsTally() *LyricsTally {
+	return &LyricsTally{words: make(map[string]int), content: make(map[string]int)}
+}
+
+// Add counts the lyrics text, written in the BCP-47 language lang
+func (t *LyricsTally) Add(text, lang string) {
+	t.stats.Verses += len(SplitVerses(text))
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) != "" {
+			t.stats.Lines++
+		}
+	}
+	for _, r := range text {
+		switch {
+		case r == '\n' || r == '\r':
+		case unicode.IsSpace(r):
+			t.stats.Characters++
+		default:
+			t.stats.Characters++
+			t.stats.CharactersNoSpaces++
+		}
+	}
+
+	stop := stopwordsOf(lang)
+	for _, word := range Words(text) {
+		t.stats.Words++
+		t.words[word]++
+		if !stop[word] {
+			t.content[word]++
+		}
+	}
+}
+
+// Stats returns the statistics of the lyrics added so far with their top
+// most frequent words
+func (t *LyricsTally) Stats(top int) LyricsStats {
+	stats := t.stats
+	stats.UniqueWords = len(t.words)
+	if stats.Words > 0 {
+		stats.UniqueWordRatio = float64(stats.UniqueWords) / float64(stats.Words)
+	}
+	stats.ReadingTime = wordsDuration(stats.Words, readingWordsPerMinute)
+	stats.SingingTime = wordsDuration(stats.Words, singingWordsPerMinute)
+
+	stats.TopWords = make([]WordCount, 0, len(t.content))
+	for word, count := range t.content {
+		stats.TopWords = append(stats.TopWords, WordCount{Word: word, Count: count})
+	}
+	sort.Slice(stats.TopWords, func(i, j int) bool {
+		if stats.TopWords[i].Count != stats.TopWords[j].Count {
+			return stats.TopWords[i].Count > stats.TopWords[j].Count
+		}
+		return stats.TopWords[i].Word < stats.TopWords[j].Word
+	})
+	if len(stats.TopWords) > top {
+		stats.TopWords = stats.TopWords[:top]
+	}
+	return stats
+}
+
+// ComputeLyricsStats returns the statistics of lyrics written in the
+// BCP-47 language lang
+func ComputeLyricsStats(text, lang string, top int) LyricsStats {
+	tally := NewLyricsTally()
+	tally.Add(text, lang)
+	return tally.Stats(top)
+}
+
+// Words returns the lowercased words of text. Words are runs of letters,
+// digits and marks, apostrophes within them included, so "don't" is one word.
+func Words(text string) []string {
+	var words []string
+	runes := []rune(strings.ToLower(text))
+	start := -1
+	for i, r := range runes {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) ||
+			isApostrophe(r) && start >= 0 && i+1 < len(runes) && unicode.IsLetter(runes[i+1])
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			words = append(words, string(runes[start:i]))
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// wordsDuration is the time taken by words at a pace in words per minute,
+// rounded up to the second
+func wordsDuration(words, perMinute int) time.Duration {
+	seconds := math.Ceil(float64(words) * 60 / float64(perMinute))
+	return time.Duration(seconds) * time.Second
+}
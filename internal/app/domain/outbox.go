@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// OutboxEvent is a song event written to the outbox in the transaction of
+// the song write, and delivered to the sinks from there
+type OutboxEvent struct {
+	ID     int64
+	Type   SongEventType
+	SongID int
+	// Payload is the song as written, JSON encoded, nil for deleted songs
+	Payload   []byte
+	CreatedAt time.Time
+	// Attempts counts the deliveries tried, LastError tells why the last one failed
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	// DeliveredAt is nil while the event is pending
+	DeliveredAt *time.Time
+}
+
+// OutboxStatus describes the events waiting in the outbox
+type OutboxStatus struct {
+	Pending int64
+	// OldestPending is the time the oldest pending event was written, nil when none is
+	OldestPending *time.Time
+	// Stuck lists the events pending for longer than expected, oldest first
+	Stuck []OutboxEvent
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// MaxPlaylistNameLength bounds playlist names
+const MaxPlaylistNameLength = 255
+
+// Playlist is an ordered list of songs. Positions are 1-based and dense:
+// removing an entry moves the following ones up, so positions always run
+// from 1 to SongCount.
+type Playlist struct {
+	ID        int
+	Name      string
+	SongCount int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Entries is only filled when the playlist is fetched on its own
+	Entries []PlaylistEntry
+}
+
+// PlaylistEntry is a song at a position of a playlist. A song may appear
+// several times in the same playlist.
+type PlaylistEntry struct {
+	Position int
+	Song     *Song
+	AddedAt  time.Time
+}
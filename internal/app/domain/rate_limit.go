@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// RateLimitDecision is the outcome of charging a request to its caller's bucket
+type RateLimitDecision struct {
+	Allowed bool
+	// Limit is the bucket capacity
+	Limit int
+	// Remaining is the number of whole tokens left after the request
+	Remaining int
+	// Reset is the time until the bucket is full again
+	Reset time.Duration
+	// RetryAfter is the time until a denied request could succeed
+	RetryAfter time.Duration
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// RelationType is how a song derives from its original
+type RelationType string
+
+const (
+	RelationCover       RelationType = "cover"
+	RelationRemix       RelationType = "remix"
+	RelationLive        RelationType = "live"
+	RelationTranslation RelationType = "translation"
+)
+
+// Valid reports whether t is a known relation type
+func (t RelationType) Valid() bool {
+	switch t {
+	case RelationCover, RelationRemix, RelationLive, RelationTranslation:
+		return true
+	}
+	return false
+}
+
+// SongRelation records that SongID is a version of OriginalID, such as a
+// cover or a live recording. Following originals never leads back to a song.
+type SongRelation struct {
+	SongID        int
+	SongTitle     string
+	OriginalID    int
+	OriginalTitle string
+	Type          RelationType
+	CreatedAt     time.Time
+}
+
+// FamilySong is a member of a version family
+type FamilySong struct {
+	ID    int
+	Title string
+	// Original is set for songs that are not a version of another song
+	Original bool
+}
+
+// VersionFamily is every song connected to a song through relations, in
+// either direction, with the relations between them
+type VersionFamily struct {
+	// Songs lists the originals first, then the versions, each by ID
+	Songs     []FamilySong
+	Relations []SongRelation
+}
@@ -9,4 +9,48 @@ type Song struct {
 	ReleaseDate time.Time
 	Text        string
 	Link        string
+	// Credits lists the credited artists; GroupID is always credited as a
+	// primary artist. Writes leave the credits untouched when it is nil.
+	Credits []Credit
+	// Language is the BCP-47 language detected from Text, empty when unknown
+	// or not detected yet
+	Language string
+	// LanguageConfidence ranges from 0 to 1
+	LanguageConfidence float64
+	// Script is the ISO 15924 script of Text such as Latn, empty when unknown
+	Script string
+	// Explicit flags songs with explicit lyrics. Unless ExplicitManual is
+	// set by an editor it is computed from Text.
+	Explicit       bool
+	ExplicitManual bool
+	// NearDuplicates warns, on the song returned by a write, of the existing
+	// songs with nearly the same lyrics. It is not stored.
+	NearDuplicates []NearDuplicate
+}
+
+// CreditRole is the part an artist had in a song
+type CreditRole string
+
+const (
+	CreditPrimary  CreditRole = "primary"
+	CreditFeatured CreditRole = "featured"
+	CreditComposer CreditRole = "composer"
+	CreditLyricist CreditRole = "lyricist"
+	CreditProducer CreditRole = "producer"
+)
+
+// Valid reports whether r is a known credit role
+func (r CreditRole) Valid() bool {
+	switch r {
+	case CreditPrimary, CreditFeatured, CreditComposer, CreditLyricist, CreditProducer:
+		return true
+	}
+	return false
+}
+
+// Credit links an artist to a song in a role
+type Credit struct {
+	ArtistID   int
+	ArtistName string
+	Role       CreditRole
 }
@@ -0,0 +1,39 @@
+package domain
+
+// BatchMode controls how a batch reacts to failing items
+type BatchMode string
+
+const (
+	// BatchModeAtomic applies all items in one transaction or none of them
+	BatchModeAtomic BatchMode = "atomic"
+	// BatchModeBestEffort applies every item on its own and keeps the successful ones
+	BatchModeBestEffort BatchMode = "best_effort"
+)
+
+// MaxBatchSize is the maximum number of items accepted in one batch
+const MaxBatchSize = 500
+
+// BatchItem is a single input of a batch operation. Err is set when the item
+// could not be decoded, so it fails without reaching the repository.
+type BatchItem struct {
+	ID   int
+	Song *Song
+	Err  error
+}
+
+// BatchResult is the outcome of a single batch item
+type BatchResult struct {
+	Index int
+	ID    int
+	Song  *Song
+	Err   error
+}
+
+// BatchReport is the outcome of a whole batch
+type BatchReport struct {
+	Mode      BatchMode
+	Committed bool
+	Succeeded int
+	Failed    int
+	Results   []BatchResult
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"songs/internal/app/common/slugerrors"
+	"strings"
+	"unicode"
+)
+
+// NormalizeTitle returns the natural key of a song title within its group:
+// lower-cased, without punctuation and with whitespace collapsed, so that
+// "Don't Stop" and "dont  stop" are the same song. It mirrors the
+// song_title_key SQL function backing the unique index.
+func NormalizeTitle(title string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// DuplicateSongError is returned when a song clashes with an existing song of
+// the same group and normalized title. It matches ErrDuplicate with errors.Is.
+type DuplicateSongError struct {
+	ExistingID int
+}
+
+func (e *DuplicateSongError) Error() string {
+	return ErrDuplicate.Error()
+}
+
+func (e *DuplicateSongError) Slug() string {
+	return ErrDuplicate.Slug()
+}
+
+func (e *DuplicateSongError) ErrorType() slugerrors.ErrorType {
+	return ErrDuplicate.ErrorType()
+}
+
+func (e *DuplicateSongError) Unwrap() error {
+	return ErrDuplicate
+}
+
+// DuplicateSet is a group of songs sharing a group and normalized title,
+// ordered by ID. Such sets predate the uniqueness constraint and can be
+// resolved by merging them into one song.
+type DuplicateSet struct {
+	GroupID  int
+	TitleKey string
+	Songs    []*Song
+}
+
+// NearDuplicateMode is what happens to songs written with lyrics nearly the
+// same as those of an existing song
+type NearDuplicateMode string
+
+const (
+	NearDuplicateOff    NearDuplicateMode = "off"
+	NearDuplicateWarn   NearDuplicateMode = "warn"
+	NearDuplicateReject NearDuplicateMode = "reject"
+)
+
+// Valid reports whether m is a known mode
+func (m NearDuplicateMode) Valid() bool {
+	switch m {
+	case NearDuplicateOff, NearDuplicateWarn, NearDuplicateReject:
+		return true
+	}
+	return false
+}
+
+// NearDuplicate is an existing song with lyrics nearly the same as those of
+// another song
+type NearDuplicate struct {
+	SongID int
+	Title  string
+	// Similarity is the share of word sequences the lyrics have in common, in (0, 1]
+	Similarity float64
+}
+
+// NearDuplicateError is returned when a song is rejected for lyrics nearly
+// the same as those of existing songs, most similar first. It matches
+// ErrNearDuplicate with errors.Is.
+type NearDuplicateError struct {
+	Matches []NearDuplicate
+}
+
+func (e *NearDuplicateError) Error() string {
+	return ErrNearDuplicate.Error()
+}
+
+func (e *NearDuplicateError) Slug() string {
+	return ErrNearDuplicate.Slug()
+}
+
+func (e *NearDuplicateError) ErrorType() slugerrors.ErrorType {
+	return ErrNearDuplicate.ErrorType()
+}
+
+func (e *NearDuplicateError) Unwrap() error {
+	return ErrNearDuplicate
+}
+
+// NearDuplicateCluster is a set of songs linked by lyrics nearly the same,
+// directly or through other songs of the set, ordered by ID
+type NearDuplicateCluster struct {
+	Songs []*Song
+	Pairs []NearDuplicatePair
+}
+
+// NearDuplicatePair is two songs of a cluster with nearly the same lyrics
+type NearDuplicatePair struct {
+	SongID     int
+	OtherID    int
+	Similarity float64
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// SongEventType is the kind of change a song event records
+type SongEventType string
+
+const (
+	SongEventCreated SongEventType = "created"
+	SongEventUpdated SongEventType = "updated"
+	SongEventDeleted SongEventType = "deleted"
+	// SongEventReset tells consumers resuming from a purged event that they
+	// missed changes and should reload the songs they keep
+	SongEventReset SongEventType = "reset"
+)
+
+// SongEvent records a change to a song. Seq increases with every event
+// recorded, though not every number is used.
+type SongEvent struct {
+	Seq    int64
+	Type   SongEventType
+	SongID int
+	// Song is the song as written, nil for deleted songs
+	Song *Song
+	Time time.Time
+}
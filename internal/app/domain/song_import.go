@@ -0,0 +1,58 @@
+package domain
+
+// ImportFormat is the encoding of a bulk import payload
+type ImportFormat string
+
+const (
+	ImportFormatCSV    ImportFormat = "csv"
+	ImportFormatNDJSON ImportFormat = "ndjson"
+)
+
+// ImportRowStatus is the outcome of importing a single row
+type ImportRowStatus string
+
+const (
+	ImportRowCreated ImportRowStatus = "created"
+	ImportRowSkipped ImportRowStatus = "skipped"
+	ImportRowFailed  ImportRowStatus = "failed"
+)
+
+// ImportRow is a single song read from a bulk import payload
+type ImportRow struct {
+	GroupName   string
+	Title       string
+	ReleaseDate string
+	Text        string
+	Link        string
+}
+
+// ImportRowResult describes what happened to one row of an import
+type ImportRowResult struct {
+	Row     int
+	Status  ImportRowStatus
+	SongID  int
+	Slug    string
+	Message string
+}
+
+// ImportReport summarizes a bulk import
+type ImportReport struct {
+	DryRun  bool
+	Created int
+	Skipped int
+	Failed  int
+	Rows    []ImportRowResult
+}
+
+// Add records a row result and updates the counters
+func (r *ImportReport) Add(result ImportRowResult) {
+	switch result.Status {
+	case ImportRowCreated:
+		r.Created++
+	case ImportRowSkipped:
+		r.Skipped++
+	case ImportRowFailed:
+		r.Failed++
+	}
+	r.Rows = append(r.Rows, result)
+}
@@ -0,0 +1,15 @@
+package domain
+
+const (
+	// DefaultSimilarSongs is the number of similar songs recommended by default
+	DefaultSimilarSongs = 10
+	// MaxSimilarSongs bounds the number of similar songs recommended
+	MaxSimilarSongs = 50
+)
+
+// SimilarSong is a song recommended for another one
+type SimilarSong struct {
+	Song *Song
+	// Score is the similarity of the lyrics and titles of the songs, in (0, 1]
+	Score float64
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "strings"
+
+// stopwords are the most common function words of a few languages, left
+// out of the most frequent words of lyrics
+var stopwords = map[string][]string{
+	"en": strings.Fields(`a about all am an and are as at be been but by can could did do does don't
+		for from had has have he her him his how i i'm i'll i've if in into is it it's its just me
+		my no not now of oh on or our out she so than that the their them then there these they
+		this to too up us was we were what when where which who will with would yeah you you're your`),
+	"es": strings.Fields(`a al algo como con de del el ella en es esa ese eso esta este esto fue ha
+		hay la las le les lo los me mi mis muy más ni no nos o para pero por que qué se si sin
+		sobre su sus te ti tu tus tú un una uno y ya yo él`),
+	"fr": strings.Fields(`a au aux avec c'est ce ces dans de des du elle en est et il ils je la le
+		les leur lui ma me mes moi mon ne nous on ou par pas pour qu'il que qui sa se ses si son
+		sur ta te tes toi ton tu un une vous y à ça`),
+	"de": strings.Fields(`aber als am an auch auf aus bei bin bist das dass dem den der des die dich
+		dir du ein eine einen er es für hat ich ihr im in ist ja mein meine mich mir mit nicht
+		noch nur oder sich sie so und uns von war was wie wir zu`),
+	"it": strings.Fields(`a al che ci come con da del della di e è gli ha ho i il in io la le lo
+		ma mi mia mio ne non per più se si sono su ti tu un una`),
+	"pt": strings.Fields(`a ao as com como da das de do dos e é ela ele em era eu isso lhe mais
+		me meu minha muito na nas não no nos o os ou para pela pelo por que se sem seu sua te
+		tu um uma você`),
+	"ru": strings.Fields(`а без бы в во вот все всё да для до же за и из или их к как ко когда
+		ли мне мы на не нет но ну о об он она они от по с со так там те то ты у уж что это я`),
+}
+
+// stopwordSets indexes stopwords by language; the empty language holds every list
+var stopwordSets = func() map[string]map[string]bool {
+	sets := map[string]map[string]bool{"": {}}
+	for lang, words := range stopwords {
+		sets[lang] = make(map[string]bool, len(words))
+		for _, word := range words {
+			sets[lang][word] = true
+			sets[""][word] = true
+		}
+	}
+	return sets
+}()
+
+// stopwordsOf returns the stopwords of a BCP-47 language, those of every
+// language when it has none or is unknown
+func stopwordsOf(lang string) map[string]bool {
+	base, _, _ := strings.Cut(strings.ToLower(lang), "-")
+	if set, ok := stopwordSets[base]; ok {
+		return set
+	}
+	return stopwordSets[""]
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTagLength bounds the length of a tag in characters
+	MaxTagLength = 64
+	// MaxTagsPerRequest bounds the number of tags applied or removed at once
+	MaxTagsPerRequest = 50
+)
+
+// TagMatch tells whether listed songs need any or all of the filtered tags
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+// TagCount is the number of songs carrying a tag
+type TagCount struct {
+	Name  string
+	Count int64
+}
+
+// TagChange reports a bulk tag or untag. MissingIDs lists the requested
+// songs that do not exist; Changed counts the song-tag links added or removed.
+type TagChange struct {
+	Tags       []string
+	SongIDs    []int
+	MissingIDs []int
+	Changed    int64
+}
+
+// NormalizeTags lowercases tags, collapses their whitespace and drops
+// repeated ones, keeping the first occurrence order
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+		if tag == "" || utf8.RuneCountInString(tag) > MaxTagLength || strings.Contains(tag, ",") {
+			return nil, ErrInvalidTag
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
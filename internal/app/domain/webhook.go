@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"net/url"
+	"time"
+)
+
+// MaxWebhookAttempts is the number of attempts after which a delivery is
+// given up as failed, until redelivered by hand
+const MaxWebhookAttempts = 8
+
+// WebhookSubscription notifies a partner URL of the song events of the
+// given types, in requests signed with Secret
+type WebhookSubscription struct {
+	ID         int
+	URL        string
+	EventTypes []SongEventType
+	Secret     string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validate checks the URL is an absolute http(s) URL and the event types
+// are those of song writes, at least one
+func (s WebhookSubscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhook
+	}
+	if len(s.EventTypes) == 0 {
+		return ErrInvalidWebhook
+	}
+	for _, eventType := range s.EventTypes {
+		switch eventType {
+		case SongEventCreated, SongEventUpdated, SongEventDeleted:
+		default:
+			return ErrInvalidWebhook
+		}
+	}
+	return nil
+}
+
+// Wants tells whether the subscription is notified of events of a type
+func (s WebhookSubscription) Wants(eventType SongEventType) bool {
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return s.Active
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus is where a delivery stands
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is the notification of a subscription of an outbox event
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int
+	EventID        int64
+	EventType      SongEventType
+	// Payload is the JSON document posted
+	Payload       []byte
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	// ResponseCode and LastError come from the last attempt, ResponseCode
+	// being 0 when no response was received
+	ResponseCode int
+	LastError    string
+	CreatedAt    time.Time
+	DeliveredAt  *time.Time
+	// Log lists the attempts, oldest first, when loaded
+	Log []WebhookAttempt
+}
+
+// WebhookAttempt is one request of a delivery
+type WebhookAttempt struct {
+	At           time.Time
+	ResponseCode int
+	Error        string
+	Duration     time.Duration
+}
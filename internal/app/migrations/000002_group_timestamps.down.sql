@@ -0,0 +1,4 @@
+-- down.sql
+ALTER TABLE groups
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS updated_at;
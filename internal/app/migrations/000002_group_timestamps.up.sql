@@ -0,0 +1,4 @@
+-- up.sql
+ALTER TABLE groups
+    ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT NOW();
@@ -0,0 +1,2 @@
+-- down.sql
+DROP TABLE IF EXISTS idempotency_keys;
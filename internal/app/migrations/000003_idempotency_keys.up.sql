@@ -0,0 +1,13 @@
+-- up.sql
+CREATE TABLE idempotency_keys (
+                                  key VARCHAR(255) PRIMARY KEY,
+                                  fingerprint VARCHAR(64) NOT NULL,
+                                  completed BOOLEAN NOT NULL DEFAULT FALSE,
+                                  status_code INTEGER NOT NULL DEFAULT 0,
+                                  content_type VARCHAR(255) NOT NULL DEFAULT '',
+                                  response BYTEA,
+                                  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                  expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
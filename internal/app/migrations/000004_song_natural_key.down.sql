@@ -0,0 +1,5 @@
+-- down.sql
+DROP INDEX IF EXISTS idx_songs_natural_key;
+ALTER TABLE songs DROP COLUMN IF EXISTS legacy_duplicate;
+ALTER TABLE songs DROP COLUMN IF EXISTS title_key;
+DROP FUNCTION IF EXISTS song_title_key(TEXT);
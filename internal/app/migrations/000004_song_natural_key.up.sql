@@ -0,0 +1,24 @@
+-- up.sql
+-- Natural key of a song title: lower-cased, punctuation removed, whitespace collapsed.
+-- Keep in sync with domain.NormalizeTitle.
+CREATE FUNCTION song_title_key(title TEXT) RETURNS TEXT
+    LANGUAGE SQL IMMUTABLE PARALLEL SAFE
+AS $$
+SELECT btrim(regexp_replace(regexp_replace(lower(title), '[^[:alnum:][:space:]]+', '', 'g'), '[[:space:]]+', ' ', 'g'))
+$$;
+
+ALTER TABLE songs ADD COLUMN title_key TEXT GENERATED ALWAYS AS (song_title_key(title)) STORED;
+
+-- Duplicates that existed before the constraint are flagged rather than removed:
+-- all but the oldest song of a set are exempt from the unique index until merged.
+ALTER TABLE songs ADD COLUMN legacy_duplicate BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE songs s
+SET legacy_duplicate = TRUE
+FROM (
+    SELECT id, MIN(id) OVER (PARTITION BY group_id, title_key) AS keep_id
+    FROM songs
+) d
+WHERE s.id = d.id AND d.id <> d.keep_id;
+
+CREATE UNIQUE INDEX idx_songs_natural_key ON songs (group_id, title_key) WHERE NOT legacy_duplicate;
@@ -0,0 +1,10 @@
+-- up.sql
+CREATE TABLE api_keys (
+                          id SERIAL PRIMARY KEY,
+                          name VARCHAR(255) NOT NULL,
+                          prefix VARCHAR(16) NOT NULL,
+                          key_hash CHAR(64) UNIQUE NOT NULL,
+                          role VARCHAR(16) NOT NULL CHECK (role IN ('reader', 'editor', 'admin')),
+                          created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                          revoked_at TIMESTAMP
+);
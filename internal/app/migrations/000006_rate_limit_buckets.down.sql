@@ -0,0 +1,2 @@
+-- down.sql
+DROP TABLE IF EXISTS rate_limit_buckets;
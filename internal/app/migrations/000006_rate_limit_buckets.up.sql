@@ -0,0 +1,9 @@
+-- up.sql
+CREATE TABLE rate_limit_buckets (
+                                    key VARCHAR(255) PRIMARY KEY,
+                                    tokens DOUBLE PRECISION NOT NULL,
+                                    allowed BOOLEAN NOT NULL,
+                                    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_rate_limit_buckets_updated_at ON rate_limit_buckets (updated_at);
@@ -0,0 +1,4 @@
+-- down.sql
+DROP TABLE IF EXISTS playlist_songs;
+DROP FUNCTION IF EXISTS playlist_songs_compact();
+DROP TABLE IF EXISTS playlists;
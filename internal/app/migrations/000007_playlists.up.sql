@@ -0,0 +1,43 @@
+-- up.sql
+CREATE TABLE playlists (
+                           id SERIAL PRIMARY KEY,
+                           name VARCHAR(255) NOT NULL,
+                           created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                           updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- The key is deferrable so statements shifting positions only need it to
+-- hold once they finish
+CREATE TABLE playlist_songs (
+                                playlist_id INTEGER NOT NULL REFERENCES playlists(id) ON DELETE CASCADE,
+                                position INTEGER NOT NULL CHECK (position > 0),
+                                song_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                                added_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                PRIMARY KEY (playlist_id, position) DEFERRABLE INITIALLY IMMEDIATE
+);
+
+CREATE INDEX idx_playlist_songs_song_id ON playlist_songs (song_id);
+
+-- Renumber the playlists that lost entries, whether removed directly or
+-- through a deleted song, so positions stay dense
+CREATE FUNCTION playlist_songs_compact() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    UPDATE playlist_songs ps
+    SET position = r.new_position
+    FROM (
+        SELECT playlist_id, position, ROW_NUMBER() OVER (PARTITION BY playlist_id ORDER BY position) AS new_position
+        FROM playlist_songs
+        WHERE playlist_id IN (SELECT DISTINCT playlist_id FROM removed)
+    ) r
+    WHERE ps.playlist_id = r.playlist_id AND ps.position = r.position AND ps.position <> r.new_position;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER playlist_songs_compact
+    AFTER DELETE ON playlist_songs
+    REFERENCING OLD TABLE AS removed
+    FOR EACH STATEMENT
+EXECUTE FUNCTION playlist_songs_compact();
@@ -0,0 +1,26 @@
+-- up.sql
+CREATE TABLE albums (
+                        id SERIAL PRIMARY KEY,
+                        group_id INTEGER NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+                        title VARCHAR(255) NOT NULL,
+                        release_date TIMESTAMP NOT NULL,
+                        type VARCHAR(16) NOT NULL CHECK (type IN ('album', 'single', 'ep')),
+                        cover_url VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_albums_group_id ON albums (group_id);
+
+CREATE TABLE album_tracks (
+                              album_id INTEGER NOT NULL REFERENCES albums(id) ON DELETE CASCADE,
+                              disc_number INTEGER NOT NULL DEFAULT 1 CHECK (disc_number > 0),
+                              track_number INTEGER NOT NULL CHECK (track_number > 0),
+                              song_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                              inherit_release_date BOOLEAN NOT NULL DEFAULT FALSE,
+                              PRIMARY KEY (album_id, disc_number, track_number),
+                              UNIQUE (album_id, song_id)
+);
+
+CREATE INDEX idx_album_tracks_song_id ON album_tracks (song_id);
+
+-- A song follows the release date of one album at most
+CREATE UNIQUE INDEX idx_album_tracks_inherit ON album_tracks (song_id) WHERE inherit_release_date;
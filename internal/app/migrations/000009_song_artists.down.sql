@@ -0,0 +1,4 @@
+-- down.sql
+DROP TRIGGER IF EXISTS songs_primary_artist ON songs;
+DROP FUNCTION IF EXISTS songs_primary_artist();
+DROP TABLE IF EXISTS song_artists;
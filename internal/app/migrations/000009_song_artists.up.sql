@@ -0,0 +1,33 @@
+-- up.sql
+CREATE TABLE song_artists (
+                              song_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                              group_id INTEGER NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+                              role VARCHAR(16) NOT NULL CHECK (role IN ('primary', 'featured', 'composer', 'lyricist', 'producer')),
+                              PRIMARY KEY (song_id, group_id, role)
+);
+
+CREATE INDEX idx_song_artists_group_id ON song_artists (group_id);
+
+INSERT INTO song_artists (song_id, group_id, role)
+SELECT id, group_id, 'primary' FROM songs;
+
+-- songs.group_id stays the main artist of a song and is always credited as
+-- primary, whichever path wrote the song
+CREATE FUNCTION songs_primary_artist() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.group_id <> NEW.group_id THEN
+        DELETE FROM song_artists WHERE song_id = NEW.id AND group_id = OLD.group_id AND role = 'primary';
+    END IF;
+    INSERT INTO song_artists (song_id, group_id, role)
+    VALUES (NEW.id, NEW.group_id, 'primary')
+    ON CONFLICT DO NOTHING;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER songs_primary_artist
+    AFTER INSERT OR UPDATE OF group_id ON songs
+    FOR EACH ROW
+EXECUTE FUNCTION songs_primary_artist();
@@ -0,0 +1,31 @@
+-- up.sql
+-- Sub-genres go away with their parent
+CREATE TABLE genres (
+                        id SERIAL PRIMARY KEY,
+                        parent_id INTEGER REFERENCES genres(id) ON DELETE CASCADE,
+                        name VARCHAR(64) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_genres_parent_name ON genres (COALESCE(parent_id, 0), LOWER(name));
+
+CREATE TABLE song_genres (
+                             song_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                             genre_id INTEGER NOT NULL REFERENCES genres(id) ON DELETE CASCADE,
+                             PRIMARY KEY (song_id, genre_id)
+);
+
+CREATE INDEX idx_song_genres_genre_id ON song_genres (genre_id);
+
+-- Tag names are stored normalized: lowercase with single spaces
+CREATE TABLE tags (
+                      id SERIAL PRIMARY KEY,
+                      name VARCHAR(64) NOT NULL UNIQUE
+);
+
+CREATE TABLE song_tags (
+                           song_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                           tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+                           PRIMARY KEY (song_id, tag_id)
+);
+
+CREATE INDEX idx_song_tags_tag_id ON song_tags (tag_id);
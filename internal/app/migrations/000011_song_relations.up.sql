@@ -0,0 +1,12 @@
+-- up.sql
+-- song_id is a version of original_id; the service keeps originals acyclic
+CREATE TABLE song_relations (
+                                song_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                                original_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                                type VARCHAR(16) NOT NULL CHECK (type IN ('cover', 'remix', 'live', 'translation')),
+                                created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                PRIMARY KEY (song_id, original_id),
+                                CHECK (song_id <> original_id)
+);
+
+CREATE INDEX idx_song_relations_original_id ON song_relations (original_id);
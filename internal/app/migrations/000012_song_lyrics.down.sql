@@ -0,0 +1,4 @@
+-- down.sql
+DROP TRIGGER IF EXISTS songs_original_lyrics ON songs;
+DROP FUNCTION IF EXISTS songs_original_lyrics();
+DROP TABLE IF EXISTS song_lyrics;
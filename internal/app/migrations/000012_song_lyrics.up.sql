@@ -0,0 +1,32 @@
+-- up.sql
+-- Lyrics of songs by language. The original variant mirrors songs.text,
+-- the other ones are translations of it.
+CREATE TABLE song_lyrics (
+                             song_id INTEGER NOT NULL REFERENCES songs(id) ON DELETE CASCADE,
+                             language VARCHAR(35) NOT NULL,
+                             text TEXT NOT NULL,
+                             original BOOLEAN NOT NULL DEFAULT FALSE,
+                             translator VARCHAR(255) NOT NULL DEFAULT '',
+                             source VARCHAR(255) NOT NULL DEFAULT '',
+                             created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                             updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                             PRIMARY KEY (song_id, language)
+);
+
+CREATE UNIQUE INDEX idx_song_lyrics_original ON song_lyrics (song_id) WHERE original;
+
+-- Edits of songs.text, whichever path wrote them, reach the original variant
+CREATE FUNCTION songs_original_lyrics() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    UPDATE song_lyrics SET text = NEW.text, updated_at = NOW()
+    WHERE song_id = NEW.id AND original AND text <> NEW.text;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER songs_original_lyrics
+    AFTER UPDATE OF text ON songs
+    FOR EACH ROW
+EXECUTE FUNCTION songs_original_lyrics();
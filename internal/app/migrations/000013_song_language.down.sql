@@ -0,0 +1,6 @@
+-- down.sql
+DROP INDEX IF EXISTS idx_songs_language;
+ALTER TABLE songs
+    DROP COLUMN IF EXISTS script,
+    DROP COLUMN IF EXISTS language_confidence,
+    DROP COLUMN IF EXISTS language;
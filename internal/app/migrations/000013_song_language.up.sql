@@ -0,0 +1,9 @@
+-- up.sql
+-- Language and script detected from songs.text by the application. Writes
+-- that bypass detection leave them empty until a backfill.
+ALTER TABLE songs
+    ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT '',
+    ADD COLUMN language_confidence REAL NOT NULL DEFAULT 0,
+    ADD COLUMN script VARCHAR(4) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_songs_language ON songs (language);
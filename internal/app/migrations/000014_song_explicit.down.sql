@@ -0,0 +1,4 @@
+-- down.sql
+ALTER TABLE songs
+    DROP COLUMN IF EXISTS explicit_manual,
+    DROP COLUMN IF EXISTS explicit;
@@ -0,0 +1,6 @@
+-- up.sql
+-- Explicit lyrics, flagged by editors (explicit_manual) or by scanning
+-- songs.text with the configured wordlists
+ALTER TABLE songs
+    ADD COLUMN explicit BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN explicit_manual BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,13 @@
+-- up.sql
+-- Change feed of songs: every create, update and delete, numbered by seq.
+-- song holds the song as written, NULL for deletes. Events are purged
+-- after the configured retention.
+CREATE TABLE song_events (
+    seq BIGSERIAL PRIMARY KEY,
+    type VARCHAR(16) NOT NULL,
+    song_id INT NOT NULL,
+    song JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_song_events_created_at ON song_events (created_at);
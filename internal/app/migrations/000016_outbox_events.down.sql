@@ -0,0 +1,5 @@
+-- down.sql
+DROP TRIGGER IF EXISTS songs_outbox_update ON songs;
+DROP TRIGGER IF EXISTS songs_outbox_insert_delete ON songs;
+DROP FUNCTION IF EXISTS songs_outbox();
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,48 @@
+-- up.sql
+-- Transactional outbox: every insert, update and delete of a song records an
+-- event, in the same transaction, for the relay to deliver to the configured
+-- sinks. An event is pending until delivered_at is set; next_attempt_at
+-- doubles as the lease of the relay delivering it. Delivered events are
+-- purged after the configured retention.
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(16) NOT NULL,
+    song_id INT NOT NULL,
+    payload JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ
+);
+
+-- Pending events in order, per song for the relay
+CREATE INDEX idx_outbox_events_pending ON outbox_events (song_id, id) WHERE delivered_at IS NULL;
+CREATE INDEX idx_outbox_events_delivered_at ON outbox_events (delivered_at) WHERE delivered_at IS NOT NULL;
+
+-- payload is the song as written, without the columns kept for the natural key
+CREATE FUNCTION songs_outbox() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO outbox_events (type, song_id) VALUES ('deleted', OLD.id);
+    ELSE
+        INSERT INTO outbox_events (type, song_id, payload)
+        VALUES (CASE TG_OP WHEN 'INSERT' THEN 'created' ELSE 'updated' END, NEW.id,
+                to_jsonb(NEW) - 'title_key' - 'legacy_duplicate');
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER songs_outbox_insert_delete
+    AFTER INSERT OR DELETE ON songs
+    FOR EACH ROW
+EXECUTE FUNCTION songs_outbox();
+
+CREATE TRIGGER songs_outbox_update
+    AFTER UPDATE ON songs
+    FOR EACH ROW
+    WHEN (OLD IS DISTINCT FROM NEW)
+EXECUTE FUNCTION songs_outbox();
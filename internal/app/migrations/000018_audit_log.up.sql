@@ -0,0 +1,44 @@
+-- up.sql
+-- Changes to songs and groups: who made them, through which request, and
+-- the record before and after
+CREATE TABLE audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    actor_name VARCHAR(255) NOT NULL DEFAULT '',
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    transport VARCHAR(16) NOT NULL DEFAULT '',
+    resource VARCHAR(16) NOT NULL,
+    resource_id INT NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    before JSONB,
+    after JSONB
+);
+
+CREATE INDEX idx_audit_log_resource ON audit_log (resource, resource_id, id);
+CREATE INDEX idx_audit_log_actor ON audit_log (actor, id);
+CREATE INDEX idx_audit_log_request_id ON audit_log (request_id) WHERE request_id <> '';
+CREATE INDEX idx_audit_log_created_at ON audit_log (created_at);
+
+-- The log is append-only: entries are never changed, and only removed by
+-- the retention purge, which sets audit.purge for its transaction
+CREATE FUNCTION audit_log_append_only() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF TG_OP = 'DELETE' AND current_setting('audit.purge', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW
+EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT
+EXECUTE FUNCTION audit_log_append_only();
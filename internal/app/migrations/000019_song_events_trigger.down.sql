@@ -0,0 +1,5 @@
+-- down.sql
+DROP TRIGGER IF EXISTS songs_change_feed_update ON songs;
+DROP TRIGGER IF EXISTS songs_change_feed_insert_delete ON songs;
+DROP FUNCTION IF EXISTS songs_change_feed();
+DROP TABLE IF EXISTS song_event_log;
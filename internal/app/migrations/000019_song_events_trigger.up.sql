@@ -0,0 +1,48 @@
+-- up.sql
+-- Record the change feed from a trigger, like the outbox, so every insert,
+-- update and delete of a song is an event whatever wrote it: the API,
+-- imports, merges, album release dates or backfills. song holds the song as
+-- written, without the columns kept for the natural key, encoded like the
+-- outbox payload.
+--
+-- Writers do not number events: numbers taken while writing become visible
+-- in commit order, so readers could skip an event committed late. The
+-- trigger appends to song_event_log with the ID of the writing transaction
+-- instead, and readers move the events of the transactions older than every
+-- one still running, which can no longer change, into song_events in the
+-- order of their transaction IDs. Long-running transactions hold the feed
+-- back until they end.
+CREATE TABLE song_event_log (
+    id BIGSERIAL PRIMARY KEY,
+    xid XID8 NOT NULL DEFAULT pg_current_xact_id(),
+    type VARCHAR(16) NOT NULL,
+    song_id INT NOT NULL,
+    song JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE FUNCTION songs_change_feed() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO song_event_log (type, song_id) VALUES ('deleted', OLD.id);
+    ELSE
+        INSERT INTO song_event_log (type, song_id, song)
+        VALUES (CASE TG_OP WHEN 'INSERT' THEN 'created' ELSE 'updated' END, NEW.id,
+                to_jsonb(NEW) - 'title_key' - 'legacy_duplicate');
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER songs_change_feed_insert_delete
+    AFTER INSERT OR DELETE ON songs
+    FOR EACH ROW
+EXECUTE FUNCTION songs_change_feed();
+
+CREATE TRIGGER songs_change_feed_update
+    AFTER UPDATE ON songs
+    FOR EACH ROW
+    WHEN (OLD IS DISTINCT FROM NEW)
+EXECUTE FUNCTION songs_change_feed();
@@ -0,0 +1,146 @@
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// ErrSchemaTooNew is returned when the database was migrated by a newer binary
+var ErrSchemaTooNew = errors.New("database schema is newer than this binary")
+
+// ErrSchemaDirty is returned when the last migration failed half-way
+var ErrSchemaDirty = errors.New("database schema is dirty")
+
+// Migrator applies SQL migrations to the database
+type Migrator struct {
+	m      *migrate.Migrate
+	latest uint
+}
+
+// New creates a migrator. An empty path uses the migrations embedded into the
+// binary, otherwise path is a golang-migrate source URL such as file://migrations.
+func New(path, dsn string) (*Migrator, error) {
+	if dsn == "" {
+		return nil, errors.New("no DSN provided")
+	}
+
+	src, err := openSource(path)
+	if err != nil {
+		return nil, fmt.Errorf("open migrations source: %w", err)
+	}
+
+	latest, err := latestVersion(src)
+	if err != nil {
+		_ = src.Close()
+		return nil, fmt.Errorf("read migrations source: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("migrations", src, dsn)
+	if err != nil {
+		_ = src.Close()
+		return nil, err
+	}
+
+	return &Migrator{m: m, latest: latest}, nil
+}
+
+// Up applies all pending migrations
+func (m *Migrator) Up() error {
+	return ignoreNoChange(m.m.Up())
+}
+
+// Down rolls back all migrations
+func (m *Migrator) Down() error {
+	return ignoreNoChange(m.m.Down())
+}
+
+// Goto migrates up or down to the given version
+func (m *Migrator) Goto(version uint) error {
+	return ignoreNoChange(m.m.Migrate(version))
+}
+
+// Force sets the schema version without running migrations and clears the dirty flag
+func (m *Migrator) Force(version int) error {
+	return m.m.Force(version)
+}
+
+// Status returns the current schema version, its dirty flag and the latest
+// version known to the binary. A database without migrations reports version 0.
+func (m *Migrator) Status() (version uint, dirty bool, latest uint, err error) {
+	version, dirty, err = m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, m.latest, nil
+	}
+	if err != nil {
+		return 0, false, m.latest, err
+	}
+	return version, dirty, m.latest, nil
+}
+
+// CheckSchema refuses schemas this binary cannot serve: dirty ones and ones
+// migrated past the latest migration it ships with.
+func (m *Migrator) CheckSchema() error {
+	version, dirty, latest, err := m.Status()
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("%w: version %d", ErrSchemaDirty, version)
+	}
+	if version > latest {
+		return fmt.Errorf("%w: database is at version %d, latest known is %d", ErrSchemaTooNew, version, latest)
+	}
+	return nil
+}
+
+// Close releases the source and database connections
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.m.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+func openSource(path string) (source.Driver, error) {
+	if path == "" {
+		return iofs.New(files, ".")
+	}
+	return source.Open(path)
+}
+
+func latestVersion(src source.Driver) (uint, error) {
+	version, err := src.First()
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := src.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+package migrations
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	src, err := iofs.New(files, ".")
+	require.NoError(t, err)
+	defer func() {
+		_ = src.Close()
+	}()
+
+	latest, err := latestVersion(src)
+	require.NoError(t, err)
+	assert.Greater(t, latest, uint(0))
+
+	// Every migration must be reversible
+	version, err := src.First()
+	require.NoError(t, err)
+	for {
+		up, _, err := src.ReadUp(version)
+		if assert.NoError(t, err, "missing up migration for version %d", version) {
+			_, _ = io.Copy(io.Discard, up)
+			_ = up.Close()
+		}
+		down, _, err := src.ReadDown(version)
+		if assert.NoError(t, err, "missing down migration for version %d", version) {
+			_ = down.Close()
+		}
+
+		if version == latest {
+			break
+		}
+		version, err = src.Next(version)
+		require.NoError(t, err)
+	}
+}
+
+func TestOpenSource_FilePath(t *testing.T) {
+	src, err := openSource("file://.")
+	require.NoError(t, err)
+	defer func() {
+		_ = src.Close()
+	}()
+
+	embedded, err := openSource("")
+	require.NoError(t, err)
+	defer func() {
+		_ = embedded.Close()
+	}()
+
+	fromFile, err := latestVersion(src)
+	require.NoError(t, err)
+	fromEmbed, err := latestVersion(embedded)
+	require.NoError(t, err)
+	assert.Equal(t, fromEmbed, fromFile)
+}
+
+// Writers bypassing the SongService, such as imports, merges, album release
+// dates and backfills, must reach the change feed and the outbox all the same
+func TestSongTriggersCoverEveryWrite(t *testing.T) {
+	for _, name := range []string{"000016_outbox_events.up.sql", "000019_song_events_trigger.up.sql"} {
+		up, err := files.ReadFile(name)
+		require.NoError(t, err)
+		sql := strings.Join(strings.Fields(string(up)), " ")
+		assert.Contains(t, sql, "AFTER INSERT OR DELETE ON songs FOR EACH ROW", name)
+		assert.Contains(t, sql, "AFTER UPDATE ON songs FOR EACH ROW", name)
+		// Both encode the song the same way, without serializing writers
+		assert.Contains(t, sql, "to_jsonb(NEW) - 'title_key' - 'legacy_duplicate'", name)
+		assert.NotContains(t, sql, "pg_advisory", name)
+	}
+}
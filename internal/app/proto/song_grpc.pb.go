@@ -27,6 +27,12 @@ type SongServiceClient interface {
 	CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*CreateSongResponse, error)
 	UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*UpdateSongResponse, error)
 	DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*DeleteSongResponse, error)
+	ExportSongs(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongService_ExportSongsClient, error)
+	BatchCreateSongs(ctx context.Context, in *BatchCreateSongsRequest, opts ...grpc.CallOption) (*BatchSongsResponse, error)
+	BatchUpdateSongs(ctx context.Context, in *BatchUpdateSongsRequest, opts ...grpc.CallOption) (*BatchSongsResponse, error)
+	BatchDeleteSongs(ctx context.Context, in *BatchDeleteSongsRequest, opts ...grpc.CallOption) (*BatchSongsResponse, error)
+	SimilarSongs(ctx context.Context, in *SimilarSongsRequest, opts ...grpc.CallOption) (*SimilarSongsResponse, error)
+	WatchSongs(ctx context.Context, in *WatchSongsRequest, opts ...grpc.CallOption) (SongService_WatchSongsClient, error)
 }
 
 type songServiceClient struct {
@@ -82,6 +88,106 @@ func (c *songServiceClient) DeleteSong(ctx context.Context, in *DeleteSongReques
 	return out, nil
 }
 
+func (c *songServiceClient) ExportSongs(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongService_ExportSongsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SongService_ServiceDesc.Streams[0], "/song.v1.SongService/ExportSongs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &songServiceExportSongsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SongService_ExportSongsClient interface {
+	Recv() (*Song, error)
+	grpc.ClientStream
+}
+
+type songServiceExportSongsClient struct {
+	grpc.ClientStream
+}
+
+func (x *songServiceExportSongsClient) Recv() (*Song, error) {
+	m := new(Song)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *songServiceClient) BatchCreateSongs(ctx context.Context, in *BatchCreateSongsRequest, opts ...grpc.CallOption) (*BatchSongsResponse, error) {
+	out := new(BatchSongsResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.SongService/BatchCreateSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) BatchUpdateSongs(ctx context.Context, in *BatchUpdateSongsRequest, opts ...grpc.CallOption) (*BatchSongsResponse, error) {
+	out := new(BatchSongsResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.SongService/BatchUpdateSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) BatchDeleteSongs(ctx context.Context, in *BatchDeleteSongsRequest, opts ...grpc.CallOption) (*BatchSongsResponse, error) {
+	out := new(BatchSongsResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.SongService/BatchDeleteSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) SimilarSongs(ctx context.Context, in *SimilarSongsRequest, opts ...grpc.CallOption) (*SimilarSongsResponse, error) {
+	out := new(SimilarSongsResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.SongService/SimilarSongs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) WatchSongs(ctx context.Context, in *WatchSongsRequest, opts ...grpc.CallOption) (SongService_WatchSongsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SongService_ServiceDesc.Streams[1], "/song.v1.SongService/WatchSongs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &songServiceWatchSongsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SongService_WatchSongsClient interface {
+	Recv() (*SongEvent, error)
+	grpc.ClientStream
+}
+
+type songServiceWatchSongsClient struct {
+	grpc.ClientStream
+}
+
+func (x *songServiceWatchSongsClient) Recv() (*SongEvent, error) {
+	m := new(SongEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SongServiceServer is the server API for SongService service.
 // All implementations must embed UnimplementedSongServiceServer
 // for forward compatibility
@@ -91,6 +197,12 @@ type SongServiceServer interface {
 	CreateSong(context.Context, *CreateSongRequest) (*CreateSongResponse, error)
 	UpdateSong(context.Context, *UpdateSongRequest) (*UpdateSongResponse, error)
 	DeleteSong(context.Context, *DeleteSongRequest) (*DeleteSongResponse, error)
+	ExportSongs(*ExportSongsRequest, SongService_ExportSongsServer) error
+	BatchCreateSongs(context.Context, *BatchCreateSongsRequest) (*BatchSongsResponse, error)
+	BatchUpdateSongs(context.Context, *BatchUpdateSongsRequest) (*BatchSongsResponse, error)
+	BatchDeleteSongs(context.Context, *BatchDeleteSongsRequest) (*BatchSongsResponse, error)
+	SimilarSongs(context.Context, *SimilarSongsRequest) (*SimilarSongsResponse, error)
+	WatchSongs(*WatchSongsRequest, SongService_WatchSongsServer) error
 	mustEmbedUnimplementedSongServiceServer()
 }
 
@@ -113,6 +225,24 @@ func (UnimplementedSongServiceServer) UpdateSong(context.Context, *UpdateSongReq
 func (UnimplementedSongServiceServer) DeleteSong(context.Context, *DeleteSongRequest) (*DeleteSongResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSong not implemented")
 }
+func (UnimplementedSongServiceServer) ExportSongs(*ExportSongsRequest, SongService_ExportSongsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSongs not implemented")
+}
+func (UnimplementedSongServiceServer) BatchCreateSongs(context.Context, *BatchCreateSongsRequest) (*BatchSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateSongs not implemented")
+}
+func (UnimplementedSongServiceServer) BatchUpdateSongs(context.Context, *BatchUpdateSongsRequest) (*BatchSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateSongs not implemented")
+}
+func (UnimplementedSongServiceServer) BatchDeleteSongs(context.Context, *BatchDeleteSongsRequest) (*BatchSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteSongs not implemented")
+}
+func (UnimplementedSongServiceServer) SimilarSongs(context.Context, *SimilarSongsRequest) (*SimilarSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimilarSongs not implemented")
+}
+func (UnimplementedSongServiceServer) WatchSongs(*WatchSongsRequest, SongService_WatchSongsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSongs not implemented")
+}
 func (UnimplementedSongServiceServer) mustEmbedUnimplementedSongServiceServer() {}
 
 // UnsafeSongServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +346,120 @@ func _SongService_DeleteSong_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SongService_ExportSongs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongServiceServer).ExportSongs(m, &songServiceExportSongsServer{stream})
+}
+
+type SongService_ExportSongsServer interface {
+	Send(*Song) error
+	grpc.ServerStream
+}
+
+type songServiceExportSongsServer struct {
+	grpc.ServerStream
+}
+
+func (x *songServiceExportSongsServer) Send(m *Song) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SongService_BatchCreateSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).BatchCreateSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.SongService/BatchCreateSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).BatchCreateSongs(ctx, req.(*BatchCreateSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_BatchUpdateSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).BatchUpdateSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.SongService/BatchUpdateSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).BatchUpdateSongs(ctx, req.(*BatchUpdateSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_BatchDeleteSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).BatchDeleteSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.SongService/BatchDeleteSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).BatchDeleteSongs(ctx, req.(*BatchDeleteSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_SimilarSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimilarSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).SimilarSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.SongService/SimilarSongs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).SimilarSongs(ctx, req.(*SimilarSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_WatchSongs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongServiceServer).WatchSongs(m, &songServiceWatchSongsServer{stream})
+}
+
+type SongService_WatchSongsServer interface {
+	Send(*SongEvent) error
+	grpc.ServerStream
+}
+
+type songServiceWatchSongsServer struct {
+	grpc.ServerStream
+}
+
+func (x *songServiceWatchSongsServer) Send(m *SongEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // SongService_ServiceDesc is the grpc.ServiceDesc for SongService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +487,709 @@ var SongService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSong",
 			Handler:    _SongService_DeleteSong_Handler,
 		},
+		{
+			MethodName: "BatchCreateSongs",
+			Handler:    _SongService_BatchCreateSongs_Handler,
+		},
+		{
+			MethodName: "BatchUpdateSongs",
+			Handler:    _SongService_BatchUpdateSongs_Handler,
+		},
+		{
+			MethodName: "BatchDeleteSongs",
+			Handler:    _SongService_BatchDeleteSongs_Handler,
+		},
+		{
+			MethodName: "SimilarSongs",
+			Handler:    _SongService_SimilarSongs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSongs",
+			Handler:       _SongService_ExportSongs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchSongs",
+			Handler:       _SongService_WatchSongs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/app/proto/song.proto",
+}
+
+// PlaylistServiceClient is the client API for PlaylistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlaylistServiceClient interface {
+	CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error)
+	RenamePlaylist(ctx context.Context, in *RenamePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*DeletePlaylistResponse, error)
+	AddPlaylistSong(ctx context.Context, in *AddPlaylistSongRequest, opts ...grpc.CallOption) (*Playlist, error)
+	RemovePlaylistSong(ctx context.Context, in *RemovePlaylistSongRequest, opts ...grpc.CallOption) (*Playlist, error)
+	MovePlaylistSong(ctx context.Context, in *MovePlaylistSongRequest, opts ...grpc.CallOption) (*Playlist, error)
+	DuplicatePlaylist(ctx context.Context, in *DuplicatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	ListSongPlaylists(ctx context.Context, in *ListSongPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error)
+}
+
+type playlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlaylistServiceClient(cc grpc.ClientConnInterface) PlaylistServiceClient {
+	return &playlistServiceClient{cc}
+}
+
+func (c *playlistServiceClient) CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/CreatePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/GetPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error) {
+	out := new(ListPlaylistsResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/ListPlaylists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RenamePlaylist(ctx context.Context, in *RenamePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/RenamePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*DeletePlaylistResponse, error) {
+	out := new(DeletePlaylistResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/DeletePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) AddPlaylistSong(ctx context.Context, in *AddPlaylistSongRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/AddPlaylistSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RemovePlaylistSong(ctx context.Context, in *RemovePlaylistSongRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/RemovePlaylistSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) MovePlaylistSong(ctx context.Context, in *MovePlaylistSongRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/MovePlaylistSong", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DuplicatePlaylist(ctx context.Context, in *DuplicatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/DuplicatePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListSongPlaylists(ctx context.Context, in *ListSongPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error) {
+	out := new(ListPlaylistsResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.PlaylistService/ListSongPlaylists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlaylistServiceServer is the server API for PlaylistService service.
+// All implementations must embed UnimplementedPlaylistServiceServer
+// for forward compatibility
+type PlaylistServiceServer interface {
+	CreatePlaylist(context.Context, *CreatePlaylistRequest) (*Playlist, error)
+	GetPlaylist(context.Context, *GetPlaylistRequest) (*Playlist, error)
+	ListPlaylists(context.Context, *ListPlaylistsRequest) (*ListPlaylistsResponse, error)
+	RenamePlaylist(context.Context, *RenamePlaylistRequest) (*Playlist, error)
+	DeletePlaylist(context.Context, *DeletePlaylistRequest) (*DeletePlaylistResponse, error)
+	AddPlaylistSong(context.Context, *AddPlaylistSongRequest) (*Playlist, error)
+	RemovePlaylistSong(context.Context, *RemovePlaylistSongRequest) (*Playlist, error)
+	MovePlaylistSong(context.Context, *MovePlaylistSongRequest) (*Playlist, error)
+	DuplicatePlaylist(context.Context, *DuplicatePlaylistRequest) (*Playlist, error)
+	ListSongPlaylists(context.Context, *ListSongPlaylistsRequest) (*ListPlaylistsResponse, error)
+	mustEmbedUnimplementedPlaylistServiceServer()
+}
+
+// UnimplementedPlaylistServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPlaylistServiceServer struct {
+}
+
+func (UnimplementedPlaylistServiceServer) CreatePlaylist(context.Context, *CreatePlaylistRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetPlaylist(context.Context, *GetPlaylistRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListPlaylists(context.Context, *ListPlaylistsRequest) (*ListPlaylistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RenamePlaylist(context.Context, *RenamePlaylistRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DeletePlaylist(context.Context, *DeletePlaylistRequest) (*DeletePlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) AddPlaylistSong(context.Context, *AddPlaylistSongRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlaylistSong not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RemovePlaylistSong(context.Context, *RemovePlaylistSongRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlaylistSong not implemented")
+}
+func (UnimplementedPlaylistServiceServer) MovePlaylistSong(context.Context, *MovePlaylistSongRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePlaylistSong not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DuplicatePlaylist(context.Context, *DuplicatePlaylistRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicatePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListSongPlaylists(context.Context, *ListSongPlaylistsRequest) (*ListPlaylistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSongPlaylists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) mustEmbedUnimplementedPlaylistServiceServer() {}
+
+// UnsafePlaylistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaylistServiceServer will
+// result in compilation errors.
+type UnsafePlaylistServiceServer interface {
+	mustEmbedUnimplementedPlaylistServiceServer()
+}
+
+func RegisterPlaylistServiceServer(s grpc.ServiceRegistrar, srv PlaylistServiceServer) {
+	s.RegisterService(&PlaylistService_ServiceDesc, srv)
+}
+
+func _PlaylistService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/CreatePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, req.(*CreatePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_GetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).GetPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/GetPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).GetPlaylist(ctx, req.(*GetPlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/ListPlaylists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListPlaylists(ctx, req.(*ListPlaylistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_RenamePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).RenamePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/RenamePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).RenamePlaylist(ctx, req.(*RenamePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).DeletePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/DeletePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).DeletePlaylist(ctx, req.(*DeletePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_AddPlaylistSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlaylistSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).AddPlaylistSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/AddPlaylistSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).AddPlaylistSong(ctx, req.(*AddPlaylistSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_RemovePlaylistSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlaylistSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).RemovePlaylistSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/RemovePlaylistSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).RemovePlaylistSong(ctx, req.(*RemovePlaylistSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_MovePlaylistSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePlaylistSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).MovePlaylistSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/MovePlaylistSong",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).MovePlaylistSong(ctx, req.(*MovePlaylistSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_DuplicatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicatePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).DuplicatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/DuplicatePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).DuplicatePlaylist(ctx, req.(*DuplicatePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListSongPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongPlaylistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListSongPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.PlaylistService/ListSongPlaylists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListSongPlaylists(ctx, req.(*ListSongPlaylistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlaylistService_ServiceDesc is the grpc.ServiceDesc for PlaylistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlaylistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "song.v1.PlaylistService",
+	HandlerType: (*PlaylistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlaylist",
+			Handler:    _PlaylistService_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "GetPlaylist",
+			Handler:    _PlaylistService_GetPlaylist_Handler,
+		},
+		{
+			MethodName: "ListPlaylists",
+			Handler:    _PlaylistService_ListPlaylists_Handler,
+		},
+		{
+			MethodName: "RenamePlaylist",
+			Handler:    _PlaylistService_RenamePlaylist_Handler,
+		},
+		{
+			MethodName: "DeletePlaylist",
+			Handler:    _PlaylistService_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "AddPlaylistSong",
+			Handler:    _PlaylistService_AddPlaylistSong_Handler,
+		},
+		{
+			MethodName: "RemovePlaylistSong",
+			Handler:    _PlaylistService_RemovePlaylistSong_Handler,
+		},
+		{
+			MethodName: "MovePlaylistSong",
+			Handler:    _PlaylistService_MovePlaylistSong_Handler,
+		},
+		{
+			MethodName: "DuplicatePlaylist",
+			Handler:    _PlaylistService_DuplicatePlaylist_Handler,
+		},
+		{
+			MethodName: "ListSongPlaylists",
+			Handler:    _PlaylistService_ListSongPlaylists_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/proto/song.proto",
+}
+
+// AlbumServiceClient is the client API for AlbumService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AlbumServiceClient interface {
+	CreateAlbum(ctx context.Context, in *CreateAlbumRequest, opts ...grpc.CallOption) (*Album, error)
+	GetAlbum(ctx context.Context, in *GetAlbumRequest, opts ...grpc.CallOption) (*Album, error)
+	ListAlbums(ctx context.Context, in *ListAlbumsRequest, opts ...grpc.CallOption) (*ListAlbumsResponse, error)
+	UpdateAlbum(ctx context.Context, in *UpdateAlbumRequest, opts ...grpc.CallOption) (*Album, error)
+	DeleteAlbum(ctx context.Context, in *DeleteAlbumRequest, opts ...grpc.CallOption) (*DeleteAlbumResponse, error)
+	ListAlbumTracks(ctx context.Context, in *ListAlbumTracksRequest, opts ...grpc.CallOption) (*ListAlbumTracksResponse, error)
+}
+
+type albumServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlbumServiceClient(cc grpc.ClientConnInterface) AlbumServiceClient {
+	return &albumServiceClient{cc}
+}
+
+func (c *albumServiceClient) CreateAlbum(ctx context.Context, in *CreateAlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, "/song.v1.AlbumService/CreateAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) GetAlbum(ctx context.Context, in *GetAlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, "/song.v1.AlbumService/GetAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) ListAlbums(ctx context.Context, in *ListAlbumsRequest, opts ...grpc.CallOption) (*ListAlbumsResponse, error) {
+	out := new(ListAlbumsResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.AlbumService/ListAlbums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) UpdateAlbum(ctx context.Context, in *UpdateAlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, "/song.v1.AlbumService/UpdateAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) DeleteAlbum(ctx context.Context, in *DeleteAlbumRequest, opts ...grpc.CallOption) (*DeleteAlbumResponse, error) {
+	out := new(DeleteAlbumResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.AlbumService/DeleteAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) ListAlbumTracks(ctx context.Context, in *ListAlbumTracksRequest, opts ...grpc.CallOption) (*ListAlbumTracksResponse, error) {
+	out := new(ListAlbumTracksResponse)
+	err := c.cc.Invoke(ctx, "/song.v1.AlbumService/ListAlbumTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AlbumServiceServer is the server API for AlbumService service.
+// All implementations must embed UnimplementedAlbumServiceServer
+// for forward compatibility
+type AlbumServiceServer interface {
+	CreateAlbum(context.Context, *CreateAlbumRequest) (*Album, error)
+	GetAlbum(context.Context, *GetAlbumRequest) (*Album, error)
+	ListAlbums(context.Context, *ListAlbumsRequest) (*ListAlbumsResponse, error)
+	UpdateAlbum(context.Context, *UpdateAlbumRequest) (*Album, error)
+	DeleteAlbum(context.Context, *DeleteAlbumRequest) (*DeleteAlbumResponse, error)
+	ListAlbumTracks(context.Context, *ListAlbumTracksRequest) (*ListAlbumTracksResponse, error)
+	mustEmbedUnimplementedAlbumServiceServer()
+}
+
+// UnimplementedAlbumServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAlbumServiceServer struct {
+}
+
+func (UnimplementedAlbumServiceServer) CreateAlbum(context.Context, *CreateAlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) GetAlbum(context.Context, *GetAlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) ListAlbums(context.Context, *ListAlbumsRequest) (*ListAlbumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlbums not implemented")
+}
+func (UnimplementedAlbumServiceServer) UpdateAlbum(context.Context, *UpdateAlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) DeleteAlbum(context.Context, *DeleteAlbumRequest) (*DeleteAlbumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) ListAlbumTracks(context.Context, *ListAlbumTracksRequest) (*ListAlbumTracksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlbumTracks not implemented")
+}
+func (UnimplementedAlbumServiceServer) mustEmbedUnimplementedAlbumServiceServer() {}
+
+// UnsafeAlbumServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlbumServiceServer will
+// result in compilation errors.
+type UnsafeAlbumServiceServer interface {
+	mustEmbedUnimplementedAlbumServiceServer()
+}
+
+func RegisterAlbumServiceServer(s grpc.ServiceRegistrar, srv AlbumServiceServer) {
+	s.RegisterService(&AlbumService_ServiceDesc, srv)
+}
+
+func _AlbumService_CreateAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).CreateAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.AlbumService/CreateAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).CreateAlbum(ctx, req.(*CreateAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_GetAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).GetAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.AlbumService/GetAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).GetAlbum(ctx, req.(*GetAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_ListAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlbumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ListAlbums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.AlbumService/ListAlbums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ListAlbums(ctx, req.(*ListAlbumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_UpdateAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).UpdateAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.AlbumService/UpdateAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).UpdateAlbum(ctx, req.(*UpdateAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_DeleteAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).DeleteAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.AlbumService/DeleteAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).DeleteAlbum(ctx, req.(*DeleteAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_ListAlbumTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlbumTracksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ListAlbumTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/song.v1.AlbumService/ListAlbumTracks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ListAlbumTracks(ctx, req.(*ListAlbumTracksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AlbumService_ServiceDesc is the grpc.ServiceDesc for AlbumService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AlbumService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "song.v1.AlbumService",
+	HandlerType: (*AlbumServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlbum",
+			Handler:    _AlbumService_CreateAlbum_Handler,
+		},
+		{
+			MethodName: "GetAlbum",
+			Handler:    _AlbumService_GetAlbum_Handler,
+		},
+		{
+			MethodName: "ListAlbums",
+			Handler:    _AlbumService_ListAlbums_Handler,
+		},
+		{
+			MethodName: "UpdateAlbum",
+			Handler:    _AlbumService_UpdateAlbum_Handler,
+		},
+		{
+			MethodName: "DeleteAlbum",
+			Handler:    _AlbumService_DeleteAlbum_Handler,
+		},
+		{
+			MethodName: "ListAlbumTracks",
+			Handler:    _AlbumService_ListAlbumTracks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/proto/song.proto",
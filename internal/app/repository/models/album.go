@@ -0,0 +1,75 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type Album struct {
+	ID          int       `gorm:"primaryKey" json:"id"`
+	GroupID     int       `gorm:"not null" json:"group_id"`
+	Title       string    `gorm:"not null" json:"title"`
+	ReleaseDate time.Time `gorm:"not null" json:"release_date"`
+	Type        string    `gorm:"not null" json:"type"`
+	CoverURL    string    `gorm:"column:cover_url;not null" json:"cover_url"`
+}
+
+func (Album) TableName() string {
+	return "albums"
+}
+
+func (a *Album) ToDomain() domain.Album {
+	return domain.Album{
+		ID:          a.ID,
+		GroupID:     a.GroupID,
+		Title:       a.Title,
+		ReleaseDate: a.ReleaseDate,
+		Type:        domain.AlbumType(a.Type),
+		CoverURL:    a.CoverURL,
+	}
+}
+
+func ToAlbumModel(a domain.Album) Album {
+	return Album{
+		ID:          a.ID,
+		GroupID:     a.GroupID,
+		Title:       a.Title,
+		ReleaseDate: a.ReleaseDate,
+		Type:        string(a.Type),
+		CoverURL:    a.CoverURL,
+	}
+}
+
+type AlbumTrack struct {
+	AlbumID            int  `gorm:"primaryKey" json:"album_id"`
+	DiscNumber         int  `gorm:"primaryKey" json:"disc_number"`
+	TrackNumber        int  `gorm:"primaryKey" json:"track_number"`
+	SongID             int  `gorm:"not null" json:"song_id"`
+	InheritReleaseDate bool `gorm:"not null" json:"inherit_release_date"`
+	Song               Song `gorm:"foreignKey:SongID" json:"song"`
+}
+
+func (AlbumTrack) TableName() string {
+	return "album_tracks"
+}
+
+func (t *AlbumTrack) ToDomain() domain.AlbumTrack {
+	song := t.Song.ToDomain()
+	return domain.AlbumTrack{
+		DiscNumber:         t.DiscNumber,
+		TrackNumber:        t.TrackNumber,
+		InheritReleaseDate: t.InheritReleaseDate,
+		SongID:             t.SongID,
+		Song:               &song,
+	}
+}
+
+func ToAlbumTrackModel(albumID int, t domain.AlbumTrack) AlbumTrack {
+	return AlbumTrack{
+		AlbumID:            albumID,
+		DiscNumber:         t.DiscNumber,
+		TrackNumber:        t.TrackNumber,
+		SongID:             t.SongID,
+		InheritReleaseDate: t.InheritReleaseDate,
+	}
+}
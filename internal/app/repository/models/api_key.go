@@ -0,0 +1,44 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type APIKey struct {
+	ID        int        `gorm:"primaryKey" json:"id"`
+	Name      string     `gorm:"not null" json:"name"`
+	Prefix    string     `gorm:"not null" json:"prefix"`
+	KeyHash   string     `gorm:"not null;unique" json:"-"`
+	Role      string     `gorm:"not null" json:"role"`
+	CreatedAt time.Time  `gorm:"not null" json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (k *APIKey) ToDomain() domain.APIKey {
+	return domain.APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Hash:      k.KeyHash,
+		Role:      domain.Role(k.Role),
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
+
+func ToAPIKeyModel(k domain.APIKey) APIKey {
+	return APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		KeyHash:   k.Hash,
+		Role:      string(k.Role),
+		CreatedAt: k.CreatedAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type AuditEntry struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt  time.Time `gorm:"not null" json:"created_at"`
+	Actor      string    `gorm:"not null" json:"actor"`
+	ActorName  string    `gorm:"not null" json:"actor_name"`
+	RequestID  string    `gorm:"not null" json:"request_id"`
+	Transport  string    `gorm:"not null" json:"transport"`
+	Resource   string    `gorm:"not null" json:"resource"`
+	ResourceID int       `gorm:"not null" json:"resource_id"`
+	Action     string    `gorm:"not null" json:"action"`
+	Before     []byte    `gorm:"type:jsonb" json:"before"`
+	After      []byte    `gorm:"type:jsonb" json:"after"`
+}
+
+func (AuditEntry) TableName() string {
+	return "audit_log"
+}
+
+func (e *AuditEntry) ToDomain() domain.AuditEntry {
+	return domain.AuditEntry{
+		ID:         e.ID,
+		Time:       e.CreatedAt,
+		Actor:      e.Actor,
+		ActorName:  e.ActorName,
+		RequestID:  e.RequestID,
+		Transport:  domain.Transport(e.Transport),
+		Resource:   domain.AuditResource(e.Resource),
+		ResourceID: e.ResourceID,
+		Action:     domain.AuditAction(e.Action),
+		Before:     e.Before,
+		After:      e.After,
+	}
+}
+
+func ToAuditEntryModel(e domain.AuditEntry) AuditEntry {
+	return AuditEntry{
+		ID:         e.ID,
+		CreatedAt:  e.Time,
+		Actor:      e.Actor,
+		ActorName:  e.ActorName,
+		RequestID:  e.RequestID,
+		Transport:  string(e.Transport),
+		Resource:   string(e.Resource),
+		ResourceID: e.ResourceID,
+		Action:     string(e.Action),
+		Before:     e.Before,
+		After:      e.After,
+	}
+}
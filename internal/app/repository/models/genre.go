@@ -0,0 +1,49 @@
+package models
+
+import "songs/internal/app/domain"
+
+type Genre struct {
+	ID        int    `gorm:"primaryKey" json:"id"`
+	ParentID  *int   `json:"parent_id"`
+	Name      string `gorm:"not null" json:"name"`
+	Path      string `gorm:"->;-:migration" json:"path"`
+	SongCount int64  `gorm:"->;-:migration" json:"song_count"`
+}
+
+func (Genre) TableName() string {
+	return "genres"
+}
+
+func (g *Genre) ToDomain() domain.Genre {
+	genre := domain.Genre{
+		ID:        g.ID,
+		Name:      g.Name,
+		Path:      g.Path,
+		SongCount: g.SongCount,
+	}
+	if g.ParentID != nil {
+		genre.ParentID = *g.ParentID
+	}
+	return genre
+}
+
+func ToGenreModel(g domain.Genre) Genre {
+	genre := Genre{
+		ID:   g.ID,
+		Name: g.Name,
+	}
+	if g.ParentID > 0 {
+		parentID := g.ParentID
+		genre.ParentID = &parentID
+	}
+	return genre
+}
+
+type SongGenre struct {
+	SongID  int `gorm:"primaryKey" json:"song_id"`
+	GenreID int `gorm:"primaryKey" json:"genre_id"`
+}
+
+func (SongGenre) TableName() string {
+	return "song_genres"
+}
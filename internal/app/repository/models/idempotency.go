@@ -0,0 +1,47 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type IdempotencyKey struct {
+	Key         string    `gorm:"primaryKey" json:"key"`
+	Fingerprint string    `gorm:"not null" json:"fingerprint"`
+	Completed   bool      `gorm:"not null" json:"completed"`
+	StatusCode  int       `gorm:"not null" json:"status_code"`
+	ContentType string    `gorm:"not null" json:"content_type"`
+	Response    []byte    `json:"response"`
+	CreatedAt   time.Time `gorm:"not null" json:"created_at"`
+	ExpiresAt   time.Time `gorm:"not null" json:"expires_at"`
+}
+
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
+func (k *IdempotencyKey) ToDomain() domain.IdempotencyRecord {
+	return domain.IdempotencyRecord{
+		Key:         k.Key,
+		Fingerprint: k.Fingerprint,
+		Completed:   k.Completed,
+		StatusCode:  k.StatusCode,
+		ContentType: k.ContentType,
+		Response:    k.Response,
+		CreatedAt:   k.CreatedAt,
+		ExpiresAt:   k.ExpiresAt,
+	}
+}
+
+func ToIdempotencyKeyModel(r domain.IdempotencyRecord) IdempotencyKey {
+	return IdempotencyKey{
+		Key:         r.Key,
+		Fingerprint: r.Fingerprint,
+		Completed:   r.Completed,
+		StatusCode:  r.StatusCode,
+		ContentType: r.ContentType,
+		Response:    r.Response,
+		CreatedAt:   r.CreatedAt,
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type Lyrics struct {
+	SongID     int       `gorm:"primaryKey;autoIncrement:false" json:"song_id"`
+	Language   string    `gorm:"primaryKey" json:"language"`
+	Text       string    `gorm:"not null" json:"text"`
+	Original   bool      `gorm:"not null" json:"original"`
+	Translator string    `gorm:"not null" json:"translator"`
+	Source     string    `gorm:"not null" json:"source"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (Lyrics) TableName() string {
+	return "song_lyrics"
+}
+
+func (l *Lyrics) ToDomain() domain.Lyrics {
+	return domain.Lyrics{
+		SongID:     l.SongID,
+		Language:   l.Language,
+		Text:       l.Text,
+		Original:   l.Original,
+		Translator: l.Translator,
+		Source:     l.Source,
+		CreatedAt:  l.CreatedAt,
+		UpdatedAt:  l.UpdatedAt,
+	}
+}
+
+func ToLyricsModel(l domain.Lyrics) Lyrics {
+	return Lyrics{
+		SongID:     l.SongID,
+		Language:   l.Language,
+		Text:       l.Text,
+		Original:   l.Original,
+		Translator: l.Translator,
+		Source:     l.Source,
+	}
+}
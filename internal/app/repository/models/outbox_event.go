@@ -0,0 +1,36 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type OutboxEvent struct {
+	ID            int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Type          string     `gorm:"not null" json:"type"`
+	SongID        int        `gorm:"not null" json:"song_id"`
+	Payload       []byte     `gorm:"type:jsonb" json:"payload"`
+	CreatedAt     time.Time  `gorm:"not null" json:"created_at"`
+	Attempts      int        `gorm:"not null" json:"attempts"`
+	NextAttemptAt time.Time  `gorm:"not null" json:"next_attempt_at"`
+	LastError     string     `gorm:"not null" json:"last_error"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+func (e *OutboxEvent) ToDomain() domain.OutboxEvent {
+	return domain.OutboxEvent{
+		ID:            e.ID,
+		Type:          domain.SongEventType(e.Type),
+		SongID:        e.SongID,
+		Payload:       e.Payload,
+		CreatedAt:     e.CreatedAt,
+		Attempts:      e.Attempts,
+		NextAttemptAt: e.NextAttemptAt,
+		LastError:     e.LastError,
+		DeliveredAt:   e.DeliveredAt,
+	}
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type Playlist struct {
+	ID        int       `gorm:"primaryKey" json:"id"`
+	Name      string    `gorm:"not null" json:"name"`
+	SongCount int       `gorm:"->;-:migration" json:"song_count"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+func (Playlist) TableName() string {
+	return "playlists"
+}
+
+func (p *Playlist) ToDomain() domain.Playlist {
+	return domain.Playlist{
+		ID:        p.ID,
+		Name:      p.Name,
+		SongCount: p.SongCount,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+func ToPlaylistModel(p domain.Playlist) Playlist {
+	return Playlist{
+		ID:        p.ID,
+		Name:      p.Name,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+type PlaylistSong struct {
+	PlaylistID int       `gorm:"primaryKey" json:"playlist_id"`
+	Position   int       `gorm:"primaryKey" json:"position"`
+	SongID     int       `gorm:"not null" json:"song_id"`
+	AddedAt    time.Time `gorm:"not null" json:"added_at"`
+	Song       Song      `gorm:"foreignKey:SongID" json:"song"`
+}
+
+func (PlaylistSong) TableName() string {
+	return "playlist_songs"
+}
+
+func (e *PlaylistSong) ToDomain() domain.PlaylistEntry {
+	song := e.Song.ToDomain()
+	return domain.PlaylistEntry{
+		Position: e.Position,
+		Song:     &song,
+		AddedAt:  e.AddedAt,
+	}
+}
@@ -12,6 +12,12 @@ type Song struct {
 	ReleaseDate time.Time `gorm:"not null" json:"release_date"`
 	Text        string    `json:"text"`
 	Link        string    `json:"link"`
+	// Language, LanguageConfidence and Script are empty until detected
+	Language           string  `json:"language"`
+	LanguageConfidence float64 `json:"language_confidence"`
+	Script             string  `json:"script"`
+	Explicit           bool    `json:"explicit"`
+	ExplicitManual     bool    `json:"explicit_manual"`
 }
 
 func (s Song) TableName() string {
@@ -20,22 +26,32 @@ func (s Song) TableName() string {
 
 func (s *Song) ToDomain() domain.Song {
 	return domain.Song{
-		ID:          s.ID,
-		GroupID:     s.GroupID,
-		Title:       s.Title,
-		ReleaseDate: s.ReleaseDate,
-		Text:        s.Text,
-		Link:        s.Link,
+		ID:                 s.ID,
+		GroupID:            s.GroupID,
+		Title:              s.Title,
+		ReleaseDate:        s.ReleaseDate,
+		Text:               s.Text,
+		Link:               s.Link,
+		Language:           s.Language,
+		LanguageConfidence: s.LanguageConfidence,
+		Script:             s.Script,
+		Explicit:           s.Explicit,
+		ExplicitManual:     s.ExplicitManual,
 	}
 }
 
 func ToDBModel(s domain.Song) Song {
 	return Song{
-		ID:          s.ID,
-		GroupID:     s.GroupID,
-		Title:       s.Title,
-		ReleaseDate: s.ReleaseDate,
-		Text:        s.Text,
-		Link:        s.Link,
+		ID:                 s.ID,
+		GroupID:            s.GroupID,
+		Title:              s.Title,
+		ReleaseDate:        s.ReleaseDate,
+		Text:               s.Text,
+		Link:               s.Link,
+		Language:           s.Language,
+		LanguageConfidence: s.LanguageConfidence,
+		Script:             s.Script,
+		Explicit:           s.Explicit,
+		ExplicitManual:     s.ExplicitManual,
 	}
 }
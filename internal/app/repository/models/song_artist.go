@@ -0,0 +1,42 @@
+package models
+
+import "songs/internal/app/domain"
+
+// SongArtist credits a group on a song in a role
+type SongArtist struct {
+	SongID  int    `gorm:"primaryKey" json:"song_id"`
+	GroupID int    `gorm:"primaryKey" json:"group_id"`
+	Role    string `gorm:"primaryKey" json:"role"`
+}
+
+func (SongArtist) TableName() string {
+	return "song_artists"
+}
+
+// SongCredit is a credit joined with the name of the group
+type SongCredit struct {
+	SongID  int
+	GroupID int
+	Name    string
+	Role    string
+}
+
+func (c *SongCredit) ToDomain() domain.Credit {
+	return domain.Credit{
+		ArtistID:   c.GroupID,
+		ArtistName: c.Name,
+		Role:       domain.CreditRole(c.Role),
+	}
+}
+
+func ToSongArtistModels(songID int, credits []domain.Credit) []SongArtist {
+	artists := make([]SongArtist, len(credits))
+	for i, c := range credits {
+		artists[i] = SongArtist{
+			SongID:  songID,
+			GroupID: c.ArtistID,
+			Role:    string(c.Role),
+		}
+	}
+	return artists
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"songs/internal/app/domain"
+	"time"
+)
+
+type SongEvent struct {
+	Seq       int64     `gorm:"primaryKey;autoIncrement" json:"seq"`
+	Type      string    `gorm:"not null" json:"type"`
+	SongID    int       `gorm:"not null" json:"song_id"`
+	Song      []byte    `gorm:"type:jsonb" json:"song"`
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (SongEvent) TableName() string {
+	return "song_events"
+}
+
+func (e *SongEvent) ToDomain() (domain.SongEvent, error) {
+	event := domain.SongEvent{
+		Seq:    e.Seq,
+		Type:   domain.SongEventType(e.Type),
+		SongID: e.SongID,
+		Time:   e.CreatedAt,
+	}
+	if len(e.Song) > 0 {
+		var payload songPayload
+		if err := json.Unmarshal(e.Song, &payload); err != nil {
+			return domain.SongEvent{}, err
+		}
+		payload.Song.ReleaseDate = time.Time(payload.ReleaseDate)
+		s := payload.Song.ToDomain()
+		event.Song = &s
+	}
+	return event, nil
+}
+
+// songPayload is a song as encoded by to_jsonb in the songs_change_feed
+// trigger, the same as the outbox payload
+type songPayload struct {
+	Song
+	ReleaseDate timestamp `json:"release_date"`
+}
+
+// timestamp is a TIMESTAMP encoded by to_jsonb, without a time zone and with
+// fractional seconds only when there are some
+type timestamp time.Time
+
+func (t *timestamp) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := time.Parse("2006-01-02T15:04:05", value)
+	if err != nil {
+		return err
+	}
+	*t = timestamp(parsed)
+	return nil
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"songs/internal/app/domain"
+	"time"
+)
 
 type SongGroup struct {
 	ID        int       `gorm:"primaryKey" json:"id,omitempty"`
@@ -12,3 +15,12 @@ type SongGroup struct {
 func (SongGroup) TableName() string {
 	return "groups"
 }
+
+func (g *SongGroup) ToDomain() domain.SongGroup {
+	return domain.SongGroup{
+		ID:        g.ID,
+		Name:      g.Name,
+		CreatedAt: g.CreatedAt,
+		UpdatedAt: g.UpdatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"time"
+)
+
+type SongRelation struct {
+	SongID        int       `gorm:"primaryKey;autoIncrement:false" json:"song_id"`
+	OriginalID    int       `gorm:"primaryKey;autoIncrement:false" json:"original_id"`
+	Type          string    `gorm:"not null" json:"type"`
+	CreatedAt     time.Time `gorm:"autoCreateTime" json:"created_at"`
+	SongTitle     string    `gorm:"->;-:migration" json:"song_title"`
+	OriginalTitle string    `gorm:"->;-:migration" json:"original_title"`
+}
+
+func (SongRelation) TableName() string {
+	return "song_relations"
+}
+
+func (r *SongRelation) ToDomain() domain.SongRelation {
+	return domain.SongRelation{
+		SongID:        r.SongID,
+		SongTitle:     r.SongTitle,
+		OriginalID:    r.OriginalID,
+		OriginalTitle: r.OriginalTitle,
+		Type:          domain.RelationType(r.Type),
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func ToSongRelationModel(r domain.SongRelation) SongRelation {
+	return SongRelation{
+		SongID:     r.SongID,
+		OriginalID: r.OriginalID,
+		Type:       string(r.Type),
+	}
+}
@@ -0,0 +1,25 @@
+package models
+
+import "songs/internal/app/domain"
+
+type Tag struct {
+	ID   int    `gorm:"primaryKey" json:"id"`
+	Name string `gorm:"unique;not null" json:"name"`
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// TagCount is a tag with the number of songs carrying it
+type TagCount struct {
+	Name  string
+	Count int64
+}
+
+func (t *TagCount) ToDomain() domain.TagCount {
+	return domain.TagCount{
+		Name:  t.Name,
+		Count: t.Count,
+	}
+}
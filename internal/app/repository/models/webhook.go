@@ -0,0 +1,123 @@
+package models
+
+import (
+	"songs/internal/app/domain"
+	"strings"
+	"time"
+)
+
+type WebhookSubscription struct {
+	ID         int       `gorm:"primaryKey" json:"id"`
+	URL        string    `gorm:"not null" json:"url"`
+	EventTypes string    `gorm:"not null" json:"event_types"`
+	Secret     string    `gorm:"not null" json:"-"`
+	Active     bool      `gorm:"not null" json:"active"`
+	CreatedAt  time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"not null" json:"updated_at"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func (s *WebhookSubscription) ToDomain() domain.WebhookSubscription {
+	eventTypes := []domain.SongEventType{}
+	for _, eventType := range strings.Split(s.EventTypes, ",") {
+		if eventType != "" {
+			eventTypes = append(eventTypes, domain.SongEventType(eventType))
+		}
+	}
+	return domain.WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: eventTypes,
+		Secret:     s.Secret,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+func ToWebhookSubscriptionModel(s domain.WebhookSubscription) WebhookSubscription {
+	eventTypes := make([]string, len(s.EventTypes))
+	for i, eventType := range s.EventTypes {
+		eventTypes[i] = string(eventType)
+	}
+	return WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: strings.Join(eventTypes, ","),
+		Secret:     s.Secret,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+	}
+}
+
+type WebhookDelivery struct {
+	ID             int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubscriptionID int        `gorm:"not null" json:"subscription_id"`
+	EventID        int64      `gorm:"not null" json:"event_id"`
+	EventType      string     `gorm:"not null" json:"event_type"`
+	Payload        []byte     `gorm:"type:jsonb" json:"payload"`
+	Status         string     `gorm:"not null" json:"status"`
+	Attempts       int        `gorm:"not null" json:"attempts"`
+	NextAttemptAt  time.Time  `gorm:"not null" json:"next_attempt_at"`
+	ResponseCode   int        `gorm:"not null" json:"response_code"`
+	LastError      string     `gorm:"not null" json:"last_error"`
+	CreatedAt      time.Time  `gorm:"not null" json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (d *WebhookDelivery) ToDomain() domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      domain.SongEventType(d.EventType),
+		Payload:        d.Payload,
+		Status:         domain.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseCode:   d.ResponseCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+type WebhookAttempt struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	DeliveryID   int64     `gorm:"not null" json:"delivery_id"`
+	AttemptedAt  time.Time `gorm:"not null" json:"attempted_at"`
+	ResponseCode int       `gorm:"not null" json:"response_code"`
+	Error        string    `gorm:"not null" json:"error"`
+	DurationMs   int       `gorm:"not null" json:"duration_ms"`
+}
+
+func (WebhookAttempt) TableName() string {
+	return "webhook_attempts"
+}
+
+func (a *WebhookAttempt) ToDomain() domain.WebhookAttempt {
+	return domain.WebhookAttempt{
+		At:           a.AttemptedAt,
+		ResponseCode: a.ResponseCode,
+		Error:        a.Error,
+		Duration:     time.Duration(a.DurationMs) * time.Millisecond,
+	}
+}
+
+func ToWebhookAttemptModel(deliveryID int64, a domain.WebhookAttempt) WebhookAttempt {
+	return WebhookAttempt{
+		DeliveryID:   deliveryID,
+		AttemptedAt:  a.At,
+		ResponseCode: a.ResponseCode,
+		Error:        a.Error,
+		DurationMs:   int(a.Duration / time.Millisecond),
+	}
+}
@@ -0,0 +1,199 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AlbumRepo implements repository pattern for albums and their tracks
+type AlbumRepo struct {
+	db *gorm.DB
+}
+
+// NewAlbumRepo creates a new album repository
+func NewAlbumRepo(db *gorm.DB) *AlbumRepo {
+	return &AlbumRepo{
+		db: db,
+	}
+}
+
+// CreateAlbum creates an album without tracks
+func (r AlbumRepo) CreateAlbum(ctx context.Context, album *domain.Album) (*domain.Album, error) {
+	dbAlbum := models.ToAlbumModel(*album)
+	dbAlbum.ID = 0
+	if err := conn(ctx, r.db).Create(&dbAlbum).Error; err != nil {
+		if isForeignKeyError(err) {
+			return nil, domain.ErrGroupNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	created := dbAlbum.ToDomain()
+	return &created, nil
+}
+
+// GetAlbum retrieves an album by ID, without its tracks
+func (r AlbumRepo) GetAlbum(ctx context.Context, id int) (*domain.Album, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	var dbAlbum models.Album
+	if err := conn(ctx, r.db).First(&dbAlbum, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	album := dbAlbum.ToDomain()
+	return &album, nil
+}
+
+// ListAlbums retrieves albums ordered by release date, optionally of one group, with pagination
+func (r AlbumRepo) ListAlbums(ctx context.Context, groupID, page, pageSize int) ([]*domain.Album, int64, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, 0, domain.ErrInvalidData
+	}
+
+	query := conn(ctx, r.db).Model(&models.Album{})
+	if groupID > 0 {
+		query = query.Where("group_id = ?", groupID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, domain.ErrDatabase
+	}
+
+	var dbAlbums []models.Album
+	offset := (page - 1) * pageSize
+	if err := query.Order("release_date, id").Offset(offset).Limit(pageSize).Find(&dbAlbums).Error; err != nil {
+		return nil, 0, domain.ErrDatabase
+	}
+
+	albums := make([]*domain.Album, len(dbAlbums))
+	for i, dbAlbum := range dbAlbums {
+		album := dbAlbum.ToDomain()
+		albums[i] = &album
+	}
+	return albums, total, nil
+}
+
+// UpdateAlbum replaces the fields of an album, keeping its tracks
+func (r AlbumRepo) UpdateAlbum(ctx context.Context, id int, album *domain.Album) (*domain.Album, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	result := conn(ctx, r.db).Model(&models.Album{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"group_id":     album.GroupID,
+		"title":        album.Title,
+		"release_date": album.ReleaseDate,
+		"type":         string(album.Type),
+		"cover_url":    album.CoverURL,
+	})
+	if result.Error != nil {
+		if isForeignKeyError(result.Error) {
+			return nil, domain.ErrGroupNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrNotFound
+	}
+
+	return r.GetAlbum(ctx, id)
+}
+
+// DeleteAlbum deletes an album and its track listing; the songs are kept
+func (r AlbumRepo) DeleteAlbum(ctx context.Context, id int) error {
+	if id <= 0 {
+		return domain.ErrInvalidID
+	}
+
+	result := conn(ctx, r.db).Delete(&models.Album{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// GetAlbumTracks retrieves the tracks of an album in disc and track order
+func (r AlbumRepo) GetAlbumTracks(ctx context.Context, albumID int) ([]domain.AlbumTrack, error) {
+	var dbTracks []models.AlbumTrack
+	err := conn(ctx, r.db).Preload("Song").Where("album_id = ?", albumID).Order("disc_number, track_number").Find(&dbTracks).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	tracks := make([]domain.AlbumTrack, len(dbTracks))
+	for i, dbTrack := range dbTracks {
+		tracks[i] = dbTrack.ToDomain()
+	}
+	return tracks, nil
+}
+
+// ReplaceAlbumTracks replaces the track listing of an album
+func (r AlbumRepo) ReplaceAlbumTracks(ctx context.Context, albumID int, tracks []domain.AlbumTrack) error {
+	db := conn(ctx, r.db)
+	if err := db.Where("album_id = ?", albumID).Delete(&models.AlbumTrack{}).Error; err != nil {
+		return domain.ErrDatabase
+	}
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	dbTracks := make([]models.AlbumTrack, len(tracks))
+	for i, track := range tracks {
+		dbTracks[i] = models.ToAlbumTrackModel(albumID, track)
+	}
+	if err := db.Omit(clause.Associations).CreateInBatches(&dbTracks, insertBatchSize).Error; err != nil {
+		switch {
+		case isForeignKeyError(err):
+			return domain.ErrSongNotFound
+		case strings.Contains(err.Error(), "idx_album_tracks_inherit"):
+			return domain.ErrReleaseDateInherited
+		case isDuplicateError(err):
+			return domain.ErrInvalidTrack
+		default:
+			return domain.ErrDatabase
+		}
+	}
+	return nil
+}
+
+// SyncReleaseDates copies the release date of an album to the songs
+// inheriting it, returning the release dates the songs changed had before
+// by song ID
+func (r AlbumRepo) SyncReleaseDates(ctx context.Context, albumID int) (map[int]time.Time, error) {
+	var changed []struct {
+		ID       int
+		Previous time.Time
+	}
+	err := conn(ctx, r.db).Raw(`UPDATE songs SET release_date = a.release_date
+		FROM album_tracks t JOIN albums a ON a.id = t.album_id, songs prev
+		WHERE t.album_id = ? AND t.inherit_release_date AND songs.id = t.song_id AND songs.release_date <> a.release_date
+			AND prev.id = songs.id
+		RETURNING songs.id, prev.release_date AS previous`,
+		albumID).Scan(&changed).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	previous := make(map[int]time.Time, len(changed))
+	for _, song := range changed {
+		previous[song.ID] = song.Previous
+	}
+	return previous, nil
+}
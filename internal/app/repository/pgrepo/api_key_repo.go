@@ -0,0 +1,84 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// APIKeyRepo stores hashed API keys
+type APIKeyRepo struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepo creates a new API key repository
+func NewAPIKeyRepo(db *gorm.DB) *APIKeyRepo {
+	return &APIKeyRepo{
+		db: db,
+	}
+}
+
+// CreateAPIKey stores a new key
+func (r APIKeyRepo) CreateAPIKey(ctx context.Context, key domain.APIKey) (*domain.APIKey, error) {
+	dbKey := models.ToAPIKeyModel(key)
+	if err := conn(ctx, r.db).Create(&dbKey).Error; err != nil {
+		if isDuplicateError(err) {
+			return nil, domain.ErrDuplicate
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	created := dbKey.ToDomain()
+	return &created, nil
+}
+
+// FindAPIKeyByHash returns the key with the given secret hash, revoked or not
+func (r APIKeyRepo) FindAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	var dbKey models.APIKey
+	if err := conn(ctx, r.db).Where("key_hash = ?", hash).First(&dbKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	key := dbKey.ToDomain()
+	return &key, nil
+}
+
+// ListAPIKeys returns all keys ordered by ID
+func (r APIKeyRepo) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	var dbKeys []models.APIKey
+	if err := conn(ctx, r.db).Order("id").Find(&dbKeys).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	keys := make([]*domain.APIKey, len(dbKeys))
+	for i, dbKey := range dbKeys {
+		key := dbKey.ToDomain()
+		keys[i] = &key
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey marks a key as revoked. Revoking a revoked key keeps the original time.
+func (r APIKeyRepo) RevokeAPIKey(ctx context.Context, id int, at time.Time) error {
+	if id <= 0 {
+		return domain.ErrInvalidID
+	}
+
+	result := conn(ctx, r.db).Model(&models.APIKey{}).
+		Where("id = ?", id).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", at))
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
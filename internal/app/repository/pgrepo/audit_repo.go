@@ -0,0 +1,111 @@
+package pgrepo
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// auditInsertBatchSize is the number of audit entries inserted per statement
+const auditInsertBatchSize = 500
+
+// AuditRepo stores the append-only audit log of changes to songs and groups
+type AuditRepo struct {
+	db *gorm.DB
+}
+
+// NewAuditRepo creates a new audit repository
+func NewAuditRepo(db *gorm.DB) *AuditRepo {
+	return &AuditRepo{
+		db: db,
+	}
+}
+
+// AppendAuditEntries adds entries to the log, joining the transaction in ctx
+// so they are only kept along with the changes they record
+func (r AuditRepo) AppendAuditEntries(ctx context.Context, entries []domain.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	dbEntries := make([]models.AuditEntry, len(entries))
+	for i, entry := range entries {
+		dbEntries[i] = models.ToAuditEntryModel(entry)
+	}
+	if err := conn(ctx, r.db).CreateInBatches(&dbEntries, auditInsertBatchSize).Error; err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+// ListAuditEntries returns a page of the entries matching filter, newest
+// first, and the number of entries matching
+func (r AuditRepo) ListAuditEntries(ctx context.Context, filter domain.AuditFilter, page, pageSize int) ([]domain.AuditEntry, int64, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, 0, domain.ErrInvalidData
+	}
+
+	query := conn(ctx, r.db).Model(&models.AuditEntry{})
+	if filter.Resource != "" {
+		query = query.Where("resource = ?", string(filter.Resource))
+	}
+	if filter.ResourceID > 0 {
+		query = query.Where("resource_id = ?", filter.ResourceID)
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", string(filter.Action))
+	}
+	if filter.Transport != "" {
+		query = query.Where("transport = ?", string(filter.Transport))
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("created_at < ?", filter.Until)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, domain.ErrDatabase
+	}
+
+	var dbEntries []models.AuditEntry
+	err := query.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&dbEntries).Error
+	if err != nil {
+		return nil, 0, domain.ErrDatabase
+	}
+
+	entries := make([]domain.AuditEntry, len(dbEntries))
+	for i, dbEntry := range dbEntries {
+		entries[i] = dbEntry.ToDomain()
+	}
+	return entries, total, nil
+}
+
+// DeleteAuditEntriesBefore removes the entries recorded before the given
+// time and returns how many were removed. The log refuses any other removal.
+func (r AuditRepo) DeleteAuditEntriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	var deleted int64
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT set_config('audit.purge', 'on', true)").Error; err != nil {
+			return err
+		}
+		result := tx.Where("created_at < ?", before).Delete(&models.AuditEntry{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, domain.ErrDatabase
+	}
+	return deleted, nil
+}
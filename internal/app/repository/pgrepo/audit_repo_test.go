@@ -0,0 +1,64 @@
+package pgrepo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupAuditTest(t *testing.T) (sqlmock.Sqlmock, *AuditRepo) {
+	_, mock, db := setupMockDB(t, &gorm.Config{SkipDefaultTransaction: true})
+	return mock, NewAuditRepo(db)
+}
+
+func TestListAuditEntries(t *testing.T) {
+	mock, repo := setupAuditTest(t)
+	now := time.Now()
+	filter := domain.AuditFilter{Resource: domain.AuditSong, ResourceID: 123, Action: domain.AuditDelete, Since: now.Add(-time.Hour)}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "audit_log" WHERE resource = $1 AND resource_id = $2 AND action = $3 AND created_at >= $4`)).
+		WithArgs("song", 123, "delete", now.Add(-time.Hour)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "audit_log" WHERE resource = $1 AND resource_id = $2 AND action = $3 AND created_at >= $4 ORDER BY id DESC LIMIT $5 OFFSET $6`)).
+		WithArgs("song", 123, "delete", now.Add(-time.Hour), 20, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "actor", "actor_name", "request_id", "transport", "resource", "resource_id", "action", "before", "after"}).
+			AddRow(9, now, "apikey:2", "ci", "req-1", "grpc", "song", 123, "delete", []byte(`{"id":123}`), nil))
+
+	entries, total, err := repo.ListAuditEntries(context.Background(), filter, 2, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "apikey:2", entries[0].Actor)
+	assert.Equal(t, domain.TransportGRPC, entries[0].Transport)
+	assert.Equal(t, `{"id":123}`, string(entries[0].Before))
+	assert.Nil(t, entries[0].After)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAuditEntriesBefore(t *testing.T) {
+	mock, repo := setupAuditTest(t)
+	before := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT set_config('audit.purge', 'on', true)`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "audit_log" WHERE created_at < $1`)).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectCommit()
+
+	deleted, err := repo.DeleteAuditEntriesBefore(context.Background(), before)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
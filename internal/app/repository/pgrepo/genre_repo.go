@@ -0,0 +1,127 @@
+package pgrepo
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+
+	"gorm.io/gorm"
+)
+
+// genreTreeQuery selects every genre with the path of names leading to it
+// from its top-level ancestor and its number of songs
+const genreTreeQuery = `WITH RECURSIVE tree AS (
+		SELECT id, parent_id, name, CAST(name AS TEXT) AS path FROM genres WHERE parent_id IS NULL
+		UNION ALL
+		SELECT g.id, g.parent_id, g.name, tree.path || ' > ' || g.name FROM genres g JOIN tree ON g.parent_id = tree.id
+	)
+	SELECT tree.*, (SELECT COUNT(*) FROM song_genres sg WHERE sg.genre_id = tree.id) AS song_count FROM tree`
+
+// GenreRepo implements repository pattern for genres and song genres
+type GenreRepo struct {
+	db *gorm.DB
+}
+
+// NewGenreRepo creates a new genre repository
+func NewGenreRepo(db *gorm.DB) *GenreRepo {
+	return &GenreRepo{
+		db: db,
+	}
+}
+
+// CreateGenre creates a genre under its parent, or at the top level when the
+// parent ID is 0
+func (r GenreRepo) CreateGenre(ctx context.Context, genre *domain.Genre) (*domain.Genre, error) {
+	dbGenre := models.ToGenreModel(*genre)
+	if err := conn(ctx, r.db).Create(&dbGenre).Error; err != nil {
+		switch {
+		case isDuplicateError(err):
+			return nil, domain.ErrDuplicate
+		case isForeignKeyError(err):
+			return nil, domain.ErrGenreNotFound
+		default:
+			return nil, domain.ErrDatabase
+		}
+	}
+
+	return r.GetGenre(ctx, dbGenre.ID)
+}
+
+// GetGenre retrieves a genre by ID
+func (r GenreRepo) GetGenre(ctx context.Context, id int) (*domain.Genre, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	genres, err := r.findGenres(ctx, " WHERE tree.id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(genres) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return &genres[0], nil
+}
+
+// ListGenres retrieves every genre ordered by path, so sub-genres follow
+// their parent
+func (r GenreRepo) ListGenres(ctx context.Context) ([]domain.Genre, error) {
+	return r.findGenres(ctx, " ORDER BY path")
+}
+
+// DeleteGenre deletes a genre with its sub-genres
+func (r GenreRepo) DeleteGenre(ctx context.Context, id int) error {
+	if id <= 0 {
+		return domain.ErrInvalidID
+	}
+
+	result := conn(ctx, r.db).Delete(&models.Genre{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// SongGenres retrieves the genres of a song ordered by path
+func (r GenreRepo) SongGenres(ctx context.Context, songID int) ([]domain.Genre, error) {
+	return r.findGenres(ctx, " WHERE tree.id IN (SELECT genre_id FROM song_genres WHERE song_id = ?) ORDER BY path", songID)
+}
+
+// ReplaceSongGenres sets the genres of a song
+func (r GenreRepo) ReplaceSongGenres(ctx context.Context, songID int, genreIDs []int) error {
+	db := conn(ctx, r.db)
+	if err := db.Where("song_id = ?", songID).Delete(&models.SongGenre{}).Error; err != nil {
+		return domain.ErrDatabase
+	}
+	if len(genreIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]models.SongGenre, len(genreIDs))
+	for i, genreID := range genreIDs {
+		rows[i] = models.SongGenre{SongID: songID, GenreID: genreID}
+	}
+	if err := db.Create(&rows).Error; err != nil {
+		if isForeignKeyError(err) {
+			return domain.ErrGenreNotFound
+		}
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+func (r GenreRepo) findGenres(ctx context.Context, clause string, args ...interface{}) ([]domain.Genre, error) {
+	var rows []models.Genre
+	if err := conn(ctx, r.db).Raw(genreTreeQuery+clause, args...).Scan(&rows).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	genres := make([]domain.Genre, len(rows))
+	for i, row := range rows {
+		genres[i] = row.ToDomain()
+	}
+	return genres, nil
+}
@@ -0,0 +1,83 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// GroupRepo implements repository pattern for song groups
+type GroupRepo struct {
+	db *gorm.DB
+}
+
+// NewGroupRepo creates a new group repository
+func NewGroupRepo(db *gorm.DB) *GroupRepo {
+	return &GroupRepo{
+		db: db,
+	}
+}
+
+// GetGroup retrieves a group by ID
+func (r GroupRepo) GetGroup(ctx context.Context, id int) (*domain.SongGroup, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	var dbGroup models.SongGroup
+	result := conn(ctx, r.db).First(&dbGroup, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	group := dbGroup.ToDomain()
+	return &group, nil
+}
+
+// FindGroupByName retrieves a group by its exact name
+func (r GroupRepo) FindGroupByName(ctx context.Context, name string) (*domain.SongGroup, error) {
+	var dbGroup models.SongGroup
+	result := conn(ctx, r.db).Where("name = ?", strings.TrimSpace(name)).First(&dbGroup)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	group := dbGroup.ToDomain()
+	return &group, nil
+}
+
+// GetOrCreateGroup returns the group with the given name, creating it if it does not exist
+func (r GroupRepo) GetOrCreateGroup(ctx context.Context, name string) (*domain.SongGroup, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrRequired
+	}
+
+	dbGroup := models.SongGroup{Name: name}
+	result := conn(ctx, r.db).Where("name = ?", name).FirstOrCreate(&dbGroup)
+	if result.Error != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	group := dbGroup.ToDomain()
+	return &group, nil
+}
+
+// CountGroups returns the total number of groups
+func (r GroupRepo) CountGroups(ctx context.Context) (int64, error) {
+	var total int64
+	if err := conn(ctx, r.db).Model(&models.SongGroup{}).Count(&total).Error; err != nil {
+		return 0, domain.ErrDatabase
+	}
+	return total, nil
+}
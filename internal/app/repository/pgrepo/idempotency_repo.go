@@ -0,0 +1,85 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyRepo stores idempotency keys and the responses they produced
+type IdempotencyRepo struct {
+	db *gorm.DB
+}
+
+// NewIdempotencyRepo creates a new idempotency key repository
+func NewIdempotencyRepo(db *gorm.DB) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		db: db,
+	}
+}
+
+// Reserve inserts a pending record for the key. When the key is already
+// taken it returns false together with the existing record.
+func (r IdempotencyRepo) Reserve(ctx context.Context, record domain.IdempotencyRecord) (bool, *domain.IdempotencyRecord, error) {
+	dbRecord := models.ToIdempotencyKeyModel(record)
+	result := conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&dbRecord)
+	if result.Error != nil {
+		return false, nil, domain.ErrDatabase
+	}
+	if result.RowsAffected == 1 {
+		return true, nil, nil
+	}
+
+	var existing models.IdempotencyKey
+	if err := conn(ctx, r.db).Where("key = ?", record.Key).First(&existing).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Deleted in between, the caller may retry
+			return false, nil, domain.ErrNotFound
+		}
+		return false, nil, domain.ErrDatabase
+	}
+
+	rec := existing.ToDomain()
+	return false, &rec, nil
+}
+
+// Complete stores the response produced for a reserved key
+func (r IdempotencyRepo) Complete(ctx context.Context, key string, statusCode int, contentType string, response []byte) error {
+	result := conn(ctx, r.db).Model(&models.IdempotencyKey{}).
+		Where("key = ?", key).
+		Updates(map[string]interface{}{
+			"completed":    true,
+			"status_code":  statusCode,
+			"content_type": contentType,
+			"response":     response,
+		})
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a key, e.g. to release it after a failed request
+func (r IdempotencyRepo) Delete(ctx context.Context, key string) error {
+	if err := conn(ctx, r.db).Where("key = ?", key).Delete(&models.IdempotencyKey{}).Error; err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+// DeleteExpired removes keys that expired before now and returns how many were removed
+func (r IdempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := conn(ctx, r.db).Where("expires_at < ?", now).Delete(&models.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
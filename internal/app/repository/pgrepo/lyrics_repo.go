@@ -0,0 +1,93 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LyricsRepo implements repository pattern for the language variants of lyrics
+type LyricsRepo struct {
+	db *gorm.DB
+}
+
+// NewLyricsRepo creates a new lyrics repository
+func NewLyricsRepo(db *gorm.DB) *LyricsRepo {
+	return &LyricsRepo{
+		db: db,
+	}
+}
+
+// ListLyrics retrieves the lyrics of a song in every language, the original first
+func (r LyricsRepo) ListLyrics(ctx context.Context, songID int) ([]domain.Lyrics, error) {
+	var rows []models.Lyrics
+	if err := conn(ctx, r.db).Where("song_id = ?", songID).Order("original DESC, language").Find(&rows).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	lyrics := make([]domain.Lyrics, len(rows))
+	for i, row := range rows {
+		lyrics[i] = row.ToDomain()
+	}
+	return lyrics, nil
+}
+
+// GetLyrics retrieves the lyrics of a song in a language
+func (r LyricsRepo) GetLyrics(ctx context.Context, songID int, lang string) (*domain.Lyrics, error) {
+	var row models.Lyrics
+	if err := conn(ctx, r.db).Where("song_id = ? AND language = ?", songID, lang).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	lyrics := row.ToDomain()
+	return &lyrics, nil
+}
+
+// SaveLyrics creates or replaces the lyrics of a song in a language. Saving
+// original lyrics demotes the previous original to a translation; making
+// them the text of the song is left to the caller.
+func (r LyricsRepo) SaveLyrics(ctx context.Context, lyrics domain.Lyrics) (*domain.Lyrics, error) {
+	db := conn(ctx, r.db)
+	row := models.ToLyricsModel(lyrics)
+
+	if row.Original {
+		err := db.Model(&models.Lyrics{}).
+			Where("song_id = ? AND original AND language <> ?", row.SongID, row.Language).
+			Updates(map[string]interface{}{"original": false, "updated_at": gorm.Expr("NOW()")}).Error
+		if err != nil {
+			return nil, domain.ErrDatabase
+		}
+	}
+
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "song_id"}, {Name: "language"}},
+		DoUpdates: clause.AssignmentColumns([]string{"text", "original", "translator", "source", "updated_at"}),
+	}).Create(&row).Error
+	if err != nil {
+		if isForeignKeyError(err) {
+			return nil, domain.ErrSongNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	return r.GetLyrics(ctx, row.SongID, row.Language)
+}
+
+// DeleteLyrics removes the lyrics of a song in a language
+func (r LyricsRepo) DeleteLyrics(ctx context.Context, songID int, lang string) error {
+	result := conn(ctx, r.db).Where("song_id = ? AND language = ?", songID, lang).Delete(&models.Lyrics{})
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package pgrepo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupLyricsTest(t *testing.T) (sqlmock.Sqlmock, *LyricsRepo) {
+	_, mock, db := setupMockDB(t, &gorm.Config{SkipDefaultTransaction: true})
+	return mock, NewLyricsRepo(db)
+}
+
+func TestSaveLyrics_Original(t *testing.T) {
+	mock, repo := setupLyricsTest(t)
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "song_lyrics" SET "original"=$1,"updated_at"=NOW() WHERE song_id = $2 AND original AND language <> $3`)).
+		WithArgs(false, 1, "en").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "song_lyrics"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "song_lyrics" WHERE song_id = $1 AND language = $2`)).
+		WithArgs(1, "en", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"song_id", "language", "text", "original", "translator", "source", "created_at", "updated_at"}).
+			AddRow(1, "en", "The sea", true, "", "", now, now))
+
+	lyrics, err := repo.SaveLyrics(context.Background(), domain.Lyrics{SongID: 1, Language: "en", Text: "The sea", Original: true})
+
+	assert.NoError(t, err)
+	assert.True(t, lyrics.Original)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveLyrics_UnknownSong(t *testing.T) {
+	mock, repo := setupLyricsTest(t)
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "song_lyrics"`)).
+		WillReturnError(&pgError{"insert or update on table \"song_lyrics\" violates foreign key constraint \"song_lyrics_song_id_fkey\" (SQLSTATE 23503)"})
+
+	_, err := repo.SaveLyrics(context.Background(), domain.Lyrics{SongID: 9, Language: "en", Text: "The sea"})
+
+	assert.ErrorIs(t, err, domain.ErrSongNotFound)
+}
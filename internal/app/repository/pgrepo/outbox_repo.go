@@ -0,0 +1,140 @@
+package pgrepo
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OutboxRepo reads the outbox written by the songs_outbox trigger, in the
+// transaction of every song write, and tracks the delivery of its events
+type OutboxRepo struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepo creates a new outbox repository
+func NewOutboxRepo(db *gorm.DB) *OutboxRepo {
+	return &OutboxRepo{
+		db: db,
+	}
+}
+
+// ClaimOutboxEvents leases up to limit pending events due by now until
+// leaseUntil, counting an attempt for each, and returns them in order. Only
+// the oldest pending event of a song is ever claimed, so the events of a
+// song are delivered one at a time and in order, whatever the number of
+// relays.
+func (r OutboxRepo) ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit int) ([]domain.OutboxEvent, error) {
+	var dbEvents []models.OutboxEvent
+	err := conn(ctx, r.db).Raw(`UPDATE outbox_events SET attempts = attempts + 1, next_attempt_at = ?
+		WHERE id IN (
+			SELECT o.id FROM outbox_events o
+			WHERE o.delivered_at IS NULL AND o.next_attempt_at <= ?
+				AND NOT EXISTS (
+					SELECT 1 FROM outbox_events p WHERE p.song_id = o.song_id AND p.delivered_at IS NULL AND p.id < o.id
+				)
+			ORDER BY o.id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, leaseUntil, now, limit).Scan(&dbEvents).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	sort.Slice(dbEvents, func(i, j int) bool { return dbEvents[i].ID < dbEvents[j].ID })
+	events := make([]domain.OutboxEvent, len(dbEvents))
+	for i, dbEvent := range dbEvents {
+		events[i] = dbEvent.ToDomain()
+	}
+	return events, nil
+}
+
+// MarkOutboxEventDelivered records the delivery of an event
+func (r OutboxRepo) MarkOutboxEventDelivered(ctx context.Context, id int64, at time.Time) error {
+	err := conn(ctx, r.db).Model(&models.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"delivered_at": at,
+			"last_error":   "",
+		}).Error
+	if err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+// MarkOutboxEventFailed records why the delivery of an event failed and
+// when to try again
+func (r OutboxRepo) MarkOutboxEventFailed(ctx context.Context, id int64, nextAttemptAt time.Time, reason string) error {
+	err := conn(ctx, r.db).Model(&models.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      reason,
+		}).Error
+	if err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+// OutboxStatus counts the pending events and lists up to limit of those
+// written before stuckBefore, oldest first
+func (r OutboxRepo) OutboxStatus(ctx context.Context, stuckBefore time.Time, limit int) (*domain.OutboxStatus, error) {
+	var pending struct {
+		Count  int64
+		Oldest *time.Time
+	}
+	err := conn(ctx, r.db).Model(&models.OutboxEvent{}).
+		Select("COUNT(*) AS count, MIN(created_at) AS oldest").
+		Where("delivered_at IS NULL").
+		Scan(&pending).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	var dbEvents []models.OutboxEvent
+	err = conn(ctx, r.db).
+		Where("delivered_at IS NULL AND created_at < ?", stuckBefore).
+		Order("id").
+		Limit(limit).
+		Find(&dbEvents).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	status := &domain.OutboxStatus{Pending: pending.Count, OldestPending: pending.Oldest, Stuck: []domain.OutboxEvent{}}
+	for _, dbEvent := range dbEvents {
+		status.Stuck = append(status.Stuck, dbEvent.ToDomain())
+	}
+	return status, nil
+}
+
+// RetryOutboxEvent makes a pending event due now, cutting its backoff short
+func (r OutboxRepo) RetryOutboxEvent(ctx context.Context, id int64, now time.Time) error {
+	result := conn(ctx, r.db).Model(&models.OutboxEvent{}).
+		Where("id = ? AND delivered_at IS NULL", id).
+		Update("next_attempt_at", now)
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrOutboxEventNotFound
+	}
+	return nil
+}
+
+// DeleteDeliveredBefore removes the events delivered before the given time
+// and returns how many were removed
+func (r OutboxRepo) DeleteDeliveredBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := conn(ctx, r.db).Where("delivered_at < ?", before).Delete(&models.OutboxEvent{})
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,56 @@
+package pgrepo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupOutboxTest(t *testing.T) (sqlmock.Sqlmock, *OutboxRepo) {
+	_, mock, db := setupMockDB(t, &gorm.Config{SkipDefaultTransaction: true})
+	return mock, NewOutboxRepo(db)
+}
+
+func TestClaimOutboxEvents(t *testing.T) {
+	mock, repo := setupOutboxTest(t)
+	now := time.Now()
+	columns := []string{"id", "type", "song_id", "payload", "created_at", "attempts", "next_attempt_at", "last_error", "delivered_at"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE outbox_events SET attempts = attempts + 1, next_attempt_at = $1`)).
+		WithArgs(now.Add(time.Minute), now, 50).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(9, "deleted", 8, nil, now, 1, now.Add(time.Minute), "", nil).
+			AddRow(4, "created", 7, []byte(`{"id":7}`), now, 2, now.Add(time.Minute), "timeout", nil))
+
+	events, err := repo.ClaimOutboxEvents(context.Background(), now, now.Add(time.Minute), 50)
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, int64(4), events[0].ID, "events are returned in order")
+	assert.Equal(t, domain.SongEventCreated, events[0].Type)
+	assert.Equal(t, "timeout", events[0].LastError)
+	assert.Nil(t, events[1].Payload)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRetryOutboxEvent_NotPending(t *testing.T) {
+	mock, repo := setupOutboxTest(t)
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "outbox_events" SET "next_attempt_at"=$1 WHERE id = $2 AND delivered_at IS NULL`)).
+		WithArgs(now, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.RetryOutboxEvent(context.Background(), 3, now)
+
+	assert.ErrorIs(t, err, domain.ErrOutboxEventNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,251 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// playlistColumns selects a playlist together with its number of songs
+const playlistColumns = "playlists.*, (SELECT COUNT(*) FROM playlist_songs ps WHERE ps.playlist_id = playlists.id) AS song_count"
+
+// PlaylistRepo implements repository pattern for playlists and their songs
+type PlaylistRepo struct {
+	db *gorm.DB
+}
+
+// NewPlaylistRepo creates a new playlist repository
+func NewPlaylistRepo(db *gorm.DB) *PlaylistRepo {
+	return &PlaylistRepo{
+		db: db,
+	}
+}
+
+// CreatePlaylist creates an empty playlist
+func (r PlaylistRepo) CreatePlaylist(ctx context.Context, playlist *domain.Playlist) (*domain.Playlist, error) {
+	dbPlaylist := models.ToPlaylistModel(*playlist)
+	if err := conn(ctx, r.db).Create(&dbPlaylist).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	created := dbPlaylist.ToDomain()
+	return &created, nil
+}
+
+// GetPlaylist retrieves a playlist by ID, without its entries
+func (r PlaylistRepo) GetPlaylist(ctx context.Context, id int) (*domain.Playlist, error) {
+	return r.findPlaylist(conn(ctx, r.db), id)
+}
+
+// LockPlaylist retrieves a playlist and locks it until the end of the
+// transaction, serializing changes to its entries
+func (r PlaylistRepo) LockPlaylist(ctx context.Context, id int) (*domain.Playlist, error) {
+	return r.findPlaylist(conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "playlists"}}), id)
+}
+
+func (r PlaylistRepo) findPlaylist(db *gorm.DB, id int) (*domain.Playlist, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	var dbPlaylist models.Playlist
+	if err := db.Select(playlistColumns).Where("playlists.id = ?", id).Take(&dbPlaylist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	playlist := dbPlaylist.ToDomain()
+	return &playlist, nil
+}
+
+// ListPlaylists retrieves playlists ordered by ID with pagination
+func (r PlaylistRepo) ListPlaylists(ctx context.Context, page, pageSize int) ([]*domain.Playlist, int64, error) {
+	if page <= 0 || pageSize <= 0 {
+		return nil, 0, domain.ErrInvalidData
+	}
+
+	var total int64
+	if err := conn(ctx, r.db).Model(&models.Playlist{}).Count(&total).Error; err != nil {
+		return nil, 0, domain.ErrDatabase
+	}
+
+	var dbPlaylists []models.Playlist
+	offset := (page - 1) * pageSize
+	err := conn(ctx, r.db).Select(playlistColumns).Order("id").Offset(offset).Limit(pageSize).Find(&dbPlaylists).Error
+	if err != nil {
+		return nil, 0, domain.ErrDatabase
+	}
+
+	return toDomainPlaylists(dbPlaylists), total, nil
+}
+
+// FindPlaylistsBySong retrieves the playlists containing a song, ordered by ID
+func (r PlaylistRepo) FindPlaylistsBySong(ctx context.Context, songID int) ([]*domain.Playlist, error) {
+	var dbPlaylists []models.Playlist
+	err := conn(ctx, r.db).Select(playlistColumns).
+		Where("EXISTS (SELECT 1 FROM playlist_songs e WHERE e.playlist_id = playlists.id AND e.song_id = ?)", songID).
+		Order("id").
+		Find(&dbPlaylists).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	return toDomainPlaylists(dbPlaylists), nil
+}
+
+// RenamePlaylist changes the name of a playlist
+func (r PlaylistRepo) RenamePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	result := conn(ctx, r.db).Model(&models.Playlist{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"name":       name,
+		"updated_at": gorm.Expr("NOW()"),
+	})
+	if result.Error != nil {
+		return nil, domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrNotFound
+	}
+
+	return r.GetPlaylist(ctx, id)
+}
+
+// DeletePlaylist deletes a playlist and its entries
+func (r PlaylistRepo) DeletePlaylist(ctx context.Context, id int) error {
+	if id <= 0 {
+		return domain.ErrInvalidID
+	}
+
+	result := conn(ctx, r.db).Delete(&models.Playlist{}, id)
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// GetPlaylistEntries retrieves the songs of a playlist in order
+func (r PlaylistRepo) GetPlaylistEntries(ctx context.Context, playlistID int) ([]domain.PlaylistEntry, error) {
+	var dbEntries []models.PlaylistSong
+	err := conn(ctx, r.db).Preload("Song").Where("playlist_id = ?", playlistID).Order("position").Find(&dbEntries).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	entries := make([]domain.PlaylistEntry, len(dbEntries))
+	for i, dbEntry := range dbEntries {
+		entries[i] = dbEntry.ToDomain()
+	}
+	return entries, nil
+}
+
+// InsertPlaylistEntry inserts a song at a position, moving the entries at
+// and after it down by one. The position must be between 1 and the number
+// of entries plus one.
+func (r PlaylistRepo) InsertPlaylistEntry(ctx context.Context, playlistID, position, songID int) error {
+	db := conn(ctx, r.db)
+	err := db.Exec("UPDATE playlist_songs SET position = position + 1 WHERE playlist_id = ? AND position >= ?", playlistID, position).Error
+	if err != nil {
+		return domain.ErrDatabase
+	}
+
+	entry := models.PlaylistSong{PlaylistID: playlistID, Position: position, SongID: songID}
+	if err := db.Omit(clause.Associations, "AddedAt").Create(&entry).Error; err != nil {
+		if isForeignKeyError(err) {
+			return domain.ErrSongNotFound
+		}
+		return domain.ErrDatabase
+	}
+
+	return r.touch(ctx, playlistID)
+}
+
+// RemovePlaylistEntry removes the entry at a position; the entries after it
+// move up by one
+func (r PlaylistRepo) RemovePlaylistEntry(ctx context.Context, playlistID, position int) error {
+	result := conn(ctx, r.db).Where("playlist_id = ? AND position = ?", playlistID, position).Delete(&models.PlaylistSong{})
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrInvalidPosition
+	}
+
+	return r.touch(ctx, playlistID)
+}
+
+// MovePlaylistEntry moves the entry at from to position to, shifting the
+// entries in between by one. Both positions must exist.
+func (r PlaylistRepo) MovePlaylistEntry(ctx context.Context, playlistID, from, to int) error {
+	if from == to {
+		return nil
+	}
+
+	shift, low, high := -1, from, to
+	if to < from {
+		shift, low, high = 1, to, from
+	}
+
+	err := conn(ctx, r.db).Exec(`UPDATE playlist_songs
+		SET position = CASE WHEN position = ? THEN ? ELSE position + ? END
+		WHERE playlist_id = ? AND position BETWEEN ? AND ?`,
+		from, to, shift, playlistID, low, high).Error
+	if err != nil {
+		return domain.ErrDatabase
+	}
+
+	return r.touch(ctx, playlistID)
+}
+
+// CopyPlaylist creates a playlist with the given name and the entries of
+// the source playlist
+func (r PlaylistRepo) CopyPlaylist(ctx context.Context, sourceID int, name string) (*domain.Playlist, error) {
+	created, err := r.CreatePlaylist(ctx, &domain.Playlist{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	err = conn(ctx, r.db).Exec(`INSERT INTO playlist_songs (playlist_id, position, song_id, added_at)
+		SELECT ?, position, song_id, NOW() FROM playlist_songs WHERE playlist_id = ?`,
+		created.ID, sourceID).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	return r.GetPlaylist(ctx, created.ID)
+}
+
+// touch records a change to the entries of a playlist
+func (r PlaylistRepo) touch(ctx context.Context, playlistID int) error {
+	if err := conn(ctx, r.db).Exec("UPDATE playlists SET updated_at = NOW() WHERE id = ?", playlistID).Error; err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+func toDomainPlaylists(dbPlaylists []models.Playlist) []*domain.Playlist {
+	playlists := make([]*domain.Playlist, len(dbPlaylists))
+	for i, dbPlaylist := range dbPlaylists {
+		playlist := dbPlaylist.ToDomain()
+		playlists[i] = &playlist
+	}
+	return playlists
+}
+
+// isForeignKeyError checks if the error is a foreign key violation
+func isForeignKeyError(err error) bool {
+	return strings.Contains(err.Error(), "foreign key constraint") ||
+		strings.Contains(err.Error(), "SQLSTATE 23503")
+}
@@ -0,0 +1,68 @@
+package pgrepo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupPlaylistTest(t *testing.T) (sqlmock.Sqlmock, *PlaylistRepo) {
+	_, mock, db := setupMockDB(t, &gorm.Config{})
+	return mock, NewPlaylistRepo(db)
+}
+
+func TestMovePlaylistEntry(t *testing.T) {
+	tests := []struct {
+		name             string
+		from, to         int
+		shift, low, high int
+	}{
+		{name: "down", from: 1, to: 3, shift: -1, low: 1, high: 3},
+		{name: "up", from: 4, to: 2, shift: 1, low: 2, high: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, repo := setupPlaylistTest(t)
+
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE playlist_songs")).
+				WithArgs(tt.from, tt.to, tt.shift, 7, tt.low, tt.high).
+				WillReturnResult(sqlmock.NewResult(0, 3))
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE playlists SET updated_at = NOW() WHERE id = $1")).
+				WithArgs(7).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := repo.MovePlaylistEntry(context.Background(), 7, tt.from, tt.to)
+
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestInsertPlaylistEntry_UnknownSong(t *testing.T) {
+	mock, repo := setupPlaylistTest(t)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE playlist_songs SET position = position + 1 WHERE playlist_id = $1 AND position >= $2")).
+		WithArgs(7, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "playlist_songs"`)).
+		WillReturnError(&pgError{"insert or update on table \"playlist_songs\" violates foreign key constraint \"playlist_songs_song_id_fkey\" (SQLSTATE 23503)"})
+
+	err := repo.InsertPlaylistEntry(context.Background(), 7, 2, 99)
+
+	assert.ErrorContains(t, err, "song not found")
+}
+
+// pgError mimics the message of a driver error
+type pgError struct {
+	message string
+}
+
+func (e *pgError) Error() string {
+	return e.message
+}
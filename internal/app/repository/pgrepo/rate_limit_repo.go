@@ -0,0 +1,74 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"songs/internal/app/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// refilledTokens is the token count of an existing bucket after refilling it
+// for the time elapsed since its last update
+const refilledTokens = `LEAST(CAST(@capacity AS DOUBLE PRECISION),
+	b.tokens + EXTRACT(EPOCH FROM (NOW() - b.updated_at)) * CAST(@rate AS DOUBLE PRECISION))`
+
+// takeTokensQuery refills and charges a bucket in a single statement, so
+// concurrent requests from several instances never overspend it. A new
+// bucket starts full.
+const takeTokensQuery = `
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES (
+	@key,
+	CASE WHEN CAST(@capacity AS INTEGER) >= CAST(@cost AS INTEGER) THEN CAST(@capacity AS INTEGER) - CAST(@cost AS INTEGER) ELSE CAST(@capacity AS INTEGER) END,
+	CAST(@capacity AS INTEGER) >= CAST(@cost AS INTEGER),
+	NOW()
+)
+ON CONFLICT (key) DO UPDATE SET
+	allowed = ` + refilledTokens + ` >= CAST(@cost AS INTEGER),
+	tokens = ` + refilledTokens + ` - CASE WHEN ` + refilledTokens + ` >= CAST(@cost AS INTEGER) THEN CAST(@cost AS INTEGER) ELSE 0 END,
+	updated_at = NOW()
+RETURNING tokens, allowed`
+
+// RateLimitRepo keeps token buckets in Postgres so every instance of the
+// API shares the same limits
+type RateLimitRepo struct {
+	db *gorm.DB
+}
+
+// NewRateLimitRepo creates a new rate limit bucket repository
+func NewRateLimitRepo(db *gorm.DB) *RateLimitRepo {
+	return &RateLimitRepo{
+		db: db,
+	}
+}
+
+// Take implements service.RateLimitStore
+func (r RateLimitRepo) Take(ctx context.Context, key string, cost, capacity int, rate float64) (float64, bool, error) {
+	var result struct {
+		Tokens  float64
+		Allowed bool
+	}
+	err := conn(ctx, r.db).Raw(takeTokensQuery,
+		sql.Named("key", key),
+		sql.Named("cost", cost),
+		sql.Named("capacity", capacity),
+		sql.Named("rate", rate),
+	).Scan(&result).Error
+	if err != nil {
+		return 0, false, domain.ErrDatabase
+	}
+	return result.Tokens, result.Allowed, nil
+}
+
+// DeleteIdle removes buckets untouched for longer than idle and returns how
+// many were removed. Buckets idle for a full refill window are full again,
+// so dropping them does not change any limit.
+func (r RateLimitRepo) DeleteIdle(ctx context.Context, idle time.Duration) (int64, error) {
+	result := conn(ctx, r.db).Exec("DELETE FROM rate_limit_buckets WHERE updated_at < NOW() - make_interval(secs => ?)", idle.Seconds())
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,125 @@
+package pgrepo
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+
+	"gorm.io/gorm"
+)
+
+// relationQuery selects relations with the titles of both songs
+const relationQuery = `SELECT r.song_id, r.original_id, r.type, r.created_at,
+		s.title AS song_title, o.title AS original_title
+	FROM song_relations r
+	JOIN songs s ON s.id = r.song_id
+	JOIN songs o ON o.id = r.original_id`
+
+// familyQuery selects every song reachable from a song through relations,
+// in either direction
+const familyQuery = `WITH RECURSIVE family (id) AS (
+		SELECT CAST(@song AS INTEGER)
+		UNION
+		SELECT CASE WHEN r.song_id = family.id THEN r.original_id ELSE r.song_id END
+		FROM song_relations r JOIN family ON r.song_id = family.id OR r.original_id = family.id
+	)
+	SELECT id FROM family`
+
+// RelationRepo implements repository pattern for song relations
+type RelationRepo struct {
+	db *gorm.DB
+}
+
+// NewRelationRepo creates a new song relation repository
+func NewRelationRepo(db *gorm.DB) *RelationRepo {
+	return &RelationRepo{
+		db: db,
+	}
+}
+
+// LockRelations serializes relation changes until the end of the current
+// transaction, so concurrent links cannot close a cycle together
+func (r RelationRepo) LockRelations(ctx context.Context) error {
+	if err := conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(hashtext('song_relations'))").Error; err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+// IsVersionOf reports whether following originals from songID leads to
+// originalID
+func (r RelationRepo) IsVersionOf(ctx context.Context, songID, originalID int) (bool, error) {
+	var found bool
+	err := conn(ctx, r.db).Raw(`WITH RECURSIVE originals (id) AS (
+			SELECT original_id FROM song_relations WHERE song_id = @song
+			UNION
+			SELECT r.original_id FROM song_relations r JOIN originals ON r.song_id = originals.id
+		)
+		SELECT EXISTS (SELECT 1 FROM originals WHERE id = @original)`,
+		map[string]interface{}{"song": songID, "original": originalID}).Scan(&found).Error
+	if err != nil {
+		return false, domain.ErrDatabase
+	}
+	return found, nil
+}
+
+// CreateRelation records that a song is a version of another one
+func (r RelationRepo) CreateRelation(ctx context.Context, relation domain.SongRelation) (*domain.SongRelation, error) {
+	row := models.ToSongRelationModel(relation)
+	if err := conn(ctx, r.db).Create(&row).Error; err != nil {
+		switch {
+		case isDuplicateError(err):
+			return nil, domain.ErrDuplicate
+		case isForeignKeyError(err):
+			return nil, domain.ErrSongNotFound
+		default:
+			return nil, domain.ErrDatabase
+		}
+	}
+
+	relations, err := r.findRelations(ctx, " WHERE r.song_id = ? AND r.original_id = ?", row.SongID, row.OriginalID)
+	if err != nil {
+		return nil, err
+	}
+	if len(relations) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return &relations[0], nil
+}
+
+// DeleteRelation removes the relation between a song and its original
+func (r RelationRepo) DeleteRelation(ctx context.Context, songID, originalID int) error {
+	result := conn(ctx, r.db).Where("song_id = ? AND original_id = ?", songID, originalID).Delete(&models.SongRelation{})
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// SongRelations retrieves the originals of a song and its versions
+func (r RelationRepo) SongRelations(ctx context.Context, songID int) ([]domain.SongRelation, error) {
+	return r.findRelations(ctx, " WHERE r.song_id = @song OR r.original_id = @song ORDER BY r.original_id, r.song_id",
+		map[string]interface{}{"song": songID})
+}
+
+// SongFamily retrieves every relation between the songs connected to a song
+func (r RelationRepo) SongFamily(ctx context.Context, songID int) ([]domain.SongRelation, error) {
+	return r.findRelations(ctx, " WHERE r.song_id IN ("+familyQuery+") ORDER BY r.original_id, r.song_id",
+		map[string]interface{}{"song": songID})
+}
+
+func (r RelationRepo) findRelations(ctx context.Context, clause string, args ...interface{}) ([]domain.SongRelation, error) {
+	var rows []models.SongRelation
+	if err := conn(ctx, r.db).Raw(relationQuery+clause, args...).Scan(&rows).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	relations := make([]domain.SongRelation, len(rows))
+	for i, row := range rows {
+		relations[i] = row.ToDomain()
+	}
+	return relations, nil
+}
@@ -0,0 +1,48 @@
+package pgrepo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func setupRelationTest(t *testing.T) (sqlmock.Sqlmock, *RelationRepo) {
+	_, mock, db := setupMockDB(t, &gorm.Config{})
+	return mock, NewRelationRepo(db)
+}
+
+func TestIsVersionOf(t *testing.T) {
+	mock, repo := setupRelationTest(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("WITH RECURSIVE originals (id) AS")).
+		WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	found, err := repo.IsVersionOf(context.Background(), 3, 1)
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSongFamily(t *testing.T) {
+	mock, repo := setupRelationTest(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE r.song_id IN (WITH RECURSIVE family (id) AS")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"song_id", "original_id", "type", "song_title", "original_title"}).
+			AddRow(2, 1, "cover", "Cover", "Original").
+			AddRow(3, 2, "translation", "Traduction", "Cover"))
+
+	relations, err := repo.SongFamily(context.Background(), 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, relations, 2)
+	assert.Equal(t, "Original", relations[0].OriginalTitle)
+	assert.Equal(t, "translation", string(relations[1].Type))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
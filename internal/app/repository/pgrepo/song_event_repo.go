@@ -0,0 +1,115 @@
+package pgrepo
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// songEventsPublishLock is the advisory lock key serializing the readers
+// publishing song events; writers never take it
+const songEventsPublishLock = 0x736f6e67
+
+// publishSongEventsQuery moves the events of the transactions older than
+// every one still running, whose events can no longer change, from the log
+// the trigger writes to the change feed, numbering them in the order of
+// their transactions
+const publishSongEventsQuery = `WITH settled AS (
+	DELETE FROM song_event_log
+	WHERE xid < pg_snapshot_xmin(pg_current_snapshot())
+	RETURNING id, xid, type, song_id, song, created_at
+)
+INSERT INTO song_events (type, song_id, song, created_at)
+SELECT type, song_id, song, created_at FROM settled ORDER BY xid, id`
+
+// SongEventRepo reads the change feed of songs, recorded by a trigger on
+// the songs table
+type SongEventRepo struct {
+	db *gorm.DB
+}
+
+// NewSongEventRepo creates a new song event repository
+func NewSongEventRepo(db *gorm.DB) *SongEventRepo {
+	return &SongEventRepo{
+		db: db,
+	}
+}
+
+// SongEventsAfter publishes the settled events, then returns up to limit
+// events numbered above afterSeq, oldest first
+func (r SongEventRepo) SongEventsAfter(ctx context.Context, afterSeq int64, limit int) ([]domain.SongEvent, error) {
+	if err := r.publish(ctx); err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	var dbEvents []models.SongEvent
+	err := conn(ctx, r.db).
+		Where("seq > ?", afterSeq).
+		Order("seq").
+		Limit(limit).
+		Find(&dbEvents).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	events := make([]domain.SongEvent, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		event, err := dbEvent.ToDomain()
+		if err != nil {
+			return nil, domain.ErrDatabase
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// publish numbers the settled events of the log. Readers publishing at the
+// same time would interleave their numbers, so only one publishes at a
+// time; the others read what it published once it commits.
+func (r SongEventRepo) publish(ctx context.Context) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", songEventsPublishLock).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		return tx.Exec(publishSongEventsQuery).Error
+	})
+}
+
+// SongEventBounds returns the numbers of the oldest and newest events kept,
+// both 0 when there are none
+func (r SongEventRepo) SongEventBounds(ctx context.Context) (first, last int64, err error) {
+	var bounds struct {
+		First int64
+		Last  int64
+	}
+	err = conn(ctx, r.db).Model(&models.SongEvent{}).
+		Select("COALESCE(MIN(seq), 0) AS first, COALESCE(MAX(seq), 0) AS last").
+		Scan(&bounds).Error
+	if err != nil {
+		return 0, 0, domain.ErrDatabase
+	}
+	return bounds.First, bounds.Last, nil
+}
+
+// DeleteSongEventsBefore publishes the settled events, so the log does not
+// grow without readers, then removes events recorded before the given time,
+// but the newest one, and returns how many were removed
+func (r SongEventRepo) DeleteSongEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	if err := r.publish(ctx); err != nil {
+		return 0, domain.ErrDatabase
+	}
+	result := conn(ctx, r.db).
+		Where("created_at < ? AND seq < (SELECT MAX(seq) FROM song_events)", before).
+		Delete(&models.SongEvent{})
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
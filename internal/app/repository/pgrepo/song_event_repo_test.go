@@ -0,0 +1,76 @@
+package pgrepo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupSongEventTest(t *testing.T) (sqlmock.Sqlmock, *SongEventRepo) {
+	_, mock, db := setupMockDB(t, &gorm.Config{SkipDefaultTransaction: true})
+	return mock, NewSongEventRepo(db)
+}
+
+// expectPublish expects the settled events to be published, by this
+// reader when it gets the lock
+func expectPublish(mock sqlmock.Sqlmock, locked bool) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT pg_try_advisory_xact_lock($1)`)).
+		WithArgs(songEventsPublishLock).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(locked))
+	if locked {
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM song_event_log WHERE xid < pg_snapshot_xmin(pg_current_snapshot())`)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+	}
+	mock.ExpectCommit()
+}
+
+func TestSongEventsAfter(t *testing.T) {
+	mock, repo := setupSongEventTest(t)
+	now := time.Now()
+
+	expectPublish(mock, true)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "song_events" WHERE seq > $1 ORDER BY seq LIMIT $2`)).
+		WithArgs(41, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "type", "song_id", "song", "created_at"}).
+			AddRow(42, "created", 1, []byte(`{"id":1,"title":"Yesterday"}`), now).
+			AddRow(43, "deleted", 1, nil, now))
+
+	events, err := repo.SongEventsAfter(context.Background(), 41, 10)
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "Yesterday", events[0].Song.Title)
+	assert.Equal(t, domain.SongEventDeleted, events[1].Type)
+	assert.Nil(t, events[1].Song)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSongEventsAfter_TriggerPayload(t *testing.T) {
+	mock, repo := setupSongEventTest(t)
+
+	// As recorded by songs_change_feed for an import or a merge, while
+	// another reader publishes
+	expectPublish(mock, false)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "song_events" WHERE seq > $1 ORDER BY seq LIMIT $2`)).
+		WithArgs(0, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "type", "song_id", "song", "created_at"}).
+			AddRow(1, "created", 7, []byte(`{"id":7,"group_id":2,"title":"Hysteria","release_date":"2003-12-01T00:00:00","text":null,"link":"","language":"en","language_confidence":0.9,"script":"Latn","explicit":true,"explicit_manual":false}`), time.Now()))
+
+	events, err := repo.SongEventsAfter(context.Background(), 0, 10)
+
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, time.Date(2003, 12, 1, 0, 0, 0, 0, time.UTC), events[0].Song.ReleaseDate)
+	assert.Equal(t, "Hysteria", events[0].Song.Title)
+	assert.True(t, events[0].Song.Explicit)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
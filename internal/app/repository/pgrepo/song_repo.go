@@ -2,12 +2,22 @@ package pgrepo
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"songs/internal/app/domain"
 	"songs/internal/app/repository/models"
 	"strings"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// insertBatchSize is the number of rows sent per INSERT statement in bulk writes
+	insertBatchSize = 100
+	// streamBatchSize is the number of rows fetched from a cursor at once
+	streamBatchSize = 500
 )
 
 // SongRepo implements repository pattern for songs
@@ -29,7 +39,7 @@ func (r SongRepo) GetSong(ctx context.Context, id int) (*domain.Song, error) {
 	}
 
 	var dbSong models.Song
-	result := r.db.WithContext(ctx).First(&dbSong, id)
+	result := conn(ctx, r.db).First(&dbSong, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrNotFound
@@ -38,6 +48,9 @@ func (r SongRepo) GetSong(ctx context.Context, id int) (*domain.Song, error) {
 	}
 
 	song := dbSong.ToDomain()
+	if err := r.loadCredits(ctx, []*domain.Song{&song}); err != nil {
+		return nil, err
+	}
 	return &song, nil
 }
 
@@ -48,15 +61,7 @@ func (r SongRepo) GetSongs(ctx context.Context, filter map[string]string, page,
 	}
 
 	var total int64
-	query := r.db.WithContext(ctx).Model(&models.Song{})
-
-	// Apply filters
-	if title, ok := filter["title"]; ok && title != "" {
-		query = query.Where("title ILIKE ?", "%"+title+"%")
-	}
-	if groupID, ok := filter["group_id"]; ok && groupID != "" {
-		query = query.Where("group_id = ?", groupID)
-	}
+	query := applySongFilters(conn(ctx, r.db).Model(&models.Song{}), filter)
 
 	// Get total count
 	if err := query.Count(&total).Error; err != nil {
@@ -66,7 +71,7 @@ func (r SongRepo) GetSongs(ctx context.Context, filter map[string]string, page,
 	// Get paginated results
 	var dbSongs []models.Song
 	offset := (page - 1) * pageSize
-	if err := query.Offset(offset).Limit(pageSize).Find(&dbSongs).Error; err != nil {
+	if err := query.Order("id").Offset(offset).Limit(pageSize).Find(&dbSongs).Error; err != nil {
 		return nil, 0, domain.ErrDatabase
 	}
 
@@ -76,10 +81,53 @@ func (r SongRepo) GetSongs(ctx context.Context, filter map[string]string, page,
 		song := dbSong.ToDomain()
 		songs[i] = &song
 	}
+	if err := r.loadCredits(ctx, songs); err != nil {
+		return nil, 0, err
+	}
 
 	return songs, total, nil
 }
 
+// StreamSongs calls fn for every song matching the filter, ordered by ID.
+// Rows are read through a server-side cursor in batches inside a read-only
+// REPEATABLE READ transaction, so the whole stream sees one consistent
+// snapshot while memory use stays bounded. Returning an error from fn stops
+// the stream and the error is returned as is.
+func (r SongRepo) StreamSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error {
+	tx := r.db.WithContext(ctx).Begin(&sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if tx.Error != nil {
+		return domain.ErrDatabase
+	}
+	defer tx.Rollback()
+
+	stmt := applySongFilters(tx.Model(&models.Song{}), filter).
+		Order("id").
+		Session(&gorm.Session{DryRun: true}).
+		Find(&[]models.Song{}).Statement
+	// The statement is already rendered with $n placeholders, so bypass gorm's own binding
+	if _, err := tx.Statement.ConnPool.ExecContext(ctx, "DECLARE song_stream NO SCROLL CURSOR FOR "+stmt.SQL.String(), stmt.Vars...); err != nil {
+		return domain.ErrDatabase
+	}
+
+	for {
+		var batch []models.Song
+		if err := tx.Raw(fmt.Sprintf("FETCH %d FROM song_stream", streamBatchSize)).Scan(&batch).Error; err != nil {
+			return domain.ErrDatabase
+		}
+
+		for _, dbSong := range batch {
+			song := dbSong.ToDomain()
+			if err := fn(&song); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < streamBatchSize {
+			return nil
+		}
+	}
+}
+
 // CreateSong creates a new song
 func (r SongRepo) CreateSong(ctx context.Context, song *domain.Song) (*domain.Song, error) {
 	if err := validateSong(*song); err != nil {
@@ -88,17 +136,231 @@ func (r SongRepo) CreateSong(ctx context.Context, song *domain.Song) (*domain.So
 
 	dbSong := models.ToDBModel(*song)
 
-	if err := r.db.WithContext(ctx).Create(&dbSong).Error; err != nil {
+	err := writeSong(conn(ctx, r.db), &dbSong, song.Credits, func(db *gorm.DB) error {
+		return db.Create(&dbSong).Error
+	})
+	if err != nil {
 		if isDuplicateError(err) {
-			return nil, domain.ErrDuplicate
+			return nil, r.duplicateError(ctx, song.GroupID, song.Title, 0)
+		}
+		if isForeignKeyError(err) {
+			return nil, domain.ErrGroupNotFound
 		}
 		return nil, domain.ErrDatabase
 	}
 
 	result := dbSong.ToDomain()
+	if err := r.loadCredits(ctx, []*domain.Song{&result}); err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
+// CreateSongs inserts songs in batches within a single transaction
+func (r SongRepo) CreateSongs(ctx context.Context, songs []*domain.Song) ([]*domain.Song, error) {
+	dbSongs := make([]models.Song, len(songs))
+	for i, song := range songs {
+		if err := validateSong(*song); err != nil {
+			return nil, err
+		}
+		dbSongs[i] = models.ToDBModel(*song)
+	}
+
+	if len(dbSongs) == 0 {
+		return []*domain.Song{}, nil
+	}
+
+	if err := conn(ctx, r.db).CreateInBatches(&dbSongs, insertBatchSize).Error; err != nil {
+		if isDuplicateError(err) {
+			return nil, domain.ErrDuplicate
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	created := make([]*domain.Song, len(dbSongs))
+	for i, dbSong := range dbSongs {
+		song := dbSong.ToDomain()
+		created[i] = &song
+	}
+
+	return created, nil
+}
+
+// ExistingTitles returns which of the given titles already exist in the group,
+// keyed by normalized title (see domain.NormalizeTitle)
+func (r SongRepo) ExistingTitles(ctx context.Context, groupID int, titles []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if groupID <= 0 || len(titles) == 0 {
+		return existing, nil
+	}
+
+	keys := make([]string, len(titles))
+	for i, title := range titles {
+		keys[i] = domain.NormalizeTitle(title)
+	}
+
+	var found []string
+	err := conn(ctx, r.db).Model(&models.Song{}).
+		Where("group_id = ? AND title_key IN ?", groupID, keys).
+		Pluck("title_key", &found).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	for _, key := range found {
+		existing[key] = true
+	}
+	return existing, nil
+}
+
+// FindDuplicates returns the sets of songs sharing a group and normalized
+// title. A positive groupID restricts the search to that group.
+func (r SongRepo) FindDuplicates(ctx context.Context, groupID int) ([]domain.DuplicateSet, error) {
+	keys := conn(ctx, r.db).Model(&models.Song{}).
+		Select("group_id, title_key").
+		Group("group_id, title_key").
+		Having("COUNT(*) > 1")
+	if groupID > 0 {
+		keys = keys.Where("group_id = ?", groupID)
+	}
+
+	var rows []struct {
+		models.Song `gorm:"embedded"`
+		TitleKey    string
+	}
+	err := conn(ctx, r.db).Model(&models.Song{}).
+		Select("songs.*").
+		Where("(group_id, title_key) IN (?)", keys).
+		Order("group_id, title_key, id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	sets := []domain.DuplicateSet{}
+	for _, row := range rows {
+		n := len(sets)
+		if n == 0 || sets[n-1].GroupID != row.GroupID || sets[n-1].TitleKey != row.TitleKey {
+			sets = append(sets, domain.DuplicateSet{GroupID: row.GroupID, TitleKey: row.TitleKey})
+			n++
+		}
+		song := row.Song.ToDomain()
+		sets[n-1].Songs = append(sets[n-1].Songs, &song)
+	}
+	return sets, nil
+}
+
+// moveAlbumTracksQuery moves the album tracks of the merged songs to the
+// kept one. Albums already listing it, or listing several merged songs past
+// the first, keep a single track: the others go with the merged songs. The
+// kept song still follows the release date of one album at most.
+const moveAlbumTracksQuery = `WITH moved AS (
+		SELECT album_id, disc_number, track_number, inherit_release_date,
+			ROW_NUMBER() OVER (PARTITION BY album_id ORDER BY disc_number, track_number) AS album_rank
+		FROM album_tracks t
+		WHERE song_id IN @sources
+			AND NOT EXISTS (SELECT 1 FROM album_tracks o WHERE o.album_id = t.album_id AND o.song_id = @target)
+	), kept AS (
+		SELECT album_id, disc_number, track_number, inherit_release_date,
+			ROW_NUMBER() OVER (PARTITION BY inherit_release_date ORDER BY album_id, disc_number, track_number) AS inherit_rank
+		FROM moved
+		WHERE album_rank = 1
+	)
+	UPDATE album_tracks t SET song_id = @target,
+		inherit_release_date = k.inherit_release_date AND k.inherit_rank = 1
+			AND NOT EXISTS (SELECT 1 FROM album_tracks i WHERE i.song_id = @target AND i.inherit_release_date)
+	FROM kept k
+	WHERE t.album_id = k.album_id AND t.disc_number = k.disc_number AND t.track_number = k.track_number`
+
+// MergeSongs saves target and deletes the songs it absorbed, moving their
+// credits, genres, tags, playlist entries and album tracks to target. Once no
+// other song shares its natural key the target is subject to the unique
+// index again.
+func (r SongRepo) MergeSongs(ctx context.Context, target *domain.Song, sourceIDs []int) (*domain.Song, error) {
+	if err := validateSong(*target); err != nil {
+		return nil, err
+	}
+
+	db := conn(ctx, r.db)
+	dbSong := models.ToDBModel(*target)
+	if err := db.Save(&dbSong).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	if len(sourceIDs) > 0 {
+		// Keep the merged songs in their playlists
+		if err := db.Exec("UPDATE playlist_songs SET song_id = ? WHERE song_id IN ?", dbSong.ID, sourceIDs).Error; err != nil {
+			return nil, domain.ErrDatabase
+		}
+		// and on their albums
+		if err := db.Exec(moveAlbumTracksQuery, map[string]interface{}{"target": dbSong.ID, "sources": sourceIDs}).Error; err != nil {
+			return nil, domain.ErrDatabase
+		}
+		for _, query := range []string{
+			`INSERT INTO song_artists (song_id, group_id, role)
+				SELECT ?, group_id, role FROM song_artists WHERE song_id IN ? ON CONFLICT DO NOTHING`,
+			`INSERT INTO song_genres (song_id, genre_id)
+				SELECT ?, genre_id FROM song_genres WHERE song_id IN ? ON CONFLICT DO NOTHING`,
+			`INSERT INTO song_tags (song_id, tag_id)
+				SELECT ?, tag_id FROM song_tags WHERE song_id IN ? ON CONFLICT DO NOTHING`,
+			// The original lyrics of duplicates are the kept song's text
+			`INSERT INTO song_lyrics (song_id, language, text, translator, source)
+				SELECT ?, language, text, translator, source FROM song_lyrics WHERE song_id IN ? AND NOT original ON CONFLICT DO NOTHING`,
+		} {
+			if err := db.Exec(query, dbSong.ID, sourceIDs).Error; err != nil {
+				return nil, domain.ErrDatabase
+			}
+		}
+		// Versions of the merged songs become versions of the kept one, and
+		// the other way around, unless that would link it to itself or close
+		// a cycle of originals. Relation changes are serialized like links.
+		if err := db.Exec("SELECT pg_advisory_xact_lock(hashtext('song_relations'))").Error; err != nil {
+			return nil, domain.ErrDatabase
+		}
+		for _, query := range []string{
+			`WITH RECURSIVE versions (id) AS (
+				SELECT song_id FROM song_relations WHERE original_id = @target
+				UNION
+				SELECT r.song_id FROM song_relations r JOIN versions ON r.original_id = versions.id
+			)
+			INSERT INTO song_relations (song_id, original_id, type, created_at)
+				SELECT @target, original_id, type, created_at FROM song_relations
+				WHERE song_id IN @sources AND original_id <> @target AND original_id NOT IN (SELECT id FROM versions)
+				ON CONFLICT DO NOTHING`,
+			`WITH RECURSIVE originals (id) AS (
+				SELECT original_id FROM song_relations WHERE song_id = @target
+				UNION
+				SELECT r.original_id FROM song_relations r JOIN originals ON r.song_id = originals.id
+			)
+			INSERT INTO song_relations (song_id, original_id, type, created_at)
+				SELECT song_id, @target, type, created_at FROM song_relations
+				WHERE original_id IN @sources AND song_id <> @target AND song_id NOT IN (SELECT id FROM originals)
+				ON CONFLICT DO NOTHING`,
+		} {
+			if err := db.Exec(query, map[string]interface{}{"target": dbSong.ID, "sources": sourceIDs}).Error; err != nil {
+				return nil, domain.ErrDatabase
+			}
+		}
+		if err := db.Delete(&models.Song{}, sourceIDs).Error; err != nil {
+			return nil, domain.ErrDatabase
+		}
+	}
+
+	err := db.Exec(`UPDATE songs SET legacy_duplicate = FALSE
+		WHERE id = ? AND legacy_duplicate AND NOT EXISTS (
+			SELECT 1 FROM songs o WHERE o.group_id = songs.group_id AND o.title_key = songs.title_key AND o.id <> songs.id
+		)`, dbSong.ID).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	merged := dbSong.ToDomain()
+	if err := r.loadCredits(ctx, []*domain.Song{&merged}); err != nil {
+		return nil, err
+	}
+	return &merged, nil
+}
+
 // UpdateSong updates an existing song
 func (r *SongRepo) UpdateSong(ctx context.Context, id int, song *domain.Song) (*domain.Song, error) {
 	if id <= 0 {
@@ -112,18 +374,33 @@ func (r *SongRepo) UpdateSong(ctx context.Context, id int, song *domain.Song) (*
 	dbSong := models.ToDBModel(*song)
 	dbSong.ID = id
 
-	result := r.db.WithContext(ctx).Save(&dbSong)
-	if result.Error != nil {
-		if isDuplicateError(result.Error) {
-			return nil, domain.ErrDuplicate
+	err := writeSong(conn(ctx, r.db), &dbSong, song.Credits, func(db *gorm.DB) error {
+		result := db.Save(&dbSong)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, err
+		case isDuplicateError(err):
+			return nil, r.duplicateError(ctx, song.GroupID, song.Title, id)
+		case isForeignKeyError(err):
+			return nil, domain.ErrGroupNotFound
+		default:
+			return nil, domain.ErrDatabase
 		}
-		return nil, domain.ErrDatabase
-	}
-	if result.RowsAffected == 0 {
-		return nil, domain.ErrNotFound
 	}
 
 	updatedSong := dbSong.ToDomain()
+	if err := r.loadCredits(ctx, []*domain.Song{&updatedSong}); err != nil {
+		return nil, err
+	}
 	return &updatedSong, nil
 }
 
@@ -138,18 +415,21 @@ func (r *SongRepo) PartialUpdateSong(ctx context.Context, id int, updates map[st
 	}
 
 	var updatedDBSong models.Song
-	result := r.db.WithContext(ctx).Model(&models.Song{}).Where("id = ?", id).Updates(updates).First(&updatedDBSong)
+	result := conn(ctx, r.db).Model(&models.Song{}).Where("id = ?", id).Updates(updates).First(&updatedDBSong)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrNotFound
 		}
 		if isDuplicateError(result.Error) {
-			return nil, domain.ErrDuplicate
+			return nil, r.partialUpdateDuplicateError(ctx, id, updates)
 		}
 		return nil, domain.ErrDatabase
 	}
 
 	song := updatedDBSong.ToDomain()
+	if err := r.loadCredits(ctx, []*domain.Song{&song}); err != nil {
+		return nil, err
+	}
 	return &song, nil
 }
 
@@ -159,7 +439,7 @@ func (r SongRepo) DeleteSong(ctx context.Context, id int) error {
 		return domain.ErrInvalidID
 	}
 
-	result := r.db.WithContext(ctx).Delete(&models.Song{}, id)
+	result := conn(ctx, r.db).Delete(&models.Song{}, id)
 	if result.Error != nil {
 		return domain.ErrDatabase
 	}
@@ -185,21 +465,178 @@ func (r SongRepo) GetSongVerses(ctx context.Context, id int, page, size int) ([]
 		return nil, 0, err
 	}
 
-	// Split text into verses (assuming verses are separated by blank lines)
-	verses := strings.Split(song.Text, "\n\n")
-	totalVerses := len(verses)
+	verses, total := domain.PageVerses(song.Text, page, size)
+	return verses, total, nil
+}
+
+// SongsWithoutLanguage retrieves up to limit songs with an ID above afterID,
+// ordered by ID, whose language was never detected, or all of them when all
+// is set
+func (r SongRepo) SongsWithoutLanguage(ctx context.Context, afterID, limit int, all bool) ([]*domain.Song, error) {
+	query := conn(ctx, r.db).Where("id > ?", afterID)
+	if !all {
+		query = query.Where("language = '' AND script = ''")
+	}
+
+	var dbSongs []models.Song
+	if err := query.Order("id").Limit(limit).Find(&dbSongs).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	songs := make([]*domain.Song, len(dbSongs))
+	for i, dbSong := range dbSongs {
+		song := dbSong.ToDomain()
+		songs[i] = &song
+	}
+	return songs, nil
+}
+
+// SetSongLanguage stores the detected language and script of a song
+func (r SongRepo) SetSongLanguage(ctx context.Context, id int, lang, script string, confidence float64) error {
+	result := conn(ctx, r.db).Model(&models.Song{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"language":            lang,
+		"language_confidence": confidence,
+		"script":              script,
+	})
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// SongsWithComputedExplicit retrieves up to limit songs with an ID above
+// afterID, ordered by ID, whose explicit flag was not set by an editor
+func (r SongRepo) SongsWithComputedExplicit(ctx context.Context, afterID, limit int) ([]*domain.Song, error) {
+	var dbSongs []models.Song
+	err := conn(ctx, r.db).Where("id > ? AND NOT explicit_manual", afterID).Order("id").Limit(limit).Find(&dbSongs).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	songs := make([]*domain.Song, len(dbSongs))
+	for i, dbSong := range dbSongs {
+		song := dbSong.ToDomain()
+		songs[i] = &song
+	}
+	return songs, nil
+}
+
+// SetSongExplicit stores the computed explicit flag of a song, unless an
+// editor set it meanwhile
+func (r SongRepo) SetSongExplicit(ctx context.Context, id int, explicit bool) error {
+	err := conn(ctx, r.db).Model(&models.Song{}).Where("id = ? AND NOT explicit_manual", id).Update("explicit", explicit).Error
+	if err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+// applySongFilters adds the list filters shared by paginated and streamed queries
+func applySongFilters(query *gorm.DB, filter map[string]string) *gorm.DB {
+	if title, ok := filter["title"]; ok && title != "" {
+		query = query.Where("title ILIKE ?", "%"+title+"%")
+	}
+	if groupID, ok := filter["group_id"]; ok && groupID != "" {
+		query = query.Where("group_id = ?", groupID)
+	}
+	if artistID, ok := filter["artist_id"]; ok && artistID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM song_artists sa WHERE sa.song_id = songs.id AND sa.group_id = ?)", artistID)
+	}
+	if genreID, ok := filter["genre_id"]; ok && genreID != "" {
+		// Songs of sub-genres belong to the genre as well
+		query = query.Where(`EXISTS (SELECT 1 FROM song_genres sg WHERE sg.song_id = songs.id AND sg.genre_id IN (
+			WITH RECURSIVE sub AS (SELECT id FROM genres WHERE id = ? UNION ALL SELECT g.id FROM genres g JOIN sub ON g.parent_id = sub.id)
+			SELECT id FROM sub))`, genreID)
+	}
+	if lang, ok := filter["language"]; ok && lang != "" {
+		// A language also matches its regional and script variants, und the
+		// songs of unknown language
+		if lang == "und" {
+			query = query.Where("language = ''")
+		} else {
+			query = query.Where("(language = ? OR language LIKE ?)", lang, lang+"-%")
+		}
+	}
+	if explicit, ok := filter["explicit"]; ok && explicit != "" {
+		query = query.Where("explicit = ?", explicit == "true")
+	}
+	if tags, ok := filter["tags"]; ok && tags != "" {
+		// Tags are normalized and distinct, see domain.NormalizeTags
+		names := strings.Split(tags, ",")
+		if filter["tags_match"] == string(domain.TagMatchAll) {
+			query = query.Where(`(SELECT COUNT(*) FROM song_tags st JOIN tags t ON t.id = st.tag_id
+				WHERE st.song_id = songs.id AND t.name IN ?) = ?`, names, len(names))
+		} else {
+			query = query.Where(`EXISTS (SELECT 1 FROM song_tags st JOIN tags t ON t.id = st.tag_id
+				WHERE st.song_id = songs.id AND t.name IN ?)`, names)
+		}
+	}
+	return query
+}
+
+// creditOrder lists primary artists first, the main one leading, then the
+// other roles, each by artist name
+const creditOrder = `sa.song_id,
+	CASE sa.role WHEN 'primary' THEN 1 WHEN 'featured' THEN 2 WHEN 'composer' THEN 3 WHEN 'lyricist' THEN 4 ELSE 5 END,
+	sa.group_id <> s.group_id, g.name`
 
-	// Calculate pagination
-	start := (page - 1) * size
-	end := start + size
-	if end > totalVerses {
-		end = totalVerses
+// loadCredits fills in the credits of songs
+func (r SongRepo) loadCredits(ctx context.Context, songs []*domain.Song) error {
+	if len(songs) == 0 {
+		return nil
 	}
-	if start >= totalVerses {
-		return []string{}, totalVerses, nil
+
+	ids := make([]int, len(songs))
+	byID := make(map[int]*domain.Song, len(songs))
+	for i, song := range songs {
+		ids[i] = song.ID
+		byID[song.ID] = song
+		song.Credits = []domain.Credit{}
 	}
 
-	return verses[start:end], totalVerses, nil
+	var rows []models.SongCredit
+	err := conn(ctx, r.db).Table("song_artists sa").
+		Select("sa.song_id, sa.group_id, g.name, sa.role").
+		Joins("JOIN groups g ON g.id = sa.group_id").
+		Joins("JOIN songs s ON s.id = sa.song_id").
+		Where("sa.song_id IN ?", ids).
+		Order(creditOrder).
+		Scan(&rows).Error
+	if err != nil {
+		return domain.ErrDatabase
+	}
+
+	for _, row := range rows {
+		if song, ok := byID[row.SongID]; ok {
+			song.Credits = append(song.Credits, row.ToDomain())
+		}
+	}
+	return nil
+}
+
+// writeSong runs write and, when credits are given, replaces the credits of
+// the written song in the same transaction. The group of the song stays
+// credited as primary artist.
+func writeSong(db *gorm.DB, dbSong *models.Song, credits []domain.Credit, write func(db *gorm.DB) error) error {
+	if credits == nil {
+		return write(db)
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := write(tx); err != nil {
+			return err
+		}
+		if err := tx.Where("song_id = ?", dbSong.ID).Delete(&models.SongArtist{}).Error; err != nil {
+			return err
+		}
+
+		primary := domain.Credit{ArtistID: dbSong.GroupID, Role: domain.CreditPrimary}
+		artists := models.ToSongArtistModels(dbSong.ID, append([]domain.Credit{primary}, credits...))
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&artists).Error
+	})
 }
 
 // validateSong validates song fields
@@ -213,9 +650,52 @@ func validateSong(song domain.Song) error {
 	if song.ReleaseDate.IsZero() {
 		return domain.ErrRequired
 	}
+	for _, credit := range song.Credits {
+		if credit.ArtistID <= 0 || !credit.Role.Valid() {
+			return domain.ErrInvalidCredit
+		}
+	}
 	return nil
 }
 
+// duplicateError builds the error for a song clashing with the natural key of
+// another song, pointing at that song when it can be found
+func (r SongRepo) duplicateError(ctx context.Context, groupID int, title string, excludeID int) error {
+	var existingID int
+	err := conn(ctx, r.db).Model(&models.Song{}).
+		Select("id").
+		Where("group_id = ? AND title_key = song_title_key(?) AND NOT legacy_duplicate AND id <> ?", groupID, title, excludeID).
+		Order("id").
+		Limit(1).
+		Scan(&existingID).Error
+	if err != nil || existingID == 0 {
+		// e.g. inside a transaction aborted by the violation
+		return domain.ErrDuplicate
+	}
+	return &domain.DuplicateSongError{ExistingID: existingID}
+}
+
+// partialUpdateDuplicateError resolves the natural key a partial update tried
+// to write by applying the updated fields to the stored song
+func (r SongRepo) partialUpdateDuplicateError(ctx context.Context, id int, updates map[string]interface{}) error {
+	var current models.Song
+	if err := conn(ctx, r.db).First(&current, id).Error; err != nil {
+		return domain.ErrDuplicate
+	}
+
+	groupID, title := current.GroupID, current.Title
+	if v, ok := updates["title"].(string); ok {
+		title = v
+	}
+	switch v := updates["group_id"].(type) {
+	case int:
+		groupID = v
+	case float64:
+		groupID = int(v)
+	}
+	return r.duplicateError(ctx, groupID, title, id)
+}
+
 // isDuplicateError checks if the error is a duplicate key error
 func isDuplicateError(err error) bool {
 	return strings.Contains(err.Error(), "duplicate key") ||
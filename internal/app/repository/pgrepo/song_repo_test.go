@@ -3,6 +3,7 @@ package pgrepo
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -16,24 +17,26 @@ import (
 )
 
 func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *SongRepo) {
-	// Create a new SQL mock
+	mockDB, mock, db := setupMockDB(t, &gorm.Config{})
+	return mockDB, mock, NewSongRepo(db)
+}
+
+// setupMockDB opens a gorm connection on a sqlmock database that is closed
+// when the test ends
+func setupMockDB(t *testing.T, config *gorm.Config) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
 	mockDB, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("Failed to create mock: %v", err)
 	}
-
-	dialector := postgres.New(postgres.Config{
-		Conn:       mockDB,
-		DriverName: "postgres",
+	t.Cleanup(func() {
+		_ = mockDB.Close()
 	})
 
-	db, err := gorm.Open(dialector, &gorm.Config{})
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: mockDB, DriverName: "postgres"}), config)
 	if err != nil {
 		t.Fatalf("Failed to open gorm connection: %v", err)
 	}
-
-	repo := NewSongRepo(db)
-	return mockDB, mock, repo
+	return mockDB, mock, db
 }
 
 func TestGetSong(t *testing.T) {
@@ -68,6 +71,12 @@ func TestGetSong(t *testing.T) {
 		WithArgs(1, 1).
 		WillReturnRows(rows)
 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT sa.song_id, sa.group_id, g.name, sa.role FROM song_artists sa JOIN groups g ON g.id = sa.group_id JOIN songs s ON s.id = sa.song_id WHERE sa.song_id IN ($1)`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"song_id", "group_id", "name", "role"}).
+			AddRow(1, 1, "The Beatles", "primary").
+			AddRow(1, 4, "Billy Preston", "featured"))
+
 	song, err := repo.GetSong(ctx, 1)
 
 	if assert.NoError(t, err) {
@@ -77,6 +86,10 @@ func TestGetSong(t *testing.T) {
 		assert.Equal(t, expectedSong.GroupID, song.GroupID)
 		assert.Equal(t, expectedSong.Text, song.Text)
 		assert.Equal(t, expectedSong.Link, song.Link)
+		assert.Equal(t, []domain.Credit{
+			{ArtistID: 1, ArtistName: "The Beatles", Role: domain.CreditPrimary},
+			{ArtistID: 4, ArtistName: "Billy Preston", Role: domain.CreditFeatured},
+		}, song.Credits)
 	}
 
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -127,6 +140,11 @@ func TestGetSongs(t *testing.T) {
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "songs"`)).
 		WillReturnRows(rows)
 
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM song_artists sa`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"song_id", "group_id", "name", "role"}).
+			AddRow(2, 1, "The Beatles", "primary"))
+
 	songs, total, err := repo.GetSongs(ctx, filter, 1, 10)
 
 	assert.NoError(t, err)
@@ -134,6 +152,154 @@ func TestGetSongs(t *testing.T) {
 	assert.Len(t, songs, 2)
 	assert.Equal(t, "Test Song 1", songs[0].Title)
 	assert.Equal(t, "Test Song 2", songs[1].Title)
+	assert.Empty(t, songs[0].Credits)
+	assert.Len(t, songs[1].Credits, 1)
+}
+
+func TestGetSongs_ByArtist(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	ctx := context.Background()
+	filter := map[string]string{"artist_id": "4"}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "songs" WHERE EXISTS (SELECT 1 FROM song_artists sa WHERE sa.song_id = songs.id AND sa.group_id = $1)`)).
+		WithArgs("4").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "songs" WHERE EXISTS (SELECT 1 FROM song_artists sa WHERE sa.song_id = songs.id AND sa.group_id = $1) ORDER BY id LIMIT $2`)).
+		WithArgs("4", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "title", "release_date", "text", "link"}))
+
+	songs, total, err := repo.GetSongs(ctx, filter, 1, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+	assert.Empty(t, songs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSongs_ByLanguage(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "songs" WHERE (language = $1 OR language LIKE $2)`)).
+		WithArgs("pt", "pt-%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "songs" WHERE (language = $1 OR language LIKE $2) ORDER BY id LIMIT $3`)).
+		WithArgs("pt", "pt-%", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "title", "text", "language", "language_confidence", "script"}).
+			AddRow(1, 1, "Garota", "Olha que coisa mais linda", "pt", 0.5, "Latn"))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM song_artists sa`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"song_id", "group_id", "name", "role"}))
+
+	songs, total, err := repo.GetSongs(ctx, map[string]string{"language": "pt"}, 1, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	if assert.Len(t, songs, 1) {
+		assert.Equal(t, "pt", songs[0].Language)
+		assert.Equal(t, 0.5, songs[0].LanguageConfidence)
+		assert.Equal(t, "Latn", songs[0].Script)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSongs_UnknownLanguage(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "songs" WHERE language = ''`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "songs" WHERE language = '' ORDER BY id LIMIT $1`)).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, _, err := repo.GetSongs(context.Background(), map[string]string{"language": "und"}, 1, 10)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSongs_NotExplicit(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "songs" WHERE explicit = $1`)).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "songs" WHERE explicit = $1 ORDER BY id LIMIT $2`)).
+		WithArgs(false, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, _, err := repo.GetSongs(context.Background(), map[string]string{"explicit": "false"}, 1, 10)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetSongExplicit_KeepsEditorFlag(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "songs" SET "explicit"=$1 WHERE id = $2 AND NOT explicit_manual`)).
+		WithArgs(true, 5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.SetSongExplicit(context.Background(), 5, true))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSongsWithoutLanguage(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "songs" WHERE id > $1 AND (language = '' AND script = '') ORDER BY id LIMIT $2`)).
+		WithArgs(7, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "text"}).AddRow(8, "Hello").AddRow(9, "Bonjour"))
+
+	songs, err := repo.SongsWithoutLanguage(context.Background(), 7, 2, false)
+
+	assert.NoError(t, err)
+	if assert.Len(t, songs, 2) {
+		assert.Equal(t, 8, songs[0].ID)
+		assert.Equal(t, "Bonjour", songs[1].Text)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetSongLanguage_NotFound(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "songs" SET "language"=$1,"language_confidence"=$2,"script"=$3 WHERE id = $4`)).
+		WithArgs("en", 0.9, "Latn", 5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.SetSongLanguage(context.Background(), 5, "en", "Latn", 0.9)
+
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestCreateSong(t *testing.T) {
@@ -156,19 +322,29 @@ func TestCreateSong(t *testing.T) {
 	mock.ExpectBegin()
 
 	// Expect the INSERT query with RETURNING clause
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "songs" ("group_id","title","release_date","text","link") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "songs" ("group_id","title","release_date","text","link","language","language_confidence","script","explicit","explicit_manual") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
 		WithArgs(
 			newSong.GroupID,
 			newSong.Title,
 			newSong.ReleaseDate,
 			newSong.Text,
 			newSong.Link,
+			newSong.Language,
+			newSong.LanguageConfidence,
+			newSong.Script,
+			newSong.Explicit,
+			newSong.ExplicitManual,
 		).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	// Expect Commit transaction
 	mock.ExpectCommit()
 
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM song_artists sa`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"song_id", "group_id", "name", "role"}).
+			AddRow(1, 1, "The Beatles", "primary"))
+
 	createdSong, err := repo.CreateSong(ctx, newSong)
 
 	if assert.NoError(t, err) {
@@ -238,13 +414,18 @@ func TestCreateSong_Error(t *testing.T) {
 	mock.ExpectBegin()
 
 	// Expect the INSERT query to fail
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "songs" ("group_id","title","release_date","text","link") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "songs" ("group_id","title","release_date","text","link","language","language_confidence","script","explicit","explicit_manual") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
 		WithArgs(
 			newSong.GroupID,
 			newSong.Title,
 			newSong.ReleaseDate,
 			newSong.Text,
 			newSong.Link,
+			newSong.Language,
+			newSong.LanguageConfidence,
+			newSong.Script,
+			newSong.Explicit,
+			newSong.ExplicitManual,
 		).
 		WillReturnError(sql.ErrConnDone)
 
@@ -258,3 +439,137 @@ func TestCreateSong_Error(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to create song")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestStreamSongs(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DECLARE song_stream NO SCROLL CURSOR FOR SELECT * FROM "songs" WHERE group_id = $1 ORDER BY id`)).
+		WithArgs("3").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`FETCH 500 FROM song_stream`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "title", "release_date", "text", "link"}).
+			AddRow(1, 3, "Song 1", now, "Lyrics 1", "link1").
+			AddRow(2, 3, "Song 2", now, "Lyrics 2", "link2"))
+	mock.ExpectRollback()
+
+	var titles []string
+	err := repo.StreamSongs(ctx, map[string]string{"group_id": "3"}, func(song *domain.Song) error {
+		titles = append(titles, song.Title)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Song 1", "Song 2"}, titles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSong_Duplicate(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	ctx := context.Background()
+	newSong := &domain.Song{GroupID: 1, Title: "Hey, Jude!", ReleaseDate: time.Now()}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "songs"`)).
+		WillReturnError(errors.New(`ERROR: duplicate key value violates unique constraint "idx_songs_natural_key" (SQLSTATE 23505)`))
+	mock.ExpectRollback()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "songs" WHERE group_id = $1 AND title_key = song_title_key($2) AND NOT legacy_duplicate AND id <> $3 ORDER BY id LIMIT $4`)).
+		WithArgs(1, "Hey, Jude!", 0, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
+
+	_, err := repo.CreateSong(ctx, newSong)
+
+	assert.ErrorIs(t, err, domain.ErrDuplicate)
+	var dupErr *domain.DuplicateSongError
+	if assert.ErrorAs(t, err, &dupErr) {
+		assert.Equal(t, 42, dupErr.ExistingID)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindDuplicates(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT songs.* FROM "songs" WHERE (group_id, title_key) IN (SELECT group_id, title_key FROM "songs" WHERE group_id = $1 GROUP BY group_id, title_key HAVING COUNT(*) > 1) ORDER BY group_id, title_key, id`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "title", "release_date", "text", "link", "title_key"}).
+			AddRow(1, 3, "Hey Jude", now, "", "", "hey jude").
+			AddRow(5, 3, "hey, jude", now, "", "", "hey jude").
+			AddRow(2, 3, "Let It Be", now, "", "", "let it be").
+			AddRow(7, 3, "Let it be!", now, "", "", "let it be"))
+
+	sets, err := repo.FindDuplicates(ctx, 3)
+
+	if assert.NoError(t, err) && assert.Len(t, sets, 2) {
+		assert.Equal(t, "hey jude", sets[0].TitleKey)
+		assert.Len(t, sets[0].Songs, 2)
+		assert.Equal(t, 5, sets[0].Songs[1].ID)
+		assert.Equal(t, "let it be", sets[1].TitleKey)
+		assert.Equal(t, 7, sets[1].Songs[1].ID)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMergeSongs(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	target := &domain.Song{ID: 1, GroupID: 7, Title: "Hysteria", ReleaseDate: time.Date(2003, 12, 1, 0, 0, 0, 0, time.UTC)}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "songs" SET`)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE playlist_songs SET song_id = $1 WHERE song_id IN ($2,$3)`)).
+		WithArgs(1, 5, 6).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// Tracks move unless the album lists the kept song already, and only one
+	// of them keeps following the release date of its album
+	mock.ExpectExec(`WITH moved AS \(.*FROM album_tracks t\s+WHERE song_id IN \(\$1,\$2\)\s+AND NOT EXISTS \(SELECT 1 FROM album_tracks o WHERE o.album_id = t.album_id AND o.song_id = \$3\).*UPDATE album_tracks t SET song_id = \$4,.*inherit_rank = 1\s+AND NOT EXISTS \(SELECT 1 FROM album_tracks i WHERE i.song_id = \$5 AND i.inherit_release_date\)`).
+		WithArgs(5, 6, 1, 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO song_artists`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO song_genres`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO song_tags`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO song_lyrics`).WillReturnResult(sqlmock.NewResult(0, 0))
+	// Relations are re-pointed under the lock of links, skipping those that
+	// would close a cycle of originals through the kept song
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock(hashtext('song_relations'))`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`WITH RECURSIVE versions .*INSERT INTO song_relations .*SELECT \$2, original_id, type, created_at FROM song_relations\s+WHERE song_id IN \(\$3,\$4\) AND original_id <> \$5 AND original_id NOT IN \(SELECT id FROM versions\)`).
+		WithArgs(1, 1, 5, 6, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`WITH RECURSIVE originals .*INSERT INTO song_relations .*SELECT song_id, \$2, type, created_at FROM song_relations\s+WHERE original_id IN \(\$3,\$4\) AND song_id <> \$5 AND song_id NOT IN \(SELECT id FROM originals\)`).
+		WithArgs(1, 1, 5, 6, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "songs" WHERE "songs"."id" IN ($1,$2)`)).
+		WithArgs(5, 6).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	mock.ExpectExec(`UPDATE songs SET legacy_duplicate = FALSE`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`FROM song_artists sa`).WillReturnRows(sqlmock.NewRows([]string{"song_id", "group_id", "name", "role"}))
+
+	merged, err := repo.MergeSongs(context.Background(), target, []int{5, 6})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, merged.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
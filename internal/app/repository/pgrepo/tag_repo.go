@@ -0,0 +1,113 @@
+package pgrepo
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagRepo implements repository pattern for song tags
+type TagRepo struct {
+	db *gorm.DB
+}
+
+// NewTagRepo creates a new tag repository
+func NewTagRepo(db *gorm.DB) *TagRepo {
+	return &TagRepo{
+		db: db,
+	}
+}
+
+// ExistingSongIDs returns which of the given song IDs exist, in ascending order
+func (r TagRepo) ExistingSongIDs(ctx context.Context, ids []int) ([]int, error) {
+	found := []int{}
+	if len(ids) == 0 {
+		return found, nil
+	}
+
+	if err := conn(ctx, r.db).Model(&models.Song{}).Where("id IN ?", ids).Order("id").Pluck("id", &found).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+	return found, nil
+}
+
+// TagSongs adds the tags to the songs, creating the tags that do not exist
+// yet, and returns the number of links added
+func (r TagRepo) TagSongs(ctx context.Context, songIDs []int, tags []string) (int64, error) {
+	db := conn(ctx, r.db)
+
+	dbTags := make([]models.Tag, len(tags))
+	for i, tag := range tags {
+		dbTags[i] = models.Tag{Name: tag}
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbTags).Error; err != nil {
+		return 0, domain.ErrDatabase
+	}
+
+	result := db.Exec(`INSERT INTO song_tags (song_id, tag_id)
+		SELECT s.id, t.id FROM songs s CROSS JOIN tags t
+		WHERE s.id IN ? AND t.name IN ?
+		ON CONFLICT DO NOTHING`, songIDs, tags)
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
+// UntagSongs removes the tags from the songs and returns the number of links
+// removed. Tags no song carries anymore are deleted.
+func (r TagRepo) UntagSongs(ctx context.Context, songIDs []int, tags []string) (int64, error) {
+	db := conn(ctx, r.db)
+
+	result := db.Exec(`DELETE FROM song_tags st USING tags t
+		WHERE st.tag_id = t.id AND st.song_id IN ? AND t.name IN ?`, songIDs, tags)
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+
+	err := db.Exec(`DELETE FROM tags t
+		WHERE t.name IN ? AND NOT EXISTS (SELECT 1 FROM song_tags st WHERE st.tag_id = t.id)`, tags).Error
+	if err != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
+// SongTags retrieves the tags of a song in alphabetical order
+func (r TagRepo) SongTags(ctx context.Context, songID int) ([]string, error) {
+	tags := []string{}
+	err := conn(ctx, r.db).Table("tags t").
+		Joins("JOIN song_tags st ON st.tag_id = t.id").
+		Where("st.song_id = ?", songID).
+		Order("t.name").
+		Pluck("t.name", &tags).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+	return tags, nil
+}
+
+// TagCloud retrieves the limit most used tags with their number of songs,
+// most used first
+func (r TagRepo) TagCloud(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	var rows []models.TagCount
+	err := conn(ctx, r.db).Table("song_tags st").
+		Select("t.name, COUNT(*) AS count").
+		Joins("JOIN tags t ON t.id = st.tag_id").
+		Group("t.name").
+		Order("count DESC, t.name").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	counts := make([]domain.TagCount, len(rows))
+	for i, row := range rows {
+		counts[i] = row.ToDomain()
+	}
+	return counts, nil
+}
@@ -0,0 +1,44 @@
+package pgrepo
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txKey is the context key holding the current transaction
+type txKey struct{}
+
+// TxManager runs functions inside a database transaction. The transaction is
+// carried by the context, so every repository method called with that context
+// joins it.
+type TxManager struct {
+	db *gorm.DB
+}
+
+// NewTxManager creates a new transaction manager
+func NewTxManager(db *gorm.DB) *TxManager {
+	return &TxManager{
+		db: db,
+	}
+}
+
+// WithinTransaction calls fn inside a transaction that is committed when fn
+// returns nil and rolled back otherwise. Nested calls join the outer transaction.
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn returns the transaction stored in ctx, or db when there is none
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,48 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestWithinTransaction_SharedByRepos(t *testing.T) {
+	mockDB, mock, repo := setupTest(t)
+	defer func() {
+		_ = mockDB.Close()
+	}()
+
+	txManager := NewTxManager(repo.db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "songs" WHERE "songs"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "songs" WHERE "songs"."id" = $1`)).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	errRollback := errors.New("rollback")
+	err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		_, ok := ctx.Value(txKey{}).(*gorm.DB)
+		assert.True(t, ok)
+
+		assert.NoError(t, repo.DeleteSong(ctx, 1))
+		assert.Error(t, repo.DeleteSong(ctx, 2))
+
+		// Nested calls join the outer transaction
+		return txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			return errRollback
+		})
+	})
+
+	assert.ErrorIs(t, err, errRollback)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,249 @@
+package pgrepo
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/repository/models"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// WebhookRepo stores the webhook subscriptions of partners and the log of
+// their deliveries
+type WebhookRepo struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepo creates a new webhook repository
+func NewWebhookRepo(db *gorm.DB) *WebhookRepo {
+	return &WebhookRepo{
+		db: db,
+	}
+}
+
+// CreateWebhook stores a new subscription
+func (r WebhookRepo) CreateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	dbSubscription := models.ToWebhookSubscriptionModel(subscription)
+	if err := conn(ctx, r.db).Create(&dbSubscription).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	created := dbSubscription.ToDomain()
+	return &created, nil
+}
+
+// GetWebhook returns the subscription with the given ID
+func (r WebhookRepo) GetWebhook(ctx context.Context, id int) (*domain.WebhookSubscription, error) {
+	var dbSubscription models.WebhookSubscription
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&dbSubscription).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	subscription := dbSubscription.ToDomain()
+	return &subscription, nil
+}
+
+// ListWebhooks returns all subscriptions ordered by ID
+func (r WebhookRepo) ListWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	var dbSubscriptions []models.WebhookSubscription
+	if err := conn(ctx, r.db).Order("id").Find(&dbSubscriptions).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	subscriptions := make([]*domain.WebhookSubscription, len(dbSubscriptions))
+	for i, dbSubscription := range dbSubscriptions {
+		subscription := dbSubscription.ToDomain()
+		subscriptions[i] = &subscription
+	}
+	return subscriptions, nil
+}
+
+// UpdateWebhook replaces the URL, event types and active flag of a
+// subscription, and its secret unless empty
+func (r WebhookRepo) UpdateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	dbSubscription := models.ToWebhookSubscriptionModel(subscription)
+	updates := map[string]interface{}{
+		"url":         dbSubscription.URL,
+		"event_types": dbSubscription.EventTypes,
+		"active":      dbSubscription.Active,
+		"updated_at":  dbSubscription.UpdatedAt,
+	}
+	if dbSubscription.Secret != "" {
+		updates["secret"] = dbSubscription.Secret
+	}
+
+	result := conn(ctx, r.db).Model(&models.WebhookSubscription{}).
+		Where("id = ?", subscription.ID).
+		Updates(updates)
+	if result.Error != nil {
+		return nil, domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return r.GetWebhook(ctx, subscription.ID)
+}
+
+// DeleteWebhook removes a subscription along with its deliveries
+func (r WebhookRepo) DeleteWebhook(ctx context.Context, id int) error {
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&models.WebhookSubscription{})
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+// EnqueueWebhookDeliveries schedules the delivery of payload, the document
+// of an outbox event, to every active subscription to its type, due now.
+// An event is enqueued once per subscription however many times it is
+// relayed. It returns how many deliveries were scheduled.
+func (r WebhookRepo) EnqueueWebhookDeliveries(ctx context.Context, event domain.OutboxEvent, payload []byte, now time.Time) (int64, error) {
+	result := conn(ctx, r.db).Exec(`INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, next_attempt_at, created_at)
+		SELECT id, ?, ?, CAST(? AS JSONB), ?, ?
+		FROM webhook_subscriptions
+		WHERE active AND ? = ANY(string_to_array(event_types, ','))
+		ON CONFLICT (subscription_id, event_id) DO NOTHING`,
+		event.ID, string(event.Type), string(payload), now, now, string(event.Type))
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
+
+// ClaimWebhookDeliveries leases up to limit pending deliveries due by now
+// until leaseUntil, counting an attempt for each, and returns them oldest
+// first
+func (r WebhookRepo) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var dbDeliveries []models.WebhookDelivery
+	err := conn(ctx, r.db).Raw(`UPDATE webhook_deliveries SET attempts = attempts + 1, next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= ?
+			ORDER BY id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, leaseUntil, now, limit).Scan(&dbDeliveries).Error
+	if err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	sort.Slice(dbDeliveries, func(i, j int) bool { return dbDeliveries[i].ID < dbDeliveries[j].ID })
+	deliveries := make([]domain.WebhookDelivery, len(dbDeliveries))
+	for i, dbDelivery := range dbDeliveries {
+		deliveries[i] = dbDelivery.ToDomain()
+	}
+	return deliveries, nil
+}
+
+// RecordWebhookAttempt logs an attempt of a delivery and moves the delivery
+// to status, due again at nextAttemptAt when still pending
+func (r WebhookRepo) RecordWebhookAttempt(ctx context.Context, id int64, attempt domain.WebhookAttempt, status domain.WebhookDeliveryStatus, nextAttemptAt time.Time) error {
+	dbAttempt := models.ToWebhookAttemptModel(id, attempt)
+	updates := map[string]interface{}{
+		"status":          string(status),
+		"next_attempt_at": nextAttemptAt,
+		"response_code":   attempt.ResponseCode,
+		"last_error":      attempt.Error,
+	}
+	if status == domain.WebhookDeliveryDelivered {
+		updates["delivered_at"] = attempt.At
+	}
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&dbAttempt).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.WebhookDelivery{}).Where("id = ?", id).Updates(updates).Error
+	})
+	if err != nil {
+		return domain.ErrDatabase
+	}
+	return nil
+}
+
+// ListWebhookDeliveries returns up to limit deliveries of a subscription,
+// newest first, only those with the given status unless empty
+func (r WebhookRepo) ListWebhookDeliveries(ctx context.Context, subscriptionID int, status domain.WebhookDeliveryStatus, limit int) ([]domain.WebhookDelivery, error) {
+	query := conn(ctx, r.db).Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		query = query.Where("status = ?", string(status))
+	}
+
+	var dbDeliveries []models.WebhookDelivery
+	if err := query.Order("id DESC").Limit(limit).Find(&dbDeliveries).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	deliveries := make([]domain.WebhookDelivery, len(dbDeliveries))
+	for i, dbDelivery := range dbDeliveries {
+		deliveries[i] = dbDelivery.ToDomain()
+	}
+	return deliveries, nil
+}
+
+// GetWebhookDelivery returns a delivery of a subscription with the log of
+// its attempts
+func (r WebhookRepo) GetWebhookDelivery(ctx context.Context, subscriptionID int, id int64) (*domain.WebhookDelivery, error) {
+	var dbDelivery models.WebhookDelivery
+	err := conn(ctx, r.db).Where("id = ? AND subscription_id = ?", id, subscriptionID).First(&dbDelivery).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWebhookDeliveryNotFound
+		}
+		return nil, domain.ErrDatabase
+	}
+
+	var dbAttempts []models.WebhookAttempt
+	if err := conn(ctx, r.db).Where("delivery_id = ?", id).Order("id").Find(&dbAttempts).Error; err != nil {
+		return nil, domain.ErrDatabase
+	}
+
+	delivery := dbDelivery.ToDomain()
+	delivery.Log = make([]domain.WebhookAttempt, len(dbAttempts))
+	for i, dbAttempt := range dbAttempts {
+		delivery.Log[i] = dbAttempt.ToDomain()
+	}
+	return &delivery, nil
+}
+
+// RedeliverWebhookDelivery makes a delivery of a subscription pending and
+// due now with a fresh count of attempts, whatever its status
+func (r WebhookRepo) RedeliverWebhookDelivery(ctx context.Context, subscriptionID int, id int64, now time.Time) error {
+	result := conn(ctx, r.db).Model(&models.WebhookDelivery{}).
+		Where("id = ? AND subscription_id = ?", id, subscriptionID).
+		Updates(map[string]interface{}{
+			"status":          string(domain.WebhookDeliveryPending),
+			"attempts":        0,
+			"next_attempt_at": now,
+			"delivered_at":    nil,
+		})
+	if result.Error != nil {
+		return domain.ErrDatabase
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookDeliveryNotFound
+	}
+	return nil
+}
+
+// DeleteWebhookDeliveriesBefore removes the deliveries, no longer pending,
+// created before the given time and returns how many were removed
+func (r WebhookRepo) DeleteWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := conn(ctx, r.db).
+		Where("status <> ? AND created_at < ?", string(domain.WebhookDeliveryPending), before).
+		Delete(&models.WebhookDelivery{})
+	if result.Error != nil {
+		return 0, domain.ErrDatabase
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,100 @@
+package pgrepo
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupWebhookTest(t *testing.T) (sqlmock.Sqlmock, *WebhookRepo) {
+	_, mock, db := setupMockDB(t, &gorm.Config{SkipDefaultTransaction: true})
+	return mock, NewWebhookRepo(db)
+}
+
+func TestGetWebhook(t *testing.T) {
+	mock, repo := setupWebhookTest(t)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_subscriptions" WHERE id = $1`)).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "url", "event_types", "secret", "active", "created_at", "updated_at"}).
+			AddRow(2, "https://partner.example.com/hook", "created,deleted", "s3cret", true, now, now))
+
+	subscription, err := repo.GetWebhook(context.Background(), 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SongEventType{domain.SongEventCreated, domain.SongEventDeleted}, subscription.EventTypes)
+	assert.Equal(t, "s3cret", subscription.Secret)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetWebhook_NotFound(t *testing.T) {
+	mock, repo := setupWebhookTest(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "webhook_subscriptions" WHERE id = $1`)).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, err := repo.GetWebhook(context.Background(), 2)
+
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnqueueWebhookDeliveries(t *testing.T) {
+	mock, repo := setupWebhookTest(t)
+	now := time.Now()
+	event := domain.OutboxEvent{ID: 12, Type: domain.SongEventUpdated, SongID: 7}
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, next_attempt_at, created_at)`)).
+		WithArgs(int64(12), "updated", `{"id":12}`, now, now, "updated").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	count, err := repo.EnqueueWebhookDeliveries(context.Background(), event, []byte(`{"id":12}`), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordWebhookAttempt(t *testing.T) {
+	mock, repo := setupWebhookTest(t)
+	now := time.Now()
+	attempt := domain.WebhookAttempt{At: now, ResponseCode: 200, Duration: 42 * time.Millisecond}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "webhook_attempts" ("delivery_id","attempted_at","response_code","error","duration_ms")`)).
+		WithArgs(int64(5), now, 200, "", 42).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "delivered_at"=$1,"last_error"=$2,"next_attempt_at"=$3,"response_code"=$4,"status"=$5 WHERE id = $6`)).
+		WithArgs(now, "", now, 200, "delivered", int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.RecordWebhookAttempt(context.Background(), 5, attempt, domain.WebhookDeliveryDelivered, now)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRedeliverWebhookDelivery_NotFound(t *testing.T) {
+	mock, repo := setupWebhookTest(t)
+	now := time.Now()
+
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "webhook_deliveries" SET "attempts"=$1,"delivered_at"=$2,"next_attempt_at"=$3,"status"=$4 WHERE id = $5 AND subscription_id = $6`)).
+		WithArgs(0, nil, now, "pending", int64(5), 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.RedeliverWebhookDelivery(context.Background(), 2, 5, now)
+
+	assert.ErrorIs(t, err, domain.ErrWebhookDeliveryNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AlbumService manages albums and their track listings
+type AlbumService struct {
+	repo  AlbumRepository
+	songs SongReader
+	tx    Transactor
+	audit Auditor
+}
+
+// AlbumRepository defines the interface for album repository operations
+type AlbumRepository interface {
+	CreateAlbum(ctx context.Context, album *domain.Album) (*domain.Album, error)
+	GetAlbum(ctx context.Context, id int) (*domain.Album, error)
+	ListAlbums(ctx context.Context, groupID, page, pageSize int) ([]*domain.Album, int64, error)
+	UpdateAlbum(ctx context.Context, id int, album *domain.Album) (*domain.Album, error)
+	DeleteAlbum(ctx context.Context, id int) error
+	GetAlbumTracks(ctx context.Context, albumID int) ([]domain.AlbumTrack, error)
+	ReplaceAlbumTracks(ctx context.Context, albumID int, tracks []domain.AlbumTrack) error
+	SyncReleaseDates(ctx context.Context, albumID int) (map[int]time.Time, error)
+}
+
+// NewAlbumService creates a new instance of AlbumService recording the
+// songs whose release date follows an album with audit, unless nil
+func NewAlbumService(repo AlbumRepository, songs SongReader, tx Transactor, audit Auditor) *AlbumService {
+	return &AlbumService{
+		repo:  repo,
+		songs: songs,
+		tx:    tx,
+		audit: audit,
+	}
+}
+
+// CreateAlbum creates an album with the given track listing. Songs of tracks
+// inheriting the release date take the one of the album.
+func (s *AlbumService) CreateAlbum(ctx context.Context, album *domain.Album) (*domain.Album, error) {
+	if err := normalizeAlbum(album); err != nil {
+		return nil, err
+	}
+
+	var id int
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err := s.repo.CreateAlbum(ctx, album)
+		if err != nil {
+			return err
+		}
+		id = created.ID
+		return s.saveTracks(ctx, id, album.Tracks)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAlbum(ctx, id)
+}
+
+// GetAlbum retrieves an album with its tracks
+func (s *AlbumService) GetAlbum(ctx context.Context, id int) (*domain.Album, error) {
+	album, err := s.repo.GetAlbum(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	album.Tracks, err = s.repo.GetAlbumTracks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return album, nil
+}
+
+// ListAlbums retrieves albums, optionally of one group, with pagination
+func (s *AlbumService) ListAlbums(ctx context.Context, groupID, page, pageSize int) ([]*domain.Album, int64, error) {
+	return s.repo.ListAlbums(ctx, groupID, page, pageSize)
+}
+
+// UpdateAlbum replaces an album. Its track listing is replaced as well when
+// album.Tracks is not nil. Songs inheriting the release date follow it.
+func (s *AlbumService) UpdateAlbum(ctx context.Context, id int, album *domain.Album) (*domain.Album, error) {
+	if err := normalizeAlbum(album); err != nil {
+		return nil, err
+	}
+
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := s.repo.UpdateAlbum(ctx, id, album); err != nil {
+			return err
+		}
+		if album.Tracks == nil {
+			return s.syncReleaseDates(ctx, id)
+		}
+		return s.saveTracks(ctx, id, album.Tracks)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAlbum(ctx, id)
+}
+
+// DeleteAlbum deletes an album; its songs are kept with their release dates
+func (s *AlbumService) DeleteAlbum(ctx context.Context, id int) error {
+	return s.repo.DeleteAlbum(ctx, id)
+}
+
+// GetAlbumTracks retrieves the tracks of an album in disc and track order
+func (s *AlbumService) GetAlbumTracks(ctx context.Context, id int) ([]domain.AlbumTrack, error) {
+	if _, err := s.repo.GetAlbum(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAlbumTracks(ctx, id)
+}
+
+func (s *AlbumService) saveTracks(ctx context.Context, albumID int, tracks []domain.AlbumTrack) error {
+	if err := s.repo.ReplaceAlbumTracks(ctx, albumID, tracks); err != nil {
+		return err
+	}
+	return s.syncReleaseDates(ctx, albumID)
+}
+
+// syncReleaseDates copies the release date of an album to the songs
+// inheriting it and records the songs changed, as patched
+func (s *AlbumService) syncReleaseDates(ctx context.Context, albumID int) error {
+	previous, err := s.repo.SyncReleaseDates(ctx, albumID)
+	if err != nil || s.audit == nil || len(previous) == 0 {
+		return err
+	}
+
+	ids := make([]int, 0, len(previous))
+	for id := range previous {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	entries := make([]domain.AuditEntry, 0, len(ids))
+	for _, id := range ids {
+		after, err := s.songs.GetSong(ctx, id)
+		if err != nil {
+			return err
+		}
+		before := *after
+		before.ReleaseDate = previous[id]
+		entries = append(entries, songAuditEntry(domain.AuditPatch, id, &before, after))
+	}
+	return s.audit.Record(ctx, entries...)
+}
+
+// normalizeAlbum validates an album, defaulting its type to album and the
+// disc of its tracks to 1
+func normalizeAlbum(album *domain.Album) error {
+	album.Title = strings.TrimSpace(album.Title)
+	if album.Title == "" || album.ReleaseDate.IsZero() || album.GroupID <= 0 {
+		return domain.ErrRequired
+	}
+	if album.Type == "" {
+		album.Type = domain.AlbumTypeAlbum
+	}
+	if !album.Type.Valid() {
+		return domain.ErrInvalidAlbumType
+	}
+
+	type slot struct{ disc, track int }
+	slots := make(map[slot]bool, len(album.Tracks))
+	songs := make(map[int]bool, len(album.Tracks))
+	for i := range album.Tracks {
+		track := &album.Tracks[i]
+		if track.DiscNumber == 0 {
+			track.DiscNumber = 1
+		}
+		if track.DiscNumber < 0 || track.TrackNumber <= 0 || track.SongID <= 0 {
+			return domain.ErrInvalidTrack
+		}
+
+		key := slot{track.DiscNumber, track.TrackNumber}
+		if slots[key] || songs[track.SongID] {
+			return domain.ErrInvalidTrack
+		}
+		slots[key] = true
+		songs[track.SongID] = true
+	}
+	return nil
+}
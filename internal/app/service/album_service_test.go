@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAlbumRepo is a mock implementation of AlbumRepository
+type MockAlbumRepo struct {
+	mock.Mock
+}
+
+func (m *MockAlbumRepo) CreateAlbum(ctx context.Context, album *domain.Album) (*domain.Album, error) {
+	args := m.Called(ctx, album)
+	created, _ := args.Get(0).(*domain.Album)
+	return created, args.Error(1)
+}
+
+func (m *MockAlbumRepo) GetAlbum(ctx context.Context, id int) (*domain.Album, error) {
+	args := m.Called(ctx, id)
+	album, _ := args.Get(0).(*domain.Album)
+	return album, args.Error(1)
+}
+
+func (m *MockAlbumRepo) ListAlbums(ctx context.Context, groupID, page, pageSize int) ([]*domain.Album, int64, error) {
+	args := m.Called(ctx, groupID, page, pageSize)
+	albums, _ := args.Get(0).([]*domain.Album)
+	return albums, args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockAlbumRepo) UpdateAlbum(ctx context.Context, id int, album *domain.Album) (*domain.Album, error) {
+	args := m.Called(ctx, id, album)
+	updated, _ := args.Get(0).(*domain.Album)
+	return updated, args.Error(1)
+}
+
+func (m *MockAlbumRepo) DeleteAlbum(ctx context.Context, id int) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockAlbumRepo) GetAlbumTracks(ctx context.Context, albumID int) ([]domain.AlbumTrack, error) {
+	args := m.Called(ctx, albumID)
+	tracks, _ := args.Get(0).([]domain.AlbumTrack)
+	return tracks, args.Error(1)
+}
+
+func (m *MockAlbumRepo) ReplaceAlbumTracks(ctx context.Context, albumID int, tracks []domain.AlbumTrack) error {
+	return m.Called(ctx, albumID, tracks).Error(0)
+}
+
+func (m *MockAlbumRepo) SyncReleaseDates(ctx context.Context, albumID int) (map[int]time.Time, error) {
+	args := m.Called(ctx, albumID)
+	previous, _ := args.Get(0).(map[int]time.Time)
+	return previous, args.Error(1)
+}
+
+func TestCreateAlbum_Validation(t *testing.T) {
+	released := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		album   domain.Album
+		wantErr error
+	}{
+		{name: "missing title", album: domain.Album{GroupID: 1, Title: "  ", ReleaseDate: released}, wantErr: domain.ErrRequired},
+		{name: "missing group", album: domain.Album{Title: "Debut", ReleaseDate: released}, wantErr: domain.ErrRequired},
+		{name: "missing date", album: domain.Album{GroupID: 1, Title: "Debut"}, wantErr: domain.ErrRequired},
+		{name: "unknown type", album: domain.Album{GroupID: 1, Title: "Debut", ReleaseDate: released, Type: "live"}, wantErr: domain.ErrInvalidAlbumType},
+		{
+			name: "same slot twice",
+			album: domain.Album{GroupID: 1, Title: "Debut", ReleaseDate: released, Tracks: []domain.AlbumTrack{
+				{TrackNumber: 1, SongID: 1},
+				{DiscNumber: 1, TrackNumber: 1, SongID: 2},
+			}},
+			wantErr: domain.ErrInvalidTrack,
+		},
+		{
+			name: "same song twice",
+			album: domain.Album{GroupID: 1, Title: "Debut", ReleaseDate: released, Tracks: []domain.AlbumTrack{
+				{TrackNumber: 1, SongID: 1},
+				{DiscNumber: 2, TrackNumber: 1, SongID: 1},
+			}},
+			wantErr: domain.ErrInvalidTrack,
+		},
+		{
+			name: "missing track number",
+			album: domain.Album{GroupID: 1, Title: "Debut", ReleaseDate: released, Tracks: []domain.AlbumTrack{
+				{SongID: 1},
+			}},
+			wantErr: domain.ErrInvalidTrack,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockAlbumRepo)
+			tx := &fakeTransactor{}
+			service := NewAlbumService(repo, new(MockSongRepo), tx, nil)
+
+			_, err := service.CreateAlbum(context.Background(), &tt.album)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 0, tx.calls)
+		})
+	}
+}
+
+func TestCreateAlbum_Defaults(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAlbumRepo)
+	service := NewAlbumService(repo, new(MockSongRepo), &fakeTransactor{}, nil)
+
+	album := &domain.Album{
+		GroupID:     1,
+		Title:       " Debut ",
+		ReleaseDate: time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC),
+		Tracks:      []domain.AlbumTrack{{TrackNumber: 1, SongID: 7, InheritReleaseDate: true}},
+	}
+	wantTracks := []domain.AlbumTrack{{DiscNumber: 1, TrackNumber: 1, SongID: 7, InheritReleaseDate: true}}
+
+	repo.On("CreateAlbum", ctx, mock.MatchedBy(func(a *domain.Album) bool {
+		return a.Title == "Debut" && a.Type == domain.AlbumTypeAlbum
+	})).Return(&domain.Album{ID: 3}, nil)
+	repo.On("ReplaceAlbumTracks", ctx, 3, wantTracks).Return(nil)
+	repo.On("SyncReleaseDates", ctx, 3).Return(nil, nil)
+	repo.On("GetAlbum", ctx, 3).Return(&domain.Album{ID: 3, Title: "Debut"}, nil)
+	repo.On("GetAlbumTracks", ctx, 3).Return(wantTracks, nil)
+
+	created, err := service.CreateAlbum(ctx, album)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, created.ID)
+	assert.Equal(t, wantTracks, created.Tracks)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateAlbum_KeepsTracks(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAlbumRepo)
+	tx := &fakeTransactor{}
+	service := NewAlbumService(repo, new(MockSongRepo), tx, nil)
+
+	album := &domain.Album{GroupID: 1, Title: "Debut", ReleaseDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	repo.On("UpdateAlbum", ctx, 3, album).Return(&domain.Album{ID: 3}, nil)
+	repo.On("SyncReleaseDates", ctx, 3).Return(nil, nil)
+	repo.On("GetAlbum", ctx, 3).Return(&domain.Album{ID: 3}, nil)
+	repo.On("GetAlbumTracks", ctx, 3).Return([]domain.AlbumTrack{}, nil)
+
+	_, err := service.UpdateAlbum(ctx, 3, album)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, tx.calls)
+	repo.AssertNotCalled(t, "ReplaceAlbumTracks", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateAlbum_RecordsSyncedSongs(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAlbumRepo)
+	songs := new(MockSongRepo)
+	audit, auditRepo, _ := newTestAuditService(0)
+	service := NewAlbumService(repo, songs, &fakeTransactor{}, audit)
+
+	previous := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	released := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	album := &domain.Album{GroupID: 1, Title: "Debut", ReleaseDate: released}
+
+	repo.On("UpdateAlbum", ctx, 3, album).Return(&domain.Album{ID: 3}, nil)
+	repo.On("SyncReleaseDates", ctx, 3).Return(map[int]time.Time{7: previous}, nil)
+	songs.On("GetSong", ctx, 7).Return(&domain.Song{ID: 7, Title: "Opener", ReleaseDate: released}, nil)
+	repo.On("GetAlbum", ctx, 3).Return(&domain.Album{ID: 3}, nil)
+	repo.On("GetAlbumTracks", ctx, 3).Return([]domain.AlbumTrack{}, nil)
+
+	_, err := service.UpdateAlbum(ctx, 3, album)
+
+	require.NoError(t, err)
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, domain.AuditPatch, auditRepo.entries[0].Action)
+	assert.Equal(t, 7, auditRepo.entries[0].ResourceID)
+	assert.Contains(t, string(auditRepo.entries[0].Before), `"release_date":"2020-05-01T00:00:00Z"`)
+	assert.Contains(t, string(auditRepo.entries[0].After), `"release_date":"2021-01-01T00:00:00Z"`)
+}
+
+func TestUpdateAlbum_RollsBackOnInheritConflict(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockAlbumRepo)
+	tx := &fakeTransactor{}
+	service := NewAlbumService(repo, new(MockSongRepo), tx, nil)
+
+	album := &domain.Album{
+		GroupID:     1,
+		Title:       "Debut",
+		ReleaseDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Tracks:      []domain.AlbumTrack{{DiscNumber: 1, TrackNumber: 1, SongID: 7, InheritReleaseDate: true}},
+	}
+
+	repo.On("UpdateAlbum", ctx, 3, album).Return(&domain.Album{ID: 3}, nil)
+	repo.On("ReplaceAlbumTracks", ctx, 3, album.Tracks).Return(domain.ErrReleaseDateInherited)
+
+	_, err := service.UpdateAlbum(ctx, 3, album)
+
+	assert.ErrorIs(t, err, domain.ErrReleaseDateInherited)
+	assert.True(t, tx.rolledBack)
+	repo.AssertNotCalled(t, "SyncReleaseDates", mock.Anything, mock.Anything)
+}
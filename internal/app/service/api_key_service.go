@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"songs/internal/app/domain"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// apiKeyPrefix marks secrets issued by this service, so they are easy to spot in logs and scanners
+	apiKeyPrefix = "sng_"
+	// apiKeySecretBytes is the amount of randomness in a key
+	apiKeySecretBytes = 24
+	// apiKeyDisplayLength is how much of a key is kept in clear to tell keys apart
+	apiKeyDisplayLength = len(apiKeyPrefix) + 8
+)
+
+// APIKeyService issues, revokes and authenticates API keys
+type APIKeyService struct {
+	repo APIKeyRepository
+	now  func() time.Time
+}
+
+// APIKeyRepository defines the interface for API key storage
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key domain.APIKey) (*domain.APIKey, error)
+	FindAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int, at time.Time) error
+}
+
+// NewAPIKeyService creates a new instance of APIKeyService
+func NewAPIKeyService(repo APIKeyRepository) *APIKeyService {
+	return &APIKeyService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// IssueKey creates a key with the given role. The returned secret is not
+// stored anywhere and cannot be recovered later.
+func (s *APIKeyService) IssueKey(ctx context.Context, name string, role domain.Role) (*domain.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", domain.ErrRequired
+	}
+	if !role.Valid() {
+		return nil, "", domain.ErrInvalidRole
+	}
+
+	buf := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", fmt.Errorf("generate API key: %w", err)
+	}
+	secret := apiKeyPrefix + hex.EncodeToString(buf)
+
+	key, err := s.repo.CreateAPIKey(ctx, domain.APIKey{
+		Name:      name,
+		Prefix:    secret[:apiKeyDisplayLength],
+		Hash:      hashAPIKey(secret),
+		Role:      role,
+		CreatedAt: s.now(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+// Authenticate resolves the caller owning the given secret. Unknown and
+// revoked keys fail with ErrUnauthenticated.
+func (s *APIKeyService) Authenticate(ctx context.Context, secret string) (*domain.Principal, error) {
+	if !strings.HasPrefix(secret, apiKeyPrefix) {
+		return nil, domain.ErrUnauthenticated
+	}
+
+	key, err := s.repo.FindAPIKeyByHash(ctx, hashAPIKey(secret))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+	if key.RevokedAt != nil {
+		return nil, domain.ErrUnauthenticated
+	}
+
+	return &domain.Principal{
+		Subject: "apikey:" + strconv.Itoa(key.ID),
+		Name:    key.Name,
+		Role:    key.Role,
+	}, nil
+}
+
+// ListKeys returns all keys, revoked ones included
+func (s *APIKeyService) ListKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	return s.repo.ListAPIKeys(ctx)
+}
+
+// RevokeKey disables a key
+func (s *APIKeyService) RevokeKey(ctx context.Context, id int) error {
+	return s.repo.RevokeAPIKey(ctx, id, s.now())
+}
+
+// hashAPIKey hashes a secret for storage. Keys carry enough randomness that a
+// plain SHA-256 is sufficient, unlike passwords.
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAPIKeyRepo is a mock implementation of APIKeyRepository
+type MockAPIKeyRepo struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepo) CreateAPIKey(ctx context.Context, key domain.APIKey) (*domain.APIKey, error) {
+	args := m.Called(ctx, key)
+	created, _ := args.Get(0).(*domain.APIKey)
+	return created, args.Error(1)
+}
+
+func (m *MockAPIKeyRepo) FindAPIKeyByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	args := m.Called(ctx, hash)
+	key, _ := args.Get(0).(*domain.APIKey)
+	return key, args.Error(1)
+}
+
+func (m *MockAPIKeyRepo) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	args := m.Called(ctx)
+	keys, _ := args.Get(0).([]*domain.APIKey)
+	return keys, args.Error(1)
+}
+
+func (m *MockAPIKeyRepo) RevokeAPIKey(ctx context.Context, id int, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+func TestIssueKey_StoresOnlyHash(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepo)
+	service := NewAPIKeyService(mockRepo)
+
+	ctx := context.Background()
+	var stored domain.APIKey
+	mockRepo.On("CreateAPIKey", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(domain.APIKey)
+	}).Return(&domain.APIKey{ID: 3, Name: "ci", Role: domain.RoleEditor}, nil)
+
+	key, secret, err := service.IssueKey(ctx, " ci ", domain.RoleEditor)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, key.ID)
+	assert.True(t, strings.HasPrefix(secret, apiKeyPrefix))
+	assert.Equal(t, "ci", stored.Name)
+	assert.Equal(t, hashAPIKey(secret), stored.Hash)
+	assert.NotContains(t, stored.Hash, secret)
+	assert.True(t, strings.HasPrefix(secret, stored.Prefix))
+}
+
+func TestIssueKey_InvalidInput(t *testing.T) {
+	service := NewAPIKeyService(new(MockAPIKeyRepo))
+
+	_, _, err := service.IssueKey(context.Background(), "", domain.RoleReader)
+	assert.ErrorIs(t, err, domain.ErrRequired)
+
+	_, _, err = service.IssueKey(context.Background(), "ci", domain.Role("root"))
+	assert.ErrorIs(t, err, domain.ErrInvalidRole)
+}
+
+func TestAuthenticate(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepo)
+	service := NewAPIKeyService(mockRepo)
+
+	ctx := context.Background()
+	revokedAt := time.Now()
+	mockRepo.On("FindAPIKeyByHash", ctx, hashAPIKey("sng_valid")).Return(&domain.APIKey{ID: 7, Name: "ci", Role: domain.RoleEditor}, nil)
+	mockRepo.On("FindAPIKeyByHash", ctx, hashAPIKey("sng_revoked")).Return(&domain.APIKey{ID: 8, Role: domain.RoleAdmin, RevokedAt: &revokedAt}, nil)
+	mockRepo.On("FindAPIKeyByHash", ctx, hashAPIKey("sng_unknown")).Return(nil, domain.ErrNotFound)
+
+	principal, err := service.Authenticate(ctx, "sng_valid")
+	require.NoError(t, err)
+	assert.Equal(t, "apikey:7", principal.Subject)
+	assert.Equal(t, domain.RoleEditor, principal.Role)
+
+	for _, secret := range []string{"sng_revoked", "sng_unknown", "not-a-key"} {
+		_, err := service.Authenticate(ctx, secret)
+		assert.ErrorIs(t, err, domain.ErrUnauthenticated, secret)
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	assert.True(t, domain.RoleAdmin.Allows(domain.RoleEditor))
+	assert.True(t, domain.RoleEditor.Allows(domain.RoleReader))
+	assert.False(t, domain.RoleReader.Allows(domain.RoleEditor))
+	assert.False(t, domain.Role("").Allows(domain.RoleReader))
+}
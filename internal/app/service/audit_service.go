@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"songs/internal/app/domain"
+	"time"
+)
+
+// auditMaxPageSize bounds the page size of audit log listings
+const auditMaxPageSize = 200
+
+// Auditor records changes to songs and groups in the audit log
+type Auditor interface {
+	// Record stamps entries with the time, the caller and the request of
+	// ctx, and appends them to the log
+	Record(ctx context.Context, entries ...domain.AuditEntry) error
+}
+
+// AuditService keeps the append-only log of the changes made to songs and
+// groups: who made them, through which request and transport, and the
+// record before and after. Entries are removed once past the retention.
+type AuditService struct {
+	repo      AuditRepository
+	retention time.Duration
+	now       func() time.Time
+}
+
+// AuditRepository defines the interface for the audit log storage
+type AuditRepository interface {
+	AppendAuditEntries(ctx context.Context, entries []domain.AuditEntry) error
+	ListAuditEntries(ctx context.Context, filter domain.AuditFilter, page, pageSize int) ([]domain.AuditEntry, int64, error)
+	DeleteAuditEntriesBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// NewAuditService creates a new instance of AuditService keeping entries
+// for retention, or forever when it is zero
+func NewAuditService(repo AuditRepository, retention time.Duration) *AuditService {
+	return &AuditService{
+		repo:      repo,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// Record stamps entries with the time, the authenticated caller and the
+// request of ctx, and appends them to the log within the transaction of
+// ctx, if any
+func (s *AuditService) Record(ctx context.Context, entries ...domain.AuditEntry) error {
+	now := s.now()
+	principal := domain.PrincipalFromContext(ctx)
+	request := domain.RequestInfoFromContext(ctx)
+	for i := range entries {
+		entries[i].Time = now
+		if principal != nil {
+			entries[i].Actor = principal.Subject
+			entries[i].ActorName = principal.Name
+		}
+		entries[i].RequestID = request.ID
+		entries[i].Transport = request.Transport
+	}
+	return s.repo.AppendAuditEntries(ctx, entries)
+}
+
+// List returns a page of the entries matching filter, newest first, and
+// the number of entries matching
+func (s *AuditService) List(ctx context.Context, filter domain.AuditFilter, page, pageSize int) ([]domain.AuditEntry, int64, error) {
+	switch filter.Resource {
+	case "", domain.AuditSong, domain.AuditGroup:
+	default:
+		return nil, 0, fmt.Errorf("%w: resource must be song or group", domain.ErrInvalidData)
+	}
+	switch filter.Action {
+	case "", domain.AuditCreate, domain.AuditUpdate, domain.AuditPatch, domain.AuditDelete:
+	default:
+		return nil, 0, fmt.Errorf("%w: action must be create, update, patch or delete", domain.ErrInvalidData)
+	}
+	switch filter.Transport {
+	case "", domain.TransportHTTP, domain.TransportGRPC:
+	default:
+		return nil, 0, fmt.Errorf("%w: transport must be http or grpc", domain.ErrInvalidData)
+	}
+	return s.repo.ListAuditEntries(ctx, filter, page, min(pageSize, auditMaxPageSize))
+}
+
+// Purge removes the entries recorded longer than the retention ago and
+// returns how many were removed
+func (s *AuditService) Purge(ctx context.Context) (int64, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.repo.DeleteAuditEntriesBefore(ctx, s.now().Add(-s.retention))
+}
+
+// auditedSong is the JSON document of a song in the audit log
+type auditedSong struct {
+	ID             int             `json:"id"`
+	GroupID        int             `json:"group_id"`
+	Title          string          `json:"title"`
+	ReleaseDate    string          `json:"release_date"`
+	Text           string          `json:"text"`
+	Link           string          `json:"link"`
+	Language       string          `json:"language"`
+	Explicit       bool            `json:"explicit"`
+	ExplicitManual bool            `json:"explicit_manual"`
+	Credits        []auditedCredit `json:"credits,omitempty"`
+}
+
+type auditedCredit struct {
+	ArtistID int    `json:"artist_id"`
+	Role     string `json:"role"`
+}
+
+// songAuditEntry is the entry of a change to song id, before or after
+// being nil when the song did not exist
+func songAuditEntry(action domain.AuditAction, id int, before, after *domain.Song) domain.AuditEntry {
+	return domain.AuditEntry{
+		Resource:   domain.AuditSong,
+		ResourceID: id,
+		Action:     action,
+		Before:     songSnapshot(before),
+		After:      songSnapshot(after),
+	}
+}
+
+func songSnapshot(song *domain.Song) []byte {
+	if song == nil {
+		return nil
+	}
+	snapshot := auditedSong{
+		ID:             song.ID,
+		GroupID:        song.GroupID,
+		Title:          song.Title,
+		ReleaseDate:    song.ReleaseDate.Format(time.RFC3339),
+		Text:           song.Text,
+		Link:           song.Link,
+		Language:       song.Language,
+		Explicit:       song.Explicit,
+		ExplicitManual: song.ExplicitManual,
+	}
+	for _, credit := range song.Credits {
+		snapshot.Credits = append(snapshot.Credits, auditedCredit{ArtistID: credit.ArtistID, Role: string(credit.Role)})
+	}
+	data, _ := json.Marshal(snapshot)
+	return data
+}
+
+// groupAuditEntry is the entry of the creation of a group
+func groupAuditEntry(action domain.AuditAction, group *domain.SongGroup) domain.AuditEntry {
+	data, _ := json.Marshal(struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}{group.ID, group.Name})
+	return domain.AuditEntry{
+		Resource:   domain.AuditGroup,
+		ResourceID: group.ID,
+		Action:     action,
+		After:      data,
+	}
+}
+
+// AuditedSongService is a SongService recording the songs it creates,
+// updates, patches and deletes in the audit log. The song is read, written
+// and recorded within one transaction, so a write is never kept without its
+// entry.
+type AuditedSongService struct {
+	*SongService
+	audit Auditor
+	tx    Transactor
+}
+
+// NewAuditedSongService wraps songs to record its writes with audit
+// within transactions of tx
+func NewAuditedSongService(songs *SongService, audit Auditor, tx Transactor) *AuditedSongService {
+	return &AuditedSongService{
+		SongService: songs,
+		audit:       audit,
+		tx:          tx,
+	}
+}
+
+// CreateSong creates a song and records it
+func (s *AuditedSongService) CreateSong(ctx context.Context, song *domain.Song) (*domain.Song, error) {
+	var created *domain.Song
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if created, err = s.SongService.CreateSong(ctx, song); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, songAuditEntry(domain.AuditCreate, created.ID, nil, created))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateSong replaces a song and records it before and after
+func (s *AuditedSongService) UpdateSong(ctx context.Context, id int, song *domain.Song) (*domain.Song, error) {
+	var updated *domain.Song
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.SongService.GetSong(ctx, id)
+		if err != nil {
+			return err
+		}
+		if updated, err = s.SongService.UpdateSong(ctx, id, song); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, songAuditEntry(domain.AuditUpdate, id, before, updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// PartialUpdateSong patches a song and records it before and after
+func (s *AuditedSongService) PartialUpdateSong(ctx context.Context, id int, updates map[string]interface{}) (*domain.Song, error) {
+	var updated *domain.Song
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.SongService.GetSong(ctx, id)
+		if err != nil {
+			return err
+		}
+		if updated, err = s.SongService.PartialUpdateSong(ctx, id, updates); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, songAuditEntry(domain.AuditPatch, id, before, updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteSong deletes a song and records it as it was
+func (s *AuditedSongService) DeleteSong(ctx context.Context, id int) error {
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.SongService.GetSong(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.SongService.DeleteSong(ctx, id); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, songAuditEntry(domain.AuditDelete, id, before, nil))
+	})
+}
+
+// DetectLanguages detects the language of songs like the SongService,
+// recording every song updated
+func (s *AuditedSongService) DetectLanguages(ctx context.Context, all bool, batchSize int) (int, error) {
+	return s.detectLanguages(ctx, all, batchSize, s.recorded(s.setLanguage))
+}
+
+// ScanExplicit flags songs explicit again like the SongService, recording
+// every song whose flag changed
+func (s *AuditedSongService) ScanExplicit(ctx context.Context, batchSize int) (int, error) {
+	return s.scanExplicit(ctx, batchSize, s.recorded(s.setExplicit))
+}
+
+// recorded wraps set to record the songs it changes as patched, within the
+// transaction of the change
+func (s *AuditedSongService) recorded(set songSetter) songSetter {
+	return func(ctx context.Context, before, after *domain.Song) error {
+		return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := set(ctx, before, after); err != nil {
+				return err
+			}
+			return s.audit.Record(ctx, songAuditEntry(domain.AuditPatch, after.ID, before, after))
+		})
+	}
+}
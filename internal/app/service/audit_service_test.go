@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAuditRepo keeps the audit log in memory, failing with err when set
+type fakeAuditRepo struct {
+	entries     []domain.AuditEntry
+	purgeBefore time.Time
+	err         error
+}
+
+func (r *fakeAuditRepo) AppendAuditEntries(_ context.Context, entries []domain.AuditEntry) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.entries = append(r.entries, entries...)
+	return nil
+}
+
+func (r *fakeAuditRepo) ListAuditEntries(context.Context, domain.AuditFilter, int, int) ([]domain.AuditEntry, int64, error) {
+	return r.entries, int64(len(r.entries)), nil
+}
+
+func (r *fakeAuditRepo) DeleteAuditEntriesBefore(_ context.Context, before time.Time) (int64, error) {
+	r.purgeBefore = before
+	return 0, nil
+}
+
+func newTestAuditService(retention time.Duration) (*AuditService, *fakeAuditRepo, time.Time) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	repo := &fakeAuditRepo{}
+	service := NewAuditService(repo, retention)
+	service.now = func() time.Time { return now }
+	return service, repo, now
+}
+
+func TestAuditService_Record(t *testing.T) {
+	service, repo, now := newTestAuditService(0)
+	ctx := domain.WithPrincipal(context.Background(), &domain.Principal{Subject: "apikey:2", Name: "ci", Role: domain.RoleEditor})
+	ctx = domain.WithRequestInfo(ctx, domain.RequestInfo{ID: "req-1", Transport: domain.TransportGRPC})
+
+	require.NoError(t, service.Record(ctx, songAuditEntry(domain.AuditDelete, 123, &domain.Song{ID: 123, Title: "Hysteria"}, nil)))
+
+	require.Len(t, repo.entries, 1)
+	entry := repo.entries[0]
+	assert.Equal(t, now, entry.Time)
+	assert.Equal(t, "apikey:2", entry.Actor)
+	assert.Equal(t, "ci", entry.ActorName)
+	assert.Equal(t, "req-1", entry.RequestID)
+	assert.Equal(t, domain.TransportGRPC, entry.Transport)
+	assert.Equal(t, domain.AuditSong, entry.Resource)
+	assert.Contains(t, string(entry.Before), `"title":"Hysteria"`)
+	assert.Nil(t, entry.After)
+
+	require.NoError(t, service.Record(context.Background(), groupAuditEntry(domain.AuditCreate, &domain.SongGroup{ID: 7, Name: "Muse"})))
+	assert.Empty(t, repo.entries[1].Actor, "anonymous")
+	assert.Empty(t, repo.entries[1].Transport)
+	assert.JSONEq(t, `{"id":7,"name":"Muse"}`, string(repo.entries[1].After))
+}
+
+func TestAuditService_List_InvalidFilter(t *testing.T) {
+	service, _, _ := newTestAuditService(0)
+	ctx := context.Background()
+
+	for _, filter := range []domain.AuditFilter{
+		{Resource: "album"},
+		{Action: "merge"},
+		{Transport: "smtp"},
+	} {
+		_, _, err := service.List(ctx, filter, 1, 50)
+		assert.ErrorIs(t, err, domain.ErrInvalidData, filter)
+	}
+}
+
+func TestAuditService_Purge(t *testing.T) {
+	service, repo, now := newTestAuditService(0)
+	_, err := service.Purge(context.Background())
+	require.NoError(t, err)
+	assert.True(t, repo.purgeBefore.IsZero(), "kept forever without retention")
+
+	service, repo, now = newTestAuditService(24 * time.Hour)
+	_, err = service.Purge(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-24*time.Hour), repo.purgeBefore)
+}
+
+func TestAuditedSongService(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	audit, repo, _ := newTestAuditService(0)
+	tx := &fakeTransactor{}
+	service := NewAuditedSongService(NewSongService(mockRepo, nil, nil, nil), audit, tx)
+	ctx := domain.WithPrincipal(context.Background(), &domain.Principal{Subject: "apikey:2", Role: domain.RoleEditor})
+
+	original := &domain.Song{ID: 1, GroupID: 7, Title: "Hysteria"}
+	updated := &domain.Song{ID: 1, GroupID: 7, Title: "Hysteria (Live)"}
+	mockRepo.On("CreateSong", ctx, original).Return(original, nil)
+	mockRepo.On("GetSong", ctx, 1).Return(original, nil)
+	mockRepo.On("UpdateSong", ctx, 1, updated).Return(updated, nil)
+	mockRepo.On("PartialUpdateSong", ctx, 1, map[string]interface{}{"title": "Hysteria (Live)"}).Return(updated, nil)
+	mockRepo.On("DeleteSong", ctx, 1).Return(nil)
+	mockRepo.On("GetSong", ctx, 9).Return(nil, domain.ErrNotFound)
+
+	_, err := service.CreateSong(ctx, original)
+	require.NoError(t, err)
+	_, err = service.UpdateSong(ctx, 1, updated)
+	require.NoError(t, err)
+	_, err = service.PartialUpdateSong(ctx, 1, map[string]interface{}{"title": "Hysteria (Live)"})
+	require.NoError(t, err)
+	require.NoError(t, service.DeleteSong(ctx, 1))
+	assert.ErrorIs(t, service.DeleteSong(ctx, 9), domain.ErrNotFound)
+
+	require.Len(t, repo.entries, 4, "failed writes are not recorded")
+	actions := []domain.AuditAction{domain.AuditCreate, domain.AuditUpdate, domain.AuditPatch, domain.AuditDelete}
+	for i, entry := range repo.entries {
+		assert.Equal(t, actions[i], entry.Action)
+		assert.Equal(t, 1, entry.ResourceID)
+		assert.Equal(t, "apikey:2", entry.Actor)
+	}
+	assert.Nil(t, repo.entries[0].Before)
+	assert.Contains(t, string(repo.entries[1].Before), `"title":"Hysteria"`)
+	assert.Contains(t, string(repo.entries[1].After), `"title":"Hysteria (Live)"`)
+	assert.Nil(t, repo.entries[3].After)
+	mockRepo.AssertNotCalled(t, "DeleteSong", ctx, 9)
+	assert.Equal(t, 5, tx.calls, "every write is recorded in its transaction")
+}
+
+func TestAuditedSongService_RecordFails(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	audit, repo, _ := newTestAuditService(0)
+	repo.err = domain.ErrDatabase
+	tx := &fakeTransactor{}
+	service := NewAuditedSongService(NewSongService(mockRepo, nil, nil, nil), audit, tx)
+	ctx := context.Background()
+
+	song := &domain.Song{ID: 1, GroupID: 7, Title: "Hysteria"}
+	mockRepo.On("GetSong", ctx, 1).Return(song, nil)
+	mockRepo.On("DeleteSong", ctx, 1).Return(nil)
+
+	err := service.DeleteSong(ctx, 1)
+
+	assert.ErrorIs(t, err, domain.ErrDatabase)
+	assert.True(t, tx.rolledBack, "the song is kept when its deletion cannot be recorded")
+}
+
+func TestAuditedSongService_DetectLanguages(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	audit, repo, _ := newTestAuditService(0)
+	tx := &fakeTransactor{}
+	detector := fakeDetector{"Well damn": {Language: "en", Script: "Latn", Confidence: 0.9}}
+	service := NewAuditedSongService(NewSongService(mockRepo, detector, nil, nil), audit, tx)
+	ctx := context.Background()
+
+	mockRepo.On("SongsWithoutLanguage", ctx, 0, 10, false).Return([]*domain.Song{{ID: 3, Text: "Well damn"}}, nil)
+	mockRepo.On("SetSongLanguage", ctx, 3, "en", "Latn", 0.9).Return(nil)
+
+	updated, err := service.DetectLanguages(ctx, false, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, 1, tx.calls)
+	require.Len(t, repo.entries, 1)
+	assert.Equal(t, domain.AuditPatch, repo.entries[0].Action)
+	assert.Contains(t, string(repo.entries[0].Before), `"language":""`)
+	assert.Contains(t, string(repo.entries[0].After), `"language":"en"`)
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"strings"
+)
+
+// Authenticator resolves the caller presenting a credential
+type Authenticator interface {
+	Authenticate(ctx context.Context, credential string) (*domain.Principal, error)
+}
+
+// CredentialAuthenticator dispatches credentials shaped like a JWT to the JWT
+// authenticator and everything else to the API key authenticator. Either may
+// be nil to disable that kind of credential.
+type CredentialAuthenticator struct {
+	apiKeys Authenticator
+	jwt     Authenticator
+}
+
+// NewCredentialAuthenticator creates a new instance of CredentialAuthenticator
+func NewCredentialAuthenticator(apiKeys, jwt Authenticator) *CredentialAuthenticator {
+	return &CredentialAuthenticator{
+		apiKeys: apiKeys,
+		jwt:     jwt,
+	}
+}
+
+// Authenticate resolves the caller presenting credential
+func (a *CredentialAuthenticator) Authenticate(ctx context.Context, credential string) (*domain.Principal, error) {
+	next := a.apiKeys
+	if strings.Count(credential, ".") == 2 {
+		next = a.jwt
+	}
+	if next == nil {
+		return nil, domain.ErrUnauthenticated
+	}
+	return next.Authenticate(ctx, credential)
+}
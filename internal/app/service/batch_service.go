@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+)
+
+// errBatchFailed rolls back an atomic batch after an item failed
+var errBatchFailed = errors.New("batch item failed")
+
+// BatchService applies song writes in bulk
+type BatchService struct {
+	repo SongWriter
+	tx   Transactor
+}
+
+// SongWriter writes songs one at a time. A SongService writes them like
+// single writes do, detecting their language and explicit lyrics.
+type SongWriter interface {
+	GetSong(ctx context.Context, id int) (*domain.Song, error)
+	CreateSong(ctx context.Context, song *domain.Song) (*domain.Song, error)
+	UpdateSong(ctx context.Context, id int, song *domain.Song) (*domain.Song, error)
+	DeleteSong(ctx context.Context, id int) error
+}
+
+// Transactor runs functions inside a database transaction carried by the context
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// NewBatchService creates a new instance of BatchService
+func NewBatchService(repo SongWriter, tx Transactor) *BatchService {
+	return &BatchService{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// CreateSongs creates every song of the batch
+func (s *BatchService) CreateSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error) {
+	return s.run(ctx, items, mode, func(ctx context.Context, item domain.BatchItem) (*domain.Song, error) {
+		return s.repo.CreateSong(ctx, item.Song)
+	})
+}
+
+// UpdateSongs replaces every song of the batch, identified by item ID
+func (s *BatchService) UpdateSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error) {
+	return s.run(ctx, items, mode, func(ctx context.Context, item domain.BatchItem) (*domain.Song, error) {
+		// Save upserts, so make sure the song exists first
+		if _, err := s.repo.GetSong(ctx, item.ID); err != nil {
+			return nil, err
+		}
+		return s.repo.UpdateSong(ctx, item.ID, item.Song)
+	})
+}
+
+// DeleteSongs deletes every song of the batch, identified by item ID
+func (s *BatchService) DeleteSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error) {
+	return s.run(ctx, items, mode, func(ctx context.Context, item domain.BatchItem) (*domain.Song, error) {
+		return nil, s.repo.DeleteSong(ctx, item.ID)
+	})
+}
+
+// run applies fn to every item. In atomic mode all items share a transaction
+// and the first failure rolls back the whole batch; the other items are then
+// reported with ErrBatchAborted. In best-effort mode every item is applied on
+// its own and failures do not affect the other items.
+func (s *BatchService) run(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode, fn func(ctx context.Context, item domain.BatchItem) (*domain.Song, error)) (*domain.BatchReport, error) {
+	if len(items) == 0 {
+		return nil, domain.ErrRequired
+	}
+	if len(items) > domain.MaxBatchSize {
+		return nil, domain.ErrBatchTooLarge
+	}
+
+	report := &domain.BatchReport{Mode: mode, Results: make([]domain.BatchResult, len(items))}
+	apply := func(ctx context.Context, i int) error {
+		item := items[i]
+		result := domain.BatchResult{Index: i, ID: item.ID, Err: item.Err}
+		if result.Err == nil {
+			result.Song, result.Err = fn(ctx, item)
+			if result.Song != nil {
+				result.ID = result.Song.ID
+			}
+		}
+		report.Results[i] = result
+		return result.Err
+	}
+
+	switch mode {
+	case domain.BatchModeAtomic:
+		// Invalid input fails the batch before touching the database
+		for i, item := range items {
+			if item.Err != nil {
+				report.Results[i] = domain.BatchResult{Index: i, ID: item.ID, Err: item.Err}
+				return finishAborted(report, items, i), nil
+			}
+		}
+
+		failed := -1
+		err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			for i := range items {
+				if err := apply(ctx, i); err != nil {
+					failed = i
+					return errBatchFailed
+				}
+			}
+			return nil
+		})
+		if failed >= 0 {
+			return finishAborted(report, items, failed), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		report.Committed = true
+	case domain.BatchModeBestEffort:
+		for i := range items {
+			_ = apply(ctx, i)
+		}
+		report.Committed = true
+	default:
+		return nil, domain.ErrInvalidData
+	}
+
+	for _, result := range report.Results {
+		if result.Err != nil {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+	}
+	return report, nil
+}
+
+// finishAborted marks every item except the failed one as aborted
+func finishAborted(report *domain.BatchReport, items []domain.BatchItem, failed int) *domain.BatchReport {
+	for i := range report.Results {
+		if i == failed {
+			continue
+		}
+		report.Results[i] = domain.BatchResult{Index: i, ID: items[i].ID, Err: domain.ErrBatchAborted}
+	}
+	report.Committed = false
+	report.Succeeded = 0
+	report.Failed = len(report.Results)
+	return report
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransactor runs functions inline and records whether the transaction was rolled back
+type fakeTransactor struct {
+	calls      int
+	rolledBack bool
+}
+
+func (f *fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.calls++
+	err := fn(ctx)
+	f.rolledBack = err != nil
+	return err
+}
+
+func TestBatchCreateSongs_Atomic(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	tx := &fakeTransactor{}
+	service := NewBatchService(mockRepo, tx)
+
+	ctx := context.Background()
+	first := &domain.Song{GroupID: 1, Title: "First", ReleaseDate: time.Now()}
+	second := &domain.Song{GroupID: 1, Title: "Second", ReleaseDate: time.Now()}
+
+	mockRepo.On("CreateSong", ctx, first).Return(&domain.Song{ID: 10, Title: "First"}, nil)
+	mockRepo.On("CreateSong", ctx, second).Return(&domain.Song{ID: 11, Title: "Second"}, nil)
+
+	report, err := service.CreateSongs(ctx, []domain.BatchItem{{Song: first}, {Song: second}}, domain.BatchModeAtomic)
+
+	require.NoError(t, err)
+	assert.True(t, report.Committed)
+	assert.Equal(t, 2, report.Succeeded)
+	assert.Equal(t, 10, report.Results[0].ID)
+	assert.Equal(t, 11, report.Results[1].ID)
+	assert.Equal(t, 1, tx.calls)
+	assert.False(t, tx.rolledBack)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBatchDeleteSongs_AtomicRollback(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	tx := &fakeTransactor{}
+	service := NewBatchService(mockRepo, tx)
+
+	ctx := context.Background()
+	mockRepo.On("DeleteSong", ctx, 1).Return(nil)
+	mockRepo.On("DeleteSong", ctx, 2).Return(domain.ErrNotFound)
+
+	report, err := service.DeleteSongs(ctx, []domain.BatchItem{{ID: 1}, {ID: 2}, {ID: 3}}, domain.BatchModeAtomic)
+
+	require.NoError(t, err)
+	assert.False(t, report.Committed)
+	assert.True(t, tx.rolledBack)
+	assert.Equal(t, 3, report.Failed)
+	assert.ErrorIs(t, report.Results[0].Err, domain.ErrBatchAborted)
+	assert.ErrorIs(t, report.Results[1].Err, domain.ErrNotFound)
+	assert.ErrorIs(t, report.Results[2].Err, domain.ErrBatchAborted)
+	assert.Equal(t, 3, report.Results[2].ID)
+	mockRepo.AssertNotCalled(t, "DeleteSong", ctx, 3)
+}
+
+func TestBatchDeleteSongs_AtomicInvalidItem(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	tx := &fakeTransactor{}
+	service := NewBatchService(mockRepo, tx)
+
+	report, err := service.DeleteSongs(context.Background(), []domain.BatchItem{{ID: 1}, {Err: domain.ErrInvalidID}}, domain.BatchModeAtomic)
+
+	require.NoError(t, err)
+	assert.False(t, report.Committed)
+	assert.Equal(t, 0, tx.calls)
+	assert.ErrorIs(t, report.Results[0].Err, domain.ErrBatchAborted)
+	assert.ErrorIs(t, report.Results[1].Err, domain.ErrInvalidID)
+	mockRepo.AssertNotCalled(t, "DeleteSong", mock.Anything, mock.Anything)
+}
+
+func TestBatchUpdateSongs_BestEffort(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	tx := &fakeTransactor{}
+	service := NewBatchService(mockRepo, tx)
+
+	ctx := context.Background()
+	song := &domain.Song{ID: 1, GroupID: 1, Title: "Updated", ReleaseDate: time.Now()}
+
+	mockRepo.On("GetSong", ctx, 1).Return(&domain.Song{ID: 1}, nil)
+	mockRepo.On("UpdateSong", ctx, 1, song).Return(song, nil)
+	mockRepo.On("GetSong", ctx, 2).Return(nil, domain.ErrNotFound)
+
+	report, err := service.UpdateSongs(ctx, []domain.BatchItem{
+		{ID: 1, Song: song},
+		{ID: 2, Song: &domain.Song{ID: 2}},
+		{Err: domain.ErrInvalidData},
+	}, domain.BatchModeBestEffort)
+
+	require.NoError(t, err)
+	assert.True(t, report.Committed)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, "Updated", report.Results[0].Song.Title)
+	assert.ErrorIs(t, report.Results[1].Err, domain.ErrNotFound)
+	assert.ErrorIs(t, report.Results[2].Err, domain.ErrInvalidData)
+	assert.Equal(t, 0, tx.calls)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestBatch_TooLarge(t *testing.T) {
+	service := NewBatchService(new(MockSongRepo), &fakeTransactor{})
+
+	_, err := service.DeleteSongs(context.Background(), make([]domain.BatchItem, domain.MaxBatchSize+1), domain.BatchModeBestEffort)
+
+	assert.ErrorIs(t, err, domain.ErrBatchTooLarge)
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"log"
+	"songs/internal/app/domain"
+	"sync"
+	"time"
+)
+
+const (
+	// changeFeedBatchSize is the number of events read at a time by watchers
+	changeFeedBatchSize = 100
+	// changeFeedPollInterval is how often watchers look for events recorded
+	// by other instances or writers, or committed after they were woken up
+	changeFeedPollInterval = time.Second
+	// changeFeedRetryInterval is how long followers wait before reading the
+	// feed again after failing to
+	changeFeedRetryInterval = 5 * time.Second
+)
+
+// ChangeFeedService streams the change feed of songs to watchers. A
+// database trigger records an event for every song written, whatever wrote
+// it, in the transaction of the write. Events are stored, so watchers resume
+// where they left off, on any instance.
+type ChangeFeedService struct {
+	events    SongEventRepository
+	retention time.Duration
+	now       func() time.Time
+
+	mu sync.Mutex
+	// recorded is closed, and replaced, whenever a song is written here
+	recorded chan struct{}
+}
+
+// SongEventRepository defines the interface for song event storage
+type SongEventRepository interface {
+	SongEventsAfter(ctx context.Context, afterSeq int64, limit int) ([]domain.SongEvent, error)
+	SongEventBounds(ctx context.Context) (first, last int64, err error)
+	DeleteSongEventsBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// NewChangeFeedService creates a new instance of ChangeFeedService keeping events for retention
+func NewChangeFeedService(events SongEventRepository, retention time.Duration) *ChangeFeedService {
+	return &ChangeFeedService{
+		events:    events,
+		retention: retention,
+		now:       time.Now,
+		recorded:  make(chan struct{}),
+	}
+}
+
+// SongChanged wakes up the watchers of this instance, as one of the
+// SongObservers of the SongService, so they look for the event of the write
+// right away. Watchers find the events of other writes on their next poll.
+func (s *ChangeFeedService) SongChanged(context.Context, domain.SongEvent) {
+	s.mu.Lock()
+	close(s.recorded)
+	s.recorded = make(chan struct{})
+	s.mu.Unlock()
+}
+
+// Watch calls fn with the events numbered above afterSeq, oldest first, then
+// with each event recorded afterwards, until ctx is done or fn fails. A
+// negative afterSeq starts with the next event recorded. When the events
+// following afterSeq were purged, or afterSeq is unknown, fn is first
+// called with a SongEventReset event numbered to resume from.
+func (s *ChangeFeedService) Watch(ctx context.Context, afterSeq int64, fn func(domain.SongEvent) error) error {
+	first, last, err := s.events.SongEventBounds(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case afterSeq < 0:
+		afterSeq = last
+	case afterSeq > last:
+		afterSeq = last
+		if err := fn(domain.SongEvent{Seq: afterSeq, Type: domain.SongEventReset, Time: s.now()}); err != nil {
+			return err
+		}
+	case first > afterSeq+1:
+		afterSeq = first - 1
+		if err := fn(domain.SongEvent{Seq: afterSeq, Type: domain.SongEventReset, Time: s.now()}); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(changeFeedPollInterval)
+	defer ticker.Stop()
+	for {
+		// Taken before reading, so events recorded meanwhile are not missed
+		s.mu.Lock()
+		recorded := s.recorded
+		s.mu.Unlock()
+
+		events, err := s.events.SongEventsAfter(ctx, afterSeq, changeFeedBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+			afterSeq = event.Seq
+		}
+		if len(events) == changeFeedBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-recorded:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Position returns the number of the newest event, after which to follow
+// the songs written from now on
+func (s *ChangeFeedService) Position(ctx context.Context) (int64, error) {
+	_, last, err := s.events.SongEventBounds(ctx)
+	return last, err
+}
+
+// Follow calls fn with the events numbered above afterSeq, oldest first,
+// then with each event recorded afterwards, until ctx is done. Unlike Watch
+// it does not give up when the feed cannot be read, but retries from the
+// last event seen.
+func (s *ChangeFeedService) Follow(ctx context.Context, afterSeq int64, fn func(domain.SongEvent)) {
+	for {
+		err := s.Watch(ctx, afterSeq, func(event domain.SongEvent) error {
+			fn(event)
+			afterSeq = event.Seq
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("follow change feed after %d: %v", afterSeq, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(changeFeedRetryInterval):
+		}
+	}
+}
+
+// PurgeEvents removes the events older than the retention and returns how
+// many were removed. The newest event is always kept, so watchers can tell
+// whether they missed any.
+func (s *ChangeFeedService) PurgeEvents(ctx context.Context) (int64, error) {
+	return s.events.DeleteSongEventsBefore(ctx, s.now().Add(-s.retention))
+}
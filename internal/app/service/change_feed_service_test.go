@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSongEvents keeps song events in memory, numbering them from next.
+// AppendSongEvent stands for the trigger recording them.
+type fakeSongEvents struct {
+	mu     sync.Mutex
+	events []domain.SongEvent
+	next   int64
+}
+
+func (f *fakeSongEvents) AppendSongEvent(ctx context.Context, event domain.SongEvent) (*domain.SongEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next++
+	event.Seq = f.next
+	f.events = append(f.events, event)
+	return &event, nil
+}
+
+func (f *fakeSongEvents) SongEventsAfter(ctx context.Context, afterSeq int64, limit int) ([]domain.SongEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var events []domain.SongEvent
+	for _, event := range f.events {
+		if event.Seq > afterSeq && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (f *fakeSongEvents) SongEventBounds(ctx context.Context) (int64, int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.events) == 0 {
+		return 0, 0, nil
+	}
+	return f.events[0].Seq, f.events[len(f.events)-1].Seq, nil
+}
+
+func (f *fakeSongEvents) DeleteSongEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var kept []domain.SongEvent
+	for i, event := range f.events {
+		if event.Time.After(before) || i == len(f.events)-1 {
+			kept = append(kept, event)
+		}
+	}
+	removed := int64(len(f.events) - len(kept))
+	f.events = kept
+	return removed, nil
+}
+
+// watch collects the events Watch calls fn with until n were seen
+func watch(t *testing.T, feed *ChangeFeedService, afterSeq int64, n int) <-chan []domain.SongEvent {
+	done := make(chan []domain.SongEvent, 1)
+	stop := errors.New("stop")
+	go func() {
+		var seen []domain.SongEvent
+		err := feed.Watch(context.Background(), afterSeq, func(event domain.SongEvent) error {
+			seen = append(seen, event)
+			if len(seen) == n {
+				return stop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, stop)
+		done <- seen
+	}()
+	return done
+}
+
+func TestWatch_ResumesAfterSeq(t *testing.T) {
+	ctx := context.Background()
+	events := &fakeSongEvents{}
+	feed := NewChangeFeedService(events, time.Hour)
+	_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: 1, Song: submarine})
+	_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventUpdated, SongID: 1, Song: submarine})
+	_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventDeleted, SongID: 1})
+
+	seen := <-watch(t, feed, 1, 2)
+	require.Len(t, seen, 2)
+	assert.Equal(t, int64(2), seen[0].Seq)
+	assert.Equal(t, domain.SongEventUpdated, seen[0].Type)
+	assert.Equal(t, int64(3), seen[1].Seq)
+	assert.Nil(t, seen[1].Song)
+}
+
+func TestWatch_Live(t *testing.T) {
+	ctx := context.Background()
+	events := &fakeSongEvents{}
+	feed := NewChangeFeedService(events, time.Hour)
+	_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: 1, Song: submarine})
+
+	done := watch(t, feed, -1, 1)
+	// Keep recording until the watcher, started after the first event, sees one
+	var seen []domain.SongEvent
+	for seen == nil {
+		_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: 2, Song: remix})
+		select {
+		case seen = <-done:
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	assert.Equal(t, 2, seen[0].SongID, "events recorded before watching are skipped")
+}
+
+func TestWatch_ResetsAfterPurge(t *testing.T) {
+	ctx := context.Background()
+	events := &fakeSongEvents{}
+	feed := NewChangeFeedService(events, time.Hour)
+	for id := 1; id <= 3; id++ {
+		_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: id, Time: time.Now().Add(-2 * time.Hour)})
+	}
+
+	purged, err := feed.PurgeEvents(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged, "the newest event is kept")
+
+	seen := <-watch(t, feed, 1, 2)
+	assert.Equal(t, domain.SongEventReset, seen[0].Type)
+	assert.Equal(t, int64(2), seen[0].Seq, "resuming after the reset continues with the oldest event kept")
+	assert.Equal(t, int64(3), seen[1].Seq)
+
+	seen = <-watch(t, feed, 9, 1)
+	assert.Equal(t, domain.SongEvent{Seq: 3, Type: domain.SongEventReset, Time: seen[0].Time}, seen[0], "unknown events reset too")
+}
+
+func TestSongService_WakesWatchers(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockSongRepo)
+	events := &fakeSongEvents{}
+	feed := NewChangeFeedService(events, time.Hour)
+	songService := NewSongService(repo, nil, nil, nil, feed)
+
+	repo.On("CreateSong", ctx, mock.Anything).Run(func(mock.Arguments) {
+		_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: 1, Song: submarine})
+	}).Return(submarine, nil)
+
+	done := watch(t, feed, 0, 1)
+	// Give the watcher time to find no event and wait
+	time.Sleep(20 * time.Millisecond)
+	_, err := songService.CreateSong(ctx, &domain.Song{Title: submarine.Title, Text: submarine.Text})
+	require.NoError(t, err)
+
+	select {
+	case seen := <-done:
+		assert.Equal(t, domain.SongEventCreated, seen[0].Type)
+	case <-time.After(changeFeedPollInterval / 2):
+		t.Fatal("the watcher was not woken up by the write")
+	}
+}
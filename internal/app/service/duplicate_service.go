@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"songs/internal/app/domain"
+)
+
+// DuplicateService finds and merges songs sharing a natural key
+type DuplicateService struct {
+	repo  DuplicateRepository
+	tx    Transactor
+	audit Auditor
+}
+
+// DuplicateRepository defines the repository operations used to resolve duplicates
+type DuplicateRepository interface {
+	GetSong(ctx context.Context, id int) (*domain.Song, error)
+	FindDuplicates(ctx context.Context, groupID int) ([]domain.DuplicateSet, error)
+	MergeSongs(ctx context.Context, target *domain.Song, sourceIDs []int) (*domain.Song, error)
+}
+
+// NewDuplicateService creates a new instance of DuplicateService recording
+// merges with audit, unless nil
+func NewDuplicateService(repo DuplicateRepository, tx Transactor, audit Auditor) *DuplicateService {
+	return &DuplicateService{
+		repo:  repo,
+		tx:    tx,
+		audit: audit,
+	}
+}
+
+// FindDuplicates lists the sets of duplicate songs, optionally within one group
+func (s *DuplicateService) FindDuplicates(ctx context.Context, groupID int) ([]domain.DuplicateSet, error) {
+	return s.repo.FindDuplicates(ctx, groupID)
+}
+
+// MergeSongs folds the source songs into the target and deletes them. Fields
+// missing on the target are taken from the sources in the given order, and
+// the earliest release date wins. All songs must belong to the target's group
+// and share its normalized title. The merge is audited as an update of the
+// target and a deletion of every source, within the transaction of the merge.
+func (s *DuplicateService) MergeSongs(ctx context.Context, targetID int, sourceIDs []int) (*domain.Song, error) {
+	if len(sourceIDs) == 0 {
+		return nil, domain.ErrRequired
+	}
+	seen := map[int]bool{targetID: true}
+	for _, id := range sourceIDs {
+		if seen[id] {
+			return nil, domain.ErrInvalidData
+		}
+		seen[id] = true
+	}
+
+	var merged *domain.Song
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		target, err := s.repo.GetSong(ctx, targetID)
+		if err != nil {
+			return err
+		}
+		before := *target
+
+		key := domain.NormalizeTitle(target.Title)
+		var entries []domain.AuditEntry
+		for _, id := range sourceIDs {
+			source, err := s.repo.GetSong(ctx, id)
+			if err != nil {
+				return err
+			}
+			if source.GroupID != target.GroupID || domain.NormalizeTitle(source.Title) != key {
+				return domain.ErrMergeMismatch
+			}
+
+			if target.Text == "" {
+				target.Text = source.Text
+			}
+			if target.Link == "" {
+				target.Link = source.Link
+			}
+			if source.ReleaseDate.Before(target.ReleaseDate) {
+				target.ReleaseDate = source.ReleaseDate
+			}
+			entries = append(entries, songAuditEntry(domain.AuditDelete, source.ID, source, nil))
+		}
+
+		merged, err = s.repo.MergeSongs(ctx, target, sourceIDs)
+		if err != nil {
+			return err
+		}
+		if s.audit == nil {
+			return nil
+		}
+		entries = append([]domain.AuditEntry{songAuditEntry(domain.AuditUpdate, merged.ID, &before, merged)}, entries...)
+		return s.audit.Record(ctx, entries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
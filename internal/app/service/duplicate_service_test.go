@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDuplicateRepo is a mock implementation of DuplicateRepository
+type MockDuplicateRepo struct {
+	mock.Mock
+}
+
+func (m *MockDuplicateRepo) GetSong(ctx context.Context, id int) (*domain.Song, error) {
+	args := m.Called(ctx, id)
+	song, _ := args.Get(0).(*domain.Song)
+	return song, args.Error(1)
+}
+
+func (m *MockDuplicateRepo) FindDuplicates(ctx context.Context, groupID int) ([]domain.DuplicateSet, error) {
+	args := m.Called(ctx, groupID)
+	sets, _ := args.Get(0).([]domain.DuplicateSet)
+	return sets, args.Error(1)
+}
+
+func (m *MockDuplicateRepo) MergeSongs(ctx context.Context, target *domain.Song, sourceIDs []int) (*domain.Song, error) {
+	args := m.Called(ctx, target, sourceIDs)
+	song, _ := args.Get(0).(*domain.Song)
+	return song, args.Error(1)
+}
+
+func TestMergeSongs(t *testing.T) {
+	mockRepo := new(MockDuplicateRepo)
+	tx := &fakeTransactor{}
+	audit, auditRepo, _ := newTestAuditService(0)
+	service := NewDuplicateService(mockRepo, tx, audit)
+
+	ctx := context.Background()
+	early := time.Date(1968, 8, 26, 0, 0, 0, 0, time.UTC)
+	late := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.On("GetSong", ctx, 1).Return(&domain.Song{ID: 1, GroupID: 2, Title: "Hey Jude", ReleaseDate: late}, nil)
+	mockRepo.On("GetSong", ctx, 5).Return(&domain.Song{ID: 5, GroupID: 2, Title: "hey, jude!", ReleaseDate: early, Text: "Hey Jude, don't make it bad", Link: "https://example.com"}, nil)
+	mockRepo.On("MergeSongs", ctx, mock.MatchedBy(func(song *domain.Song) bool {
+		return song.ID == 1 && song.Title == "Hey Jude" && song.Text == "Hey Jude, don't make it bad" &&
+			song.Link == "https://example.com" && song.ReleaseDate.Equal(early)
+	}), []int{5}).Return(&domain.Song{ID: 1, GroupID: 2, Title: "Hey Jude", ReleaseDate: early, Text: "Hey Jude, don't make it bad"}, nil)
+
+	merged, err := service.MergeSongs(ctx, 1, []int{5})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, merged.ID)
+	assert.Equal(t, 1, tx.calls)
+	mockRepo.AssertExpectations(t)
+
+	// The target is updated and the source deleted
+	require.Len(t, auditRepo.entries, 2)
+	assert.Equal(t, domain.AuditUpdate, auditRepo.entries[0].Action)
+	assert.Equal(t, 1, auditRepo.entries[0].ResourceID)
+	assert.Contains(t, string(auditRepo.entries[0].Before), `"text":""`)
+	assert.Contains(t, string(auditRepo.entries[0].After), `"text":"Hey Jude, don't make it bad"`)
+	assert.Equal(t, domain.AuditDelete, auditRepo.entries[1].Action)
+	assert.Equal(t, 5, auditRepo.entries[1].ResourceID)
+	assert.Contains(t, string(auditRepo.entries[1].Before), `"title":"hey, jude!"`)
+	assert.Nil(t, auditRepo.entries[1].After)
+}
+
+func TestMergeSongs_Mismatch(t *testing.T) {
+	mockRepo := new(MockDuplicateRepo)
+	tx := &fakeTransactor{}
+	audit, auditRepo, _ := newTestAuditService(0)
+	service := NewDuplicateService(mockRepo, tx, audit)
+
+	ctx := context.Background()
+	mockRepo.On("GetSong", ctx, 1).Return(&domain.Song{ID: 1, GroupID: 2, Title: "Hey Jude"}, nil)
+	mockRepo.On("GetSong", ctx, 3).Return(&domain.Song{ID: 3, GroupID: 2, Title: "Let It Be"}, nil)
+
+	_, err := service.MergeSongs(ctx, 1, []int{3})
+
+	assert.ErrorIs(t, err, domain.ErrMergeMismatch)
+	assert.True(t, tx.rolledBack)
+	mockRepo.AssertNotCalled(t, "MergeSongs", mock.Anything, mock.Anything, mock.Anything)
+	assert.Empty(t, auditRepo.entries, "failed merges are not recorded")
+}
+
+func TestMergeSongs_RecordFails(t *testing.T) {
+	mockRepo := new(MockDuplicateRepo)
+	tx := &fakeTransactor{}
+	audit, auditRepo, _ := newTestAuditService(0)
+	auditRepo.err = domain.ErrDatabase
+	service := NewDuplicateService(mockRepo, tx, audit)
+
+	ctx := context.Background()
+	mockRepo.On("GetSong", ctx, 1).Return(&domain.Song{ID: 1, GroupID: 2, Title: "Hey Jude"}, nil)
+	mockRepo.On("GetSong", ctx, 5).Return(&domain.Song{ID: 5, GroupID: 2, Title: "hey jude"}, nil)
+	mockRepo.On("MergeSongs", ctx, mock.Anything, []int{5}).Return(&domain.Song{ID: 1, GroupID: 2, Title: "Hey Jude"}, nil)
+
+	_, err := service.MergeSongs(ctx, 1, []int{5})
+
+	assert.ErrorIs(t, err, domain.ErrDatabase)
+	assert.True(t, tx.rolledBack)
+}
+
+func TestMergeSongs_InvalidSources(t *testing.T) {
+	service := NewDuplicateService(new(MockDuplicateRepo), &fakeTransactor{}, nil)
+
+	_, err := service.MergeSongs(context.Background(), 1, nil)
+	assert.ErrorIs(t, err, domain.ErrRequired)
+
+	_, err = service.MergeSongs(context.Background(), 1, []int{1})
+	assert.ErrorIs(t, err, domain.ErrInvalidData)
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t, "dont stop me now", domain.NormalizeTitle("  Don't   Stop Me Now! "))
+	assert.Equal(t, domain.NormalizeTitle("Hey Jude"), domain.NormalizeTitle("hey, jude"))
+	assert.Equal(t, "a b", domain.NormalizeTitle("a - b"))
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"strings"
+	"unicode/utf8"
+)
+
+// GenreService manages the genre tree and the genres of songs
+type GenreService struct {
+	repo  GenreRepository
+	songs SongReader
+	tx    Transactor
+}
+
+// GenreRepository defines the interface for genre repository operations
+type GenreRepository interface {
+	CreateGenre(ctx context.Context, genre *domain.Genre) (*domain.Genre, error)
+	ListGenres(ctx context.Context) ([]domain.Genre, error)
+	DeleteGenre(ctx context.Context, id int) error
+	SongGenres(ctx context.Context, songID int) ([]domain.Genre, error)
+	ReplaceSongGenres(ctx context.Context, songID int, genreIDs []int) error
+}
+
+// NewGenreService creates a new instance of GenreService
+func NewGenreService(repo GenreRepository, songs SongReader, tx Transactor) *GenreService {
+	return &GenreService{
+		repo:  repo,
+		songs: songs,
+		tx:    tx,
+	}
+}
+
+// CreateGenre creates a genre under parentID, or at the top level when
+// parentID is 0. Names are unique among the children of a parent, ignoring case.
+func (s *GenreService) CreateGenre(ctx context.Context, name string, parentID int) (*domain.Genre, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrRequired
+	}
+	if utf8.RuneCountInString(name) > domain.MaxGenreNameLength || strings.Contains(name, ">") {
+		return nil, domain.ErrValidation
+	}
+	if parentID < 0 {
+		return nil, domain.ErrGenreNotFound
+	}
+
+	return s.repo.CreateGenre(ctx, &domain.Genre{Name: name, ParentID: parentID})
+}
+
+// ListGenres retrieves the whole genre tree, sub-genres following their parent
+func (s *GenreService) ListGenres(ctx context.Context) ([]domain.Genre, error) {
+	return s.repo.ListGenres(ctx)
+}
+
+// DeleteGenre deletes a genre with its sub-genres; songs lose them
+func (s *GenreService) DeleteGenre(ctx context.Context, id int) error {
+	return s.repo.DeleteGenre(ctx, id)
+}
+
+// SongGenres retrieves the genres of a song
+func (s *GenreService) SongGenres(ctx context.Context, songID int) ([]domain.Genre, error) {
+	if err := checkSong(ctx, s.songs, songID); err != nil {
+		return nil, err
+	}
+	return s.repo.SongGenres(ctx, songID)
+}
+
+// SetSongGenres replaces the genres of a song
+func (s *GenreService) SetSongGenres(ctx context.Context, songID int, genreIDs []int) ([]domain.Genre, error) {
+	ids := make([]int, 0, len(genreIDs))
+	seen := make(map[int]bool, len(genreIDs))
+	for _, id := range genreIDs {
+		if id <= 0 {
+			return nil, domain.ErrGenreNotFound
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := checkSong(ctx, s.songs, songID); err != nil {
+			return err
+		}
+		return s.repo.ReplaceSongGenres(ctx, songID, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.SongGenres(ctx, songID)
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockGenreRepo is a mock implementation of GenreRepository
+type MockGenreRepo struct {
+	mock.Mock
+}
+
+func (m *MockGenreRepo) CreateGenre(ctx context.Context, genre *domain.Genre) (*domain.Genre, error) {
+	args := m.Called(ctx, genre)
+	created, _ := args.Get(0).(*domain.Genre)
+	return created, args.Error(1)
+}
+
+func (m *MockGenreRepo) ListGenres(ctx context.Context) ([]domain.Genre, error) {
+	args := m.Called(ctx)
+	genres, _ := args.Get(0).([]domain.Genre)
+	return genres, args.Error(1)
+}
+
+func (m *MockGenreRepo) DeleteGenre(ctx context.Context, id int) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockGenreRepo) SongGenres(ctx context.Context, songID int) ([]domain.Genre, error) {
+	args := m.Called(ctx, songID)
+	genres, _ := args.Get(0).([]domain.Genre)
+	return genres, args.Error(1)
+}
+
+func (m *MockGenreRepo) ReplaceSongGenres(ctx context.Context, songID int, genreIDs []int) error {
+	return m.Called(ctx, songID, genreIDs).Error(0)
+}
+
+func TestCreateGenre_Validation(t *testing.T) {
+	service := NewGenreService(new(MockGenreRepo), new(MockSongRepo), &fakeTransactor{})
+
+	_, err := service.CreateGenre(context.Background(), "  ", 0)
+	assert.ErrorIs(t, err, domain.ErrRequired)
+
+	_, err = service.CreateGenre(context.Background(), "Rock > Punk", 0)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+
+	_, err = service.CreateGenre(context.Background(), strings.Repeat("a", domain.MaxGenreNameLength+1), 0)
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestCreateGenre_UnderParent(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockGenreRepo)
+	service := NewGenreService(repo, new(MockSongRepo), &fakeTransactor{})
+
+	repo.On("CreateGenre", ctx, &domain.Genre{Name: "Punk", ParentID: 1}).
+		Return(&domain.Genre{ID: 2, ParentID: 1, Name: "Punk", Path: "Rock > Punk"}, nil)
+
+	genre, err := service.CreateGenre(ctx, " Punk ", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Rock > Punk", genre.Path)
+}
+
+func TestSetSongGenres(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockGenreRepo)
+	songs := new(MockSongRepo)
+	tx := &fakeTransactor{}
+	service := NewGenreService(repo, songs, tx)
+
+	songs.On("GetSong", ctx, 7).Return(&domain.Song{ID: 7}, nil)
+	songs.On("GetSong", ctx, 8).Return(nil, domain.ErrNotFound)
+	repo.On("ReplaceSongGenres", ctx, 7, []int{2, 1}).Return(nil)
+	repo.On("SongGenres", ctx, 7).Return([]domain.Genre{{ID: 1}, {ID: 2}}, nil)
+
+	genres, err := service.SetSongGenres(ctx, 7, []int{2, 1, 2})
+	require.NoError(t, err)
+	assert.Len(t, genres, 2)
+
+	_, err = service.SetSongGenres(ctx, 8, []int{1})
+	assert.ErrorIs(t, err, domain.ErrSongNotFound)
+	assert.True(t, tx.rolledBack)
+
+	_, err = service.SetSongGenres(ctx, 7, []int{0})
+	assert.ErrorIs(t, err, domain.ErrGenreNotFound)
+	repo.AssertNumberOfCalls(t, "ReplaceSongGenres", 1)
+}
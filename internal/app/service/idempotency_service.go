@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"time"
+)
+
+const (
+	// maxIdempotencyKeyLength matches the size of the key column
+	maxIdempotencyKeyLength = 255
+	// idempotencyLockTimeout is how long an unfinished request keeps its key;
+	// after that the request is considered abandoned, e.g. by a crashed process
+	idempotencyLockTimeout = time.Minute
+	// maxReserveAttempts bounds retries when a key expires or is released concurrently
+	maxReserveAttempts = 3
+)
+
+// IdempotencyService deduplicates retried write requests by their Idempotency-Key
+type IdempotencyService struct {
+	repo IdempotencyRepository
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+// IdempotencyRepository defines the interface for idempotency key storage
+type IdempotencyRepository interface {
+	Reserve(ctx context.Context, record domain.IdempotencyRecord) (bool, *domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, statusCode int, contentType string, response []byte) error
+	Delete(ctx context.Context, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// NewIdempotencyService creates a new instance of IdempotencyService keeping keys for ttl
+func NewIdempotencyService(repo IdempotencyRepository, ttl time.Duration) *IdempotencyService {
+	return &IdempotencyService{
+		repo: repo,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// Begin claims the key for a request with the given fingerprint. It returns
+// the stored record when the same request already completed and its response
+// must be replayed, or nil when the caller should process the request and then
+// call Complete or Release. Reusing a key for a different request fails with
+// ErrIdempotencyKeyReused, and a retry racing the original request fails with
+// ErrIdempotencyInProgress.
+func (s *IdempotencyService) Begin(ctx context.Context, key, fingerprint string) (*domain.IdempotencyRecord, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, domain.ErrInvalidData
+	}
+
+	for attempt := 0; attempt < maxReserveAttempts; attempt++ {
+		now := s.now()
+		reserved, existing, err := s.repo.Reserve(ctx, domain.IdempotencyRecord{
+			Key:         key,
+			Fingerprint: fingerprint,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(s.ttl),
+		})
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if reserved {
+			return nil, nil
+		}
+
+		expired := existing.ExpiresAt.Before(now)
+		abandoned := !existing.Completed && now.Sub(existing.CreatedAt) > idempotencyLockTimeout
+		if expired || abandoned {
+			if err := s.repo.Delete(ctx, key); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if existing.Fingerprint != fingerprint {
+			return nil, domain.ErrIdempotencyKeyReused
+		}
+		if !existing.Completed {
+			return nil, domain.ErrIdempotencyInProgress
+		}
+		return existing, nil
+	}
+
+	return nil, domain.ErrIdempotencyInProgress
+}
+
+// Complete stores the response of a request started with Begin
+func (s *IdempotencyService) Complete(ctx context.Context, key string, statusCode int, contentType string, response []byte) error {
+	return s.repo.Complete(ctx, key, statusCode, contentType, response)
+}
+
+// Release frees the key of a request that failed, so it can be retried
+func (s *IdempotencyService) Release(ctx context.Context, key string) error {
+	return s.repo.Delete(ctx, key)
+}
+
+// PurgeExpired removes expired keys and returns how many were removed
+func (s *IdempotencyService) PurgeExpired(ctx context.Context) (int64, error) {
+	return s.repo.DeleteExpired(ctx, s.now())
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockIdempotencyRepo is a mock implementation of IdempotencyRepository
+type MockIdempotencyRepo struct {
+	mock.Mock
+}
+
+func (m *MockIdempotencyRepo) Reserve(ctx context.Context, record domain.IdempotencyRecord) (bool, *domain.IdempotencyRecord, error) {
+	args := m.Called(ctx, record)
+	existing, _ := args.Get(1).(*domain.IdempotencyRecord)
+	return args.Bool(0), existing, args.Error(2)
+}
+
+func (m *MockIdempotencyRepo) Complete(ctx context.Context, key string, statusCode int, contentType string, response []byte) error {
+	args := m.Called(ctx, key, statusCode, contentType, response)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepo) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func newTestIdempotencyService(repo IdempotencyRepository, now time.Time) *IdempotencyService {
+	svc := NewIdempotencyService(repo, time.Hour)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestIdempotencyBegin_Reserved(t *testing.T) {
+	mockRepo := new(MockIdempotencyRepo)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestIdempotencyService(mockRepo, now)
+
+	ctx := context.Background()
+	mockRepo.On("Reserve", ctx, domain.IdempotencyRecord{
+		Key: "key-1", Fingerprint: "fp", CreatedAt: now, ExpiresAt: now.Add(time.Hour),
+	}).Return(true, nil, nil)
+
+	stored, err := svc.Begin(ctx, "key-1", "fp")
+
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestIdempotencyBegin_Replay(t *testing.T) {
+	mockRepo := new(MockIdempotencyRepo)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestIdempotencyService(mockRepo, now)
+
+	existing := &domain.IdempotencyRecord{
+		Key: "key-1", Fingerprint: "fp", Completed: true, StatusCode: 201,
+		Response: []byte(`{"id":1}`), CreatedAt: now.Add(-time.Minute), ExpiresAt: now.Add(time.Hour),
+	}
+	mockRepo.On("Reserve", mock.Anything, mock.Anything).Return(false, existing, nil)
+
+	stored, err := svc.Begin(context.Background(), "key-1", "fp")
+
+	require.NoError(t, err)
+	assert.Equal(t, existing, stored)
+}
+
+func TestIdempotencyBegin_Conflicts(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		existing domain.IdempotencyRecord
+		wantErr  error
+	}{
+		{
+			name:     "different request",
+			existing: domain.IdempotencyRecord{Fingerprint: "other", Completed: true, CreatedAt: now, ExpiresAt: now.Add(time.Hour)},
+			wantErr:  domain.ErrIdempotencyKeyReused,
+		},
+		{
+			name:     "in progress",
+			existing: domain.IdempotencyRecord{Fingerprint: "fp", CreatedAt: now.Add(-time.Second), ExpiresAt: now.Add(time.Hour)},
+			wantErr:  domain.ErrIdempotencyInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockIdempotencyRepo)
+			svc := newTestIdempotencyService(mockRepo, now)
+			existing := tt.existing
+			mockRepo.On("Reserve", mock.Anything, mock.Anything).Return(false, &existing, nil)
+
+			_, err := svc.Begin(context.Background(), "key-1", "fp")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestIdempotencyBegin_TakesOverExpiredKey(t *testing.T) {
+	mockRepo := new(MockIdempotencyRepo)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestIdempotencyService(mockRepo, now)
+
+	ctx := context.Background()
+	expired := &domain.IdempotencyRecord{Key: "key-1", Fingerprint: "other", Completed: true, CreatedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+	mockRepo.On("Reserve", ctx, mock.Anything).Return(false, expired, nil).Once()
+	mockRepo.On("Delete", ctx, "key-1").Return(nil).Once()
+	mockRepo.On("Reserve", ctx, mock.Anything).Return(true, nil, nil).Once()
+
+	stored, err := svc.Begin(ctx, "key-1", "fp")
+
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestIdempotencyBegin_InvalidKey(t *testing.T) {
+	svc := NewIdempotencyService(new(MockIdempotencyRepo), time.Hour)
+
+	_, err := svc.Begin(context.Background(), "", "fp")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidData)
+}
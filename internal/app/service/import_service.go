@@ -0,0 +1,398 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"songs/internal/app/common/slugerrors"
+	"songs/internal/app/domain"
+	"strings"
+	"time"
+)
+
+// importBatchSize is the number of valid rows written to the database at once
+const importBatchSize = 500
+
+// maxImportLineSize bounds a single NDJSON line, lyrics included
+const maxImportLineSize = 4 * 1024 * 1024
+
+// ImportService loads songs in bulk from CSV or NDJSON payloads
+type ImportService struct {
+	songs    SongBatchRepository
+	groups   GroupRepository
+	analyzer SongAnalyzer
+	audit    Auditor
+	tx       Transactor
+}
+
+// SongBatchRepository defines the bulk song operations used by imports
+type SongBatchRepository interface {
+	CreateSongs(ctx context.Context, songs []*domain.Song) ([]*domain.Song, error)
+	ExistingTitles(ctx context.Context, groupID int, titles []string) (map[string]bool, error)
+}
+
+// GroupRepository defines the interface for group repository operations
+type GroupRepository interface {
+	FindGroupByName(ctx context.Context, name string) (*domain.SongGroup, error)
+	GetOrCreateGroup(ctx context.Context, name string) (*domain.SongGroup, error)
+}
+
+// SongAnalyzer sets what is computed from the lyrics of a song about to be
+// written, its language and whether it is explicit. A SongService analyzes
+// songs like single writes do.
+type SongAnalyzer interface {
+	Analyze(song *domain.Song)
+}
+
+// NewImportService creates a new instance of ImportService analyzing the
+// songs it creates with analyzer and recording them, and the groups it
+// creates, with audit within the transactions of tx. Either of analyzer and
+// audit may be nil.
+func NewImportService(songs SongBatchRepository, groups GroupRepository, analyzer SongAnalyzer, audit Auditor, tx Transactor) *ImportService {
+	return &ImportService{
+		songs:    songs,
+		groups:   groups,
+		analyzer: analyzer,
+		audit:    audit,
+		tx:       tx,
+	}
+}
+
+// pendingRow is a validated row waiting to be written
+type pendingRow struct {
+	row   int
+	group string
+	song  *domain.Song
+}
+
+// Import reads rows from r and creates the songs they describe. Rows are
+// validated one by one; invalid rows are reported as failed and rows whose
+// title already exists in the group are reported as skipped. With dryRun
+// nothing is written, but the report is the same as for a real run.
+func (s *ImportService) Import(ctx context.Context, r io.Reader, format domain.ImportFormat, dryRun bool) (*domain.ImportReport, error) {
+	report := &domain.ImportReport{DryRun: dryRun, Rows: []domain.ImportRowResult{}}
+	batch := make([]pendingRow, 0, importBatchSize)
+	seen := make(map[string]bool)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := s.writeBatch(ctx, batch, dryRun, report)
+		batch = batch[:0]
+		return err
+	}
+
+	err := readImportRows(r, format, func(row int, rec domain.ImportRow, parseErr error) error {
+		if parseErr != nil {
+			report.Add(failedRow(row, domain.ErrInvalidData, parseErr.Error()))
+			return nil
+		}
+
+		song, err := validateImportRow(rec)
+		if err != nil {
+			report.Add(failedRow(row, domain.ErrValidation, err.Error()))
+			return nil
+		}
+
+		key := strings.ToLower(strings.TrimSpace(rec.GroupName)) + "\x00" + domain.NormalizeTitle(song.Title)
+		if seen[key] {
+			report.Add(domain.ImportRowResult{Row: row, Status: domain.ImportRowSkipped, Slug: domain.ErrDuplicate.Slug(), Message: "duplicate of an earlier row"})
+			return nil
+		}
+		seen[key] = true
+
+		batch = append(batch, pendingRow{row: row, group: strings.TrimSpace(rec.GroupName), song: song})
+		if len(batch) >= importBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// writeBatch resolves groups, drops rows that already exist and inserts the rest
+func (s *ImportService) writeBatch(ctx context.Context, batch []pendingRow, dryRun bool, report *domain.ImportReport) error {
+	groupIDs := make(map[string]int)
+	titlesByGroup := make(map[int][]string)
+	for _, p := range batch {
+		if _, ok := groupIDs[p.group]; ok {
+			continue
+		}
+
+		group, err := s.groups.FindGroupByName(ctx, p.group)
+		if errors.Is(err, domain.ErrNotFound) {
+			if dryRun {
+				// The group would be created by a real run
+				groupIDs[p.group] = 0
+				continue
+			}
+			group, err = s.createGroup(ctx, p.group)
+		}
+		if err != nil {
+			return fmt.Errorf("resolve group %q: %w", p.group, err)
+		}
+		groupIDs[p.group] = group.ID
+	}
+
+	for _, p := range batch {
+		p.song.GroupID = groupIDs[p.group]
+		titlesByGroup[p.song.GroupID] = append(titlesByGroup[p.song.GroupID], p.song.Title)
+	}
+
+	existing := make(map[int]map[string]bool)
+	for groupID, titles := range titlesByGroup {
+		found, err := s.songs.ExistingTitles(ctx, groupID, titles)
+		if err != nil {
+			return fmt.Errorf("check existing songs: %w", err)
+		}
+		existing[groupID] = found
+	}
+
+	toCreate := make([]pendingRow, 0, len(batch))
+	for _, p := range batch {
+		if existing[p.song.GroupID][domain.NormalizeTitle(p.song.Title)] {
+			report.Add(domain.ImportRowResult{Row: p.row, Status: domain.ImportRowSkipped, Slug: domain.ErrDuplicate.Slug(), Message: "song already exists"})
+			continue
+		}
+		toCreate = append(toCreate, p)
+	}
+
+	if dryRun {
+		for _, p := range toCreate {
+			report.Add(domain.ImportRowResult{Row: p.row, Status: domain.ImportRowCreated})
+		}
+		return nil
+	}
+
+	songs := make([]*domain.Song, len(toCreate))
+	for i, p := range toCreate {
+		if s.analyzer != nil {
+			s.analyzer.Analyze(p.song)
+		}
+		songs[i] = p.song
+	}
+
+	var created []*domain.Song
+	var insertErr error
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if created, insertErr = s.songs.CreateSongs(ctx, songs); insertErr != nil {
+			return insertErr
+		}
+		entries := make([]domain.AuditEntry, len(created))
+		for i, song := range created {
+			entries[i] = songAuditEntry(domain.AuditCreate, song.ID, nil, song)
+		}
+		return s.record(ctx, entries...)
+	})
+	if insertErr != nil {
+		for _, p := range toCreate {
+			report.Add(failedRow(p.row, insertErr, "batch insert failed"))
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("record created songs: %w", err)
+	}
+
+	for i, p := range toCreate {
+		report.Add(domain.ImportRowResult{Row: p.row, Status: domain.ImportRowCreated, SongID: created[i].ID})
+	}
+	return nil
+}
+
+// validateImportRow checks a raw row and converts it into a song without group
+func validateImportRow(rec domain.ImportRow) (*domain.Song, error) {
+	if strings.TrimSpace(rec.GroupName) == "" {
+		return nil, errors.New("group is required")
+	}
+	title := strings.TrimSpace(rec.Title)
+	if title == "" {
+		return nil, errors.New("title is required")
+	}
+	if strings.TrimSpace(rec.ReleaseDate) == "" {
+		return nil, errors.New("release_date is required")
+	}
+
+	releaseDate, err := parseReleaseDate(rec.ReleaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Song{
+		Title:       title,
+		ReleaseDate: releaseDate,
+		Text:        rec.Text,
+		Link:        strings.TrimSpace(rec.Link),
+	}, nil
+}
+
+// parseReleaseDate accepts RFC3339 timestamps as well as plain dates
+func parseReleaseDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid release_date %q, expected RFC3339 or YYYY-MM-DD", value)
+}
+
+// createGroup creates a group and records it within one transaction
+func (s *ImportService) createGroup(ctx context.Context, name string) (*domain.SongGroup, error) {
+	var group *domain.SongGroup
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if group, err = s.groups.GetOrCreateGroup(ctx, name); err != nil {
+			return err
+		}
+		return s.record(ctx, groupAuditEntry(domain.AuditCreate, group))
+	})
+	return group, err
+}
+
+// record appends entries to the audit log when auditing
+func (s *ImportService) record(ctx context.Context, entries ...domain.AuditEntry) error {
+	if s.audit == nil || len(entries) == 0 {
+		return nil
+	}
+	return s.audit.Record(ctx, entries...)
+}
+
+func failedRow(row int, err error, message string) domain.ImportRowResult {
+	result := domain.ImportRowResult{Row: row, Status: domain.ImportRowFailed, Message: message}
+	var slugErr slugerrors.SlugError
+	if errors.As(err, &slugErr) {
+		result.Slug = slugErr.Slug()
+	}
+	return result
+}
+
+// importRecord is the NDJSON representation of a row
+type importRecord struct {
+	Group       string `json:"group"`
+	Title       string `json:"title"`
+	ReleaseDate string `json:"release_date"`
+	Text        string `json:"text"`
+	Link        string `json:"link"`
+}
+
+// readImportRows decodes rows one by one and passes them to fn. Row numbers
+// are 1-based and do not count the CSV header or blank NDJSON lines.
+func readImportRows(r io.Reader, format domain.ImportFormat, fn func(row int, rec domain.ImportRow, err error) error) error {
+	switch format {
+	case domain.ImportFormatNDJSON:
+		return readNDJSONRows(r, fn)
+	case domain.ImportFormatCSV:
+		return readCSVRows(r, fn)
+	default:
+		return domain.ErrUnsupportedFormat
+	}
+}
+
+func readNDJSONRows(r io.Reader, fn func(row int, rec domain.ImportRow, err error) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	row := 0
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		row++
+
+		var rec importRecord
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			if err := fn(row, domain.ImportRow{}, fmt.Errorf("invalid JSON: %v", err)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := fn(row, domain.ImportRow{
+			GroupName:   rec.Group,
+			Title:       rec.Title,
+			ReleaseDate: rec.ReleaseDate,
+			Text:        rec.Text,
+			Link:        rec.Link,
+		}, nil); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read NDJSON: %w", err)
+	}
+	return nil
+}
+
+func readCSVRows(r io.Reader, fn func(row int, rec domain.ImportRow, err error) error) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"group", "title", "release_date"} {
+		if _, ok := columns[required]; !ok {
+			return fmt.Errorf("%w: CSV header is missing column %q", domain.ErrInvalidData, required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				if err := fn(row, domain.ImportRow{}, fmt.Errorf("invalid CSV: %v", err)); err != nil {
+					return err
+				}
+				continue
+			}
+			return fmt.Errorf("read CSV: %w", err)
+		}
+
+		if err := fn(row, domain.ImportRow{
+			GroupName:   field(record, "group"),
+			Title:       field(record, "title"),
+			ReleaseDate: field(record, "release_date"),
+			Text:        field(record, "text"),
+			Link:        field(record, "link"),
+		}, nil); err != nil {
+			return err
+		}
+	}
+}
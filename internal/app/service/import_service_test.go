@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSongBatchRepo is a mock implementation of SongBatchRepository
+type MockSongBatchRepo struct {
+	mock.Mock
+}
+
+func (m *MockSongBatchRepo) CreateSongs(ctx context.Context, songs []*domain.Song) ([]*domain.Song, error) {
+	args := m.Called(ctx, songs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Song), args.Error(1)
+}
+
+func (m *MockSongBatchRepo) ExistingTitles(ctx context.Context, groupID int, titles []string) (map[string]bool, error) {
+	args := m.Called(ctx, groupID, titles)
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+// MockGroupRepo is a mock implementation of GroupRepository
+type MockGroupRepo struct {
+	mock.Mock
+}
+
+func (m *MockGroupRepo) FindGroupByName(ctx context.Context, name string) (*domain.SongGroup, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SongGroup), args.Error(1)
+}
+
+func (m *MockGroupRepo) GetOrCreateGroup(ctx context.Context, name string) (*domain.SongGroup, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SongGroup), args.Error(1)
+}
+
+func (m *MockGroupRepo) GetGroup(ctx context.Context, id int) (*domain.SongGroup, error) {
+	args := m.Called(ctx, id)
+	group, _ := args.Get(0).(*domain.SongGroup)
+	return group, args.Error(1)
+}
+
+func TestImport_CSV(t *testing.T) {
+	songRepo := new(MockSongBatchRepo)
+	groupRepo := new(MockGroupRepo)
+	audit, auditRepo, _ := newTestAuditService(0)
+	service := NewImportService(songRepo, groupRepo, nil, audit, &fakeTransactor{})
+
+	ctx := context.Background()
+	payload := "group,title,release_date,text,link\n" +
+		"Muse,Hysteria,2003-12-01,\"It's bugging me\",https://example.com/1\n" +
+		"Muse,Starlight,2006-09-04T00:00:00Z,Far away,\n" +
+		"Muse,,2006-09-04,No title,\n" +
+		"Muse,hysteria,2003-12-01,Duplicate row,\n" +
+		"Muse,Uprising,2009-09-07,Already there,\n"
+
+	groupRepo.On("FindGroupByName", ctx, "Muse").Return(nil, domain.ErrNotFound)
+	groupRepo.On("GetOrCreateGroup", ctx, "Muse").Return(&domain.SongGroup{ID: 7, Name: "Muse"}, nil)
+	songRepo.On("ExistingTitles", ctx, 7, []string{"Hysteria", "Starlight", "Uprising"}).
+		Return(map[string]bool{"uprising": true}, nil)
+	songRepo.On("CreateSongs", ctx, mock.MatchedBy(func(songs []*domain.Song) bool {
+		return len(songs) == 2 && songs[0].Title == "Hysteria" && songs[0].GroupID == 7 && songs[1].Title == "Starlight"
+	})).Return([]*domain.Song{{ID: 11}, {ID: 12}}, nil)
+
+	report, err := service.Import(ctx, strings.NewReader(payload), domain.ImportFormatCSV, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Created)
+	assert.Equal(t, 2, report.Skipped)
+	assert.Equal(t, 1, report.Failed)
+
+	statuses := make(map[int]domain.ImportRowResult)
+	for _, row := range report.Rows {
+		statuses[row.Row] = row
+	}
+	assert.Equal(t, domain.ImportRowCreated, statuses[1].Status)
+	assert.Equal(t, 11, statuses[1].SongID)
+	assert.Equal(t, 12, statuses[2].SongID)
+	assert.Equal(t, domain.ImportRowFailed, statuses[3].Status)
+	assert.Equal(t, domain.ErrValidation.Slug(), statuses[3].Slug)
+	assert.Equal(t, domain.ImportRowSkipped, statuses[4].Status)
+	assert.Equal(t, domain.ImportRowSkipped, statuses[5].Status)
+
+	require.Len(t, auditRepo.entries, 3, "the group and songs created are audited")
+	assert.Equal(t, domain.AuditGroup, auditRepo.entries[0].Resource)
+	assert.Equal(t, 7, auditRepo.entries[0].ResourceID)
+	assert.Equal(t, domain.AuditSong, auditRepo.entries[1].Resource)
+	assert.Equal(t, 11, auditRepo.entries[1].ResourceID)
+	assert.Equal(t, domain.AuditCreate, auditRepo.entries[2].Action)
+	songRepo.AssertExpectations(t)
+	groupRepo.AssertExpectations(t)
+}
+
+func TestImport_AnalyzesSongs(t *testing.T) {
+	songRepo := new(MockSongBatchRepo)
+	groupRepo := new(MockGroupRepo)
+	detector := fakeDetector{"Well damn": {Language: "en", Script: "Latn", Confidence: 0.9}}
+	service := NewImportService(songRepo, groupRepo, NewSongService(nil, detector, fakeScanner{}, nil), nil, &fakeTransactor{})
+
+	ctx := context.Background()
+	payload := `{"group":"Muse","title":"Explicit","release_date":"2020-01-01","text":"Well damn"}` + "\n" +
+		`{"group":"Muse","title":"Clean","release_date":"2020-01-01","text":"Well done"}` + "\n"
+
+	groupRepo.On("FindGroupByName", ctx, "Muse").Return(&domain.SongGroup{ID: 7, Name: "Muse"}, nil)
+	songRepo.On("ExistingTitles", ctx, 7, []string{"Explicit", "Clean"}).Return(map[string]bool{}, nil)
+	var written []*domain.Song
+	songRepo.On("CreateSongs", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		written = args.Get(1).([]*domain.Song)
+	}).Return([]*domain.Song{{ID: 11}, {ID: 12}}, nil)
+
+	_, err := service.Import(ctx, strings.NewReader(payload), domain.ImportFormatNDJSON, false)
+
+	require.NoError(t, err)
+	require.Len(t, written, 2)
+	assert.True(t, written[0].Explicit, "imported explicit lyrics are flagged")
+	assert.Equal(t, "en", written[0].Language)
+	assert.Equal(t, "Latn", written[0].Script)
+	assert.False(t, written[1].Explicit)
+}
+
+func TestImport_RecordFails(t *testing.T) {
+	songRepo := new(MockSongBatchRepo)
+	groupRepo := new(MockGroupRepo)
+	audit, auditRepo, _ := newTestAuditService(0)
+	tx := &fakeTransactor{}
+	service := NewImportService(songRepo, groupRepo, nil, audit, tx)
+
+	ctx := context.Background()
+	payload := "group,title,release_date\nMuse,Hysteria,2003-12-01\n"
+
+	groupRepo.On("FindGroupByName", ctx, "Muse").Return(&domain.SongGroup{ID: 7, Name: "Muse"}, nil)
+	songRepo.On("ExistingTitles", ctx, 7, []string{"Hysteria"}).Return(map[string]bool{}, nil)
+	songRepo.On("CreateSongs", ctx, mock.Anything).Return([]*domain.Song{{ID: 11}}, nil)
+	auditRepo.err = domain.ErrDatabase
+
+	_, err := service.Import(ctx, strings.NewReader(payload), domain.ImportFormatCSV, false)
+
+	assert.ErrorIs(t, err, domain.ErrDatabase)
+	assert.True(t, tx.rolledBack, "songs are not kept without their entries")
+}
+
+func TestImport_NDJSONDryRun(t *testing.T) {
+	songRepo := new(MockSongBatchRepo)
+	groupRepo := new(MockGroupRepo)
+	service := NewImportService(songRepo, groupRepo, nil, nil, &fakeTransactor{})
+
+	ctx := context.Background()
+	payload := `{"group":"New Band","title":"First","release_date":"2020-01-01"}` + "\n\n" +
+		`{"group":"New Band","title":` + "\n"
+
+	groupRepo.On("FindGroupByName", ctx, "New Band").Return(nil, domain.ErrNotFound)
+	songRepo.On("ExistingTitles", ctx, 0, []string{"First"}).Return(map[string]bool{}, nil)
+
+	report, err := service.Import(ctx, strings.NewReader(payload), domain.ImportFormatNDJSON, true)
+
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Created)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, domain.ErrInvalidData.Slug(), report.Rows[0].Slug)
+	songRepo.AssertNotCalled(t, "CreateSongs", mock.Anything, mock.Anything)
+	groupRepo.AssertNotCalled(t, "GetOrCreateGroup", mock.Anything, mock.Anything)
+}
+
+func TestImport_CSVMissingColumn(t *testing.T) {
+	service := NewImportService(new(MockSongBatchRepo), new(MockGroupRepo), nil, nil, &fakeTransactor{})
+
+	_, err := service.Import(context.Background(), strings.NewReader("title,text\nA,B\n"), domain.ImportFormatCSV, false)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidData)
+}
+
+func TestImport_UnsupportedFormat(t *testing.T) {
+	service := NewImportService(new(MockSongBatchRepo), new(MockGroupRepo), nil, nil, &fakeTransactor{})
+
+	_, err := service.Import(context.Background(), strings.NewReader(""), domain.ImportFormat("xml"), false)
+
+	assert.ErrorIs(t, err, domain.ErrUnsupportedFormat)
+}
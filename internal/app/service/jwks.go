@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksMinRefreshInterval bounds reloads triggered by tokens signed with unknown keys
+	jwksMinRefreshInterval = 30 * time.Second
+	// jwksFetchTimeout bounds a single download of a remote key set
+	jwksFetchTimeout = 5 * time.Second
+	// maxJWKSSize bounds the size of a key set document
+	maxJWKSSize = 1 << 20
+)
+
+// errNoMatchingKey is returned when no key of the set can verify a token
+var errNoMatchingKey = errors.New("no matching key")
+
+// JWKS is a JSON Web Key Set loaded from a local file or an http(s) URL. The
+// set is cached and reloaded once it is older than the refresh interval, or
+// earlier when a token names a key the cached set does not have, so rotated
+// keys are picked up without a restart. The source is read without holding
+// the lock, so a slow source never holds up tokens verified with cached keys.
+type JWKS struct {
+	source  string
+	refresh time.Duration
+	client  *http.Client
+	now     func() time.Time
+
+	mu          sync.Mutex
+	keys        []jwk
+	loadedAt    time.Time
+	lastAttempt time.Time
+	// loading is the load in flight, if any
+	loading *jwksLoad
+}
+
+// jwksLoad is a load of the key set shared by the callers waiting for it
+type jwksLoad struct {
+	done chan struct{}
+	err  error
+}
+
+// jwk is a verification key of the set
+type jwk struct {
+	kid string
+	alg string
+	// key is an *rsa.PublicKey, an *ecdsa.PublicKey or an HMAC secret ([]byte)
+	key any
+}
+
+// NewJWKS creates a key set read from source, a file path or http(s) URL
+func NewJWKS(source string, refresh time.Duration) *JWKS {
+	return &JWKS{
+		source:  source,
+		refresh: refresh,
+		client:  &http.Client{Timeout: jwksFetchTimeout},
+		now:     time.Now,
+	}
+}
+
+// Refresh reloads the key set now, e.g. to check the source on startup
+func (s *JWKS) Refresh(ctx context.Context) error {
+	return s.load(ctx, true)
+}
+
+// Key returns the key verifying tokens with the given key ID and algorithm.
+// Without a key ID the only key of the set suitable for alg is used.
+func (s *JWKS) Key(ctx context.Context, kid, alg string) (any, error) {
+	s.mu.Lock()
+	stale := s.loadedAt.IsZero() || s.now().Sub(s.loadedAt) > s.refresh
+	// While a stale set is reloaded, the cached keys are used meanwhile
+	wait := s.loading == nil || len(s.keys) == 0
+	s.mu.Unlock()
+	if stale && wait {
+		_ = s.load(ctx, false)
+	}
+
+	key, err := s.find(kid, alg)
+	if errors.Is(err, errNoMatchingKey) {
+		// The key may have been rotated in since the last load
+		if err := s.load(ctx, false); err != nil {
+			return nil, err
+		}
+		key, err = s.find(kid, alg)
+	}
+	return key, err
+}
+
+func (s *JWKS) find(kid, alg string) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var found []any
+	for _, k := range s.keys {
+		if kid != "" && k.kid != kid {
+			continue
+		}
+		if (k.alg != "" && k.alg != alg) || !keySupportsAlg(k.key, alg) {
+			continue
+		}
+		found = append(found, k.key)
+	}
+	if len(found) == 0 {
+		return nil, errNoMatchingKey
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("ambiguous key: %d keys match", len(found))
+	}
+	return found[0], nil
+}
+
+// load replaces the cached keys. Loads are throttled so an unreachable
+// source is not hit on every request, unless forced. A single load runs at a
+// time and the callers arriving meanwhile wait for its outcome. On failure
+// the previous keys stay in use.
+func (s *JWKS) load(ctx context.Context, force bool) error {
+	s.mu.Lock()
+	if call := s.loading; call != nil {
+		s.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	attempt := s.now()
+	if !force && attempt.Sub(s.lastAttempt) <= jwksMinRefreshInterval {
+		s.mu.Unlock()
+		return nil
+	}
+	call := &jwksLoad{done: make(chan struct{})}
+	s.loading = call
+	s.lastAttempt = attempt
+	s.mu.Unlock()
+
+	// Shared with the waiting callers, so not canceled with the first one
+	keys, err := s.fetch(context.WithoutCancel(ctx))
+
+	s.mu.Lock()
+	if err == nil {
+		s.keys = keys
+		s.loadedAt = attempt
+	}
+	call.err = err
+	s.loading = nil
+	s.mu.Unlock()
+	close(call.done)
+	return err
+}
+
+// fetch reads and parses the key set from the source
+func (s *JWKS) fetch(ctx context.Context) ([]jwk, error) {
+	data, err := s.read(ctx)
+	if err != nil {
+		log.Printf("jwks: load %s: %v", s.source, err)
+		return nil, fmt.Errorf("load JWKS: %w", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		log.Printf("jwks: parse %s: %v", s.source, err)
+		return nil, fmt.Errorf("parse JWKS: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *JWKS) read(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(s.source, "http://") && !strings.HasPrefix(s.source, "https://") {
+		return os.ReadFile(strings.TrimPrefix(s.source, "file://"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+// parseJWKS decodes the signature keys of a key set, skipping keys of other
+// uses and of unsupported types
+func parseJWKS(data []byte) ([]jwk, error) {
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Alg string `json:"alg"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+			K   string `json:"k"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make([]jwk, 0, len(set.Keys))
+	for _, raw := range set.Keys {
+		if raw.Use != "" && raw.Use != "sig" {
+			continue
+		}
+
+		var key any
+		var err error
+		switch raw.Kty {
+		case "RSA":
+			key, err = rsaPublicKey(raw.N, raw.E)
+		case "EC":
+			key, err = ecdsaPublicKey(raw.Crv, raw.X, raw.Y)
+		case "oct":
+			key, err = base64.RawURLEncoding.DecodeString(raw.K)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", raw.Kid, err)
+		}
+		keys = append(keys, jwk{kid: raw.Kid, alg: raw.Alg, key: key})
+	}
+	return keys, nil
+}
+
+func rsaPublicKey(n, e string) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	exponent := new(big.Int).SetBytes(eBytes)
+	if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nBytes), E: int(exponent.Int64())}, nil
+}
+
+func ecdsaPublicKey(crv, x, y string) (*ecdsa.PublicKey, error) {
+	if crv != "P-256" {
+		return nil, fmt.Errorf("unsupported curve %q", crv)
+	}
+	xBytes, err := base64.RawURLEncoding.DecodeString(x)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x: %w", err)
+	}
+	yBytes, err := base64.RawURLEncoding.DecodeString(y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y: %w", err)
+	}
+	if len(xBytes) != 32 || len(yBytes) != 32 {
+		return nil, errors.New("invalid coordinate length")
+	}
+	// ecdh validates that the point is on the curve
+	point := append(append([]byte{4}, xBytes...), yBytes...)
+	if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+		return nil, err
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(xBytes), Y: new(big.Int).SetBytes(yBytes)}, nil
+}
+
+// keySupportsAlg reports whether key can verify signatures made with alg, so
+// that e.g. an RSA public key is never used as an HMAC secret
+func keySupportsAlg(key any, alg string) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return alg == "RS256"
+	case *ecdsa.PublicKey:
+		return alg == "ES256"
+	case []byte:
+		return alg == "HS256"
+	default:
+		return false
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"songs/internal/app/domain"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwtLeeway tolerates clock skew between the gateway and this service
+const jwtLeeway = 30 * time.Second
+
+// KeySource resolves the key verifying a token signed with the given key ID and algorithm
+type KeySource interface {
+	Key(ctx context.Context, kid, alg string) (any, error)
+}
+
+// JWTConfig configures how tokens are validated and mapped to roles
+type JWTConfig struct {
+	// Issuer and Audience are checked when set
+	Issuer   string
+	Audience string
+	// RolesClaim is the claim holding the caller's roles, either a string of
+	// space separated values or an array; dots address nested claims, e.g.
+	// "realm_access.roles"
+	RolesClaim string
+	// RoleMap maps claim values to roles. Values equal to a role name always
+	// map to that role.
+	RoleMap map[string]domain.Role
+}
+
+// JWTAuthenticator authenticates callers by bearer JWTs signed with RS256,
+// ES256 or HS256 by a key of a key set
+type JWTAuthenticator struct {
+	keys   KeySource
+	cfg    JWTConfig
+	parser *jwt.Parser
+}
+
+// NewJWTAuthenticator creates a new instance of JWTAuthenticator
+func NewJWTAuthenticator(keys KeySource, cfg JWTConfig) *JWTAuthenticator {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "ES256", "HS256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(jwtLeeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	if cfg.RolesClaim == "" {
+		cfg.RolesClaim = "roles"
+	}
+
+	return &JWTAuthenticator{
+		keys:   keys,
+		cfg:    cfg,
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+// Authenticate validates the token and returns the caller it describes. The
+// caller gets the highest role its claims map to, or no role at all.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.keys.Key(ctx, kid, t.Method.Alg())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrUnauthenticated, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", domain.ErrUnauthenticated)
+	}
+
+	name, _ := claims["name"].(string)
+	if name == "" {
+		name = subject
+	}
+
+	return &domain.Principal{
+		Subject: "jwt:" + subject,
+		Name:    name,
+		Role:    a.role(claims),
+	}, nil
+}
+
+// role maps the roles claim to the highest role it grants
+func (a *JWTAuthenticator) role(claims jwt.MapClaims) domain.Role {
+	var value any = map[string]any(claims)
+	for _, part := range strings.Split(a.cfg.RolesClaim, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		value = obj[part]
+	}
+
+	var names []string
+	switch v := value.(type) {
+	case string:
+		names = strings.Fields(v)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				names = append(names, s)
+			}
+		}
+	}
+
+	var best domain.Role
+	for _, name := range names {
+		role, ok := a.cfg.RoleMap[name]
+		if !ok {
+			role = domain.Role(name)
+		}
+		if role.Valid() && !best.Allows(role) {
+			best = role
+		}
+	}
+	return best
+}
+
+// ParseRoleMap parses "claim-value=role" pairs separated by commas
+func ParseRoleMap(value string) (map[string]domain.Role, error) {
+	roles := make(map[string]domain.Role)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		claim, role, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(claim) == "" {
+			return nil, fmt.Errorf("invalid role mapping %q, expected claim=role", pair)
+		}
+		r := domain.Role(strings.TrimSpace(role))
+		if !r.Valid() {
+			return nil, fmt.Errorf("invalid role mapping %q: %w", pair, domain.ErrInvalidRole)
+		}
+		roles[strings.TrimSpace(claim)] = r
+	}
+	return roles, nil
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var b64 = base64.RawURLEncoding.EncodeToString
+
+func rsaJWK(t *testing.T, kid string) (*rsa.PrivateKey, map[string]string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key, map[string]string{
+		"kty": "RSA", "kid": kid, "use": "sig",
+		"n": b64(key.N.Bytes()), "e": b64(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(t *testing.T, kid string) (*ecdsa.PrivateKey, map[string]string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key, map[string]string{
+		"kty": "EC", "kid": kid, "crv": "P-256",
+		"x": b64(key.X.FillBytes(make([]byte, 32))), "y": b64(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func writeJWKS(t *testing.T, path string, keys ...map[string]string) {
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims(roles any) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "user-1",
+		"iss":   "https://gateway.example.com",
+		"aud":   "songs",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": roles,
+	}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	rsaKey, rsaPub := rsaJWK(t, "rsa-1")
+	ecKey, ecPub := ecJWK(t, "ec-1")
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	writeJWKS(t, path, rsaPub, ecPub, map[string]string{"kty": "oct", "kid": "hmac-1", "k": b64(secret)})
+
+	auth := NewJWTAuthenticator(NewJWKS(path, time.Hour), JWTConfig{
+		Issuer:     "https://gateway.example.com",
+		Audience:   "songs",
+		RolesClaim: "roles",
+		RoleMap:    map[string]domain.Role{"songs.write": domain.RoleEditor},
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		token    string
+		wantRole domain.Role
+		wantErr  bool
+	}{
+		{"RS256", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims([]any{"reader"})), domain.RoleReader, false},
+		{"ES256 with mapped role", signToken(t, jwt.SigningMethodES256, "ec-1", ecKey, validClaims("other songs.write")), domain.RoleEditor, false},
+		{"HS256 highest role wins", signToken(t, jwt.SigningMethodHS256, "hmac-1", secret, validClaims([]any{"admin", "reader"})), domain.RoleAdmin, false},
+		{"no role", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, validClaims(nil)), "", false},
+		{"expired", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"sub": "u", "iss": "https://gateway.example.com", "aud": "songs", "exp": time.Now().Add(-time.Hour).Unix()}), "", true},
+		{"wrong audience", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, jwt.MapClaims{"sub": "u", "iss": "https://gateway.example.com", "aud": "other", "exp": time.Now().Add(time.Hour).Unix()}), "", true},
+		{"HS256 with RSA key id", signToken(t, jwt.SigningMethodHS256, "rsa-1", []byte(rsaPub["n"]), validClaims(nil)), "", true},
+		{"unknown signer", signToken(t, jwt.SigningMethodHS256, "hmac-1", []byte("another secret, not in the set"), validClaims(nil)), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := auth.Authenticate(ctx, tt.token)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrUnauthenticated)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "jwt:user-1", principal.Subject)
+			assert.Equal(t, tt.wantRole, principal.Role)
+		})
+	}
+}
+
+func TestJWKS_RotationFromURL(t *testing.T) {
+	oldKey, oldPub := rsaJWK(t, "old")
+	newKey, newPub := rsaJWK(t, "new")
+
+	current := []map[string]string{oldPub}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": current})
+	}))
+	defer srv.Close()
+
+	now := time.Now()
+	jwks := NewJWKS(srv.URL, time.Hour)
+	jwks.now = func() time.Time { return now }
+	auth := NewJWTAuthenticator(jwks, JWTConfig{})
+	ctx := context.Background()
+
+	_, err := auth.Authenticate(ctx, signToken(t, jwt.SigningMethodRS256, "old", oldKey, validClaims("reader")))
+	require.NoError(t, err)
+
+	// The gateway rotates its key; the new kid triggers a reload once the throttle allows
+	current = []map[string]string{newPub}
+	newToken := signToken(t, jwt.SigningMethodRS256, "new", newKey, validClaims("reader"))
+	_, err = auth.Authenticate(ctx, newToken)
+	assert.ErrorIs(t, err, domain.ErrUnauthenticated)
+
+	now = now.Add(jwksMinRefreshInterval + time.Second)
+	principal, err := auth.Authenticate(ctx, newToken)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleReader, principal.Role)
+}
+
+func TestJWKS_SlowSourceDoesNotBlockCachedKeys(t *testing.T) {
+	_, pub := rsaJWK(t, "old")
+
+	var requests atomic.Int32
+	fetching, release := make(chan struct{}), make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) > 1 {
+			close(fetching)
+			<-release
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{pub}})
+	}))
+	defer srv.Close()
+	releaseOnce := sync.OnceFunc(func() { close(release) })
+	defer releaseOnce()
+
+	var mu sync.Mutex
+	now := time.Now()
+	jwks := NewJWKS(srv.URL, time.Minute)
+	jwks.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	ctx := context.Background()
+	require.NoError(t, jwks.Refresh(ctx))
+
+	// The set goes stale and the source hangs while it is reloaded
+	mu.Lock()
+	now = now.Add(2 * time.Minute)
+	mu.Unlock()
+	reloaded := make(chan error, 1)
+	go func() {
+		_, err := jwks.Key(ctx, "old", "RS256")
+		reloaded <- err
+	}()
+	<-fetching
+
+	found := make(chan error, 1)
+	go func() {
+		_, err := jwks.Key(ctx, "old", "RS256")
+		found <- err
+	}()
+	select {
+	case err := <-found:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Key waited for the reload of a stale set")
+	}
+
+	releaseOnce()
+	assert.NoError(t, <-reloaded)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestParseRoleMap(t *testing.T) {
+	roles, err := ParseRoleMap("songs.read=reader, songs.write=editor")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]domain.Role{"songs.read": domain.RoleReader, "songs.write": domain.RoleEditor}, roles)
+
+	_, err = ParseRoleMap("songs.write=root")
+	assert.ErrorIs(t, err, domain.ErrInvalidRole)
+}
+
+func TestCredentialAuthenticator(t *testing.T) {
+	mockRepo := new(MockAPIKeyRepo)
+	auth := NewCredentialAuthenticator(NewAPIKeyService(mockRepo), nil)
+
+	_, err := auth.Authenticate(context.Background(), "header.payload.signature")
+	assert.ErrorIs(t, err, domain.ErrUnauthenticated)
+	mockRepo.AssertNotCalled(t, "FindAPIKeyByHash")
+}
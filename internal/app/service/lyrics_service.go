@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// LyricsService manages the lyrics of songs in several languages
+type LyricsService struct {
+	repo  LyricsRepository
+	songs SongReader
+	texts SongTextUpdater
+	tx    Transactor
+}
+
+// LyricsRepository defines the interface for lyrics repository operations
+type LyricsRepository interface {
+	ListLyrics(ctx context.Context, songID int) ([]domain.Lyrics, error)
+	GetLyrics(ctx context.Context, songID int, lang string) (*domain.Lyrics, error)
+	SaveLyrics(ctx context.Context, lyrics domain.Lyrics) (*domain.Lyrics, error)
+	DeleteLyrics(ctx context.Context, songID int, lang string) error
+}
+
+// SongTextUpdater changes the text of songs the way the SongService does,
+// detecting its language, whether it is explicit and its near duplicates
+type SongTextUpdater interface {
+	PartialUpdateSong(ctx context.Context, id int, updates map[string]interface{}) (*domain.Song, error)
+}
+
+// NewLyricsService creates a new instance of LyricsService writing the text
+// of songs given original lyrics through texts
+func NewLyricsService(repo LyricsRepository, songs SongReader, texts SongTextUpdater, tx Transactor) *LyricsService {
+	return &LyricsService{
+		repo:  repo,
+		songs: songs,
+		texts: texts,
+		tx:    tx,
+	}
+}
+
+// ListLyrics retrieves the lyrics of a song in every language, the original first
+func (s *LyricsService) ListLyrics(ctx context.Context, songID int) ([]domain.Lyrics, error) {
+	if err := checkSong(ctx, s.songs, songID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListLyrics(ctx, songID)
+}
+
+// PutLyrics creates or replaces the lyrics of a song in a language. Original
+// lyrics become the text of the song, analyzed as any other text written to
+// it; the original cannot be turned into a translation, another variant has
+// to be marked original instead.
+func (s *LyricsService) PutLyrics(ctx context.Context, lyrics domain.Lyrics) (*domain.Lyrics, error) {
+	lang, err := domain.NormalizeLanguage(lyrics.Language)
+	if err != nil {
+		return nil, err
+	}
+	lyrics.Language = lang
+	lyrics.Translator = strings.TrimSpace(lyrics.Translator)
+	lyrics.Source = strings.TrimSpace(lyrics.Source)
+	if strings.TrimSpace(lyrics.Text) == "" {
+		return nil, domain.ErrRequired
+	}
+	if utf8.RuneCountInString(lyrics.Translator) > domain.MaxLyricsCreditLength ||
+		utf8.RuneCountInString(lyrics.Source) > domain.MaxLyricsCreditLength {
+		return nil, domain.ErrValidation
+	}
+
+	var saved *domain.Lyrics
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := checkSong(ctx, s.songs, lyrics.SongID); err != nil {
+			return err
+		}
+
+		existing, err := s.repo.GetLyrics(ctx, lyrics.SongID, lang)
+		if err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return err
+		}
+		if existing != nil && existing.Original && !lyrics.Original {
+			return domain.ErrOriginalLyrics
+		}
+
+		if saved, err = s.repo.SaveLyrics(ctx, lyrics); err != nil {
+			return err
+		}
+		if lyrics.Original {
+			_, err = s.texts.PartialUpdateSong(ctx, lyrics.SongID, map[string]interface{}{"text": lyrics.Text, "language": lyrics.Language})
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// DeleteLyrics removes a translation of the lyrics of a song
+func (s *LyricsService) DeleteLyrics(ctx context.Context, songID int, lang string) error {
+	lang, err := domain.NormalizeLanguage(lang)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := s.repo.GetLyrics(ctx, songID, lang)
+		if errors.Is(err, domain.ErrNotFound) {
+			return domain.ErrLyricsNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if existing.Original {
+			return domain.ErrOriginalLyrics
+		}
+		return s.repo.DeleteLyrics(ctx, songID, lang)
+	})
+}
+
+// NegotiateLyrics picks the lyrics of a song best matching the preferred
+// languages, most preferred first. Without a good enough match it falls back
+// to the original lyrics, unless strict is set, in which case it fails with
+// ErrLyricsNotFound.
+func (s *LyricsService) NegotiateLyrics(ctx context.Context, song *domain.Song, preferences []string, strict bool) (*domain.Lyrics, error) {
+	variants, err := s.repo.ListLyrics(ctx, song.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	original := originalLyrics(song, variants)
+	if len(variants) == 0 {
+		if strict {
+			return nil, domain.ErrLyricsNotFound
+		}
+		return &original, nil
+	}
+
+	// The first supported language is the matcher's fallback
+	candidates := make([]domain.Lyrics, 0, len(variants)+1)
+	candidates = append(candidates, original)
+	for _, variant := range variants {
+		if !variant.Original {
+			candidates = append(candidates, variant)
+		}
+	}
+	supported := make([]language.Tag, len(candidates))
+	for i, candidate := range candidates {
+		supported[i] = language.Make(candidate.Language)
+	}
+
+	desired := make([]language.Tag, 0, len(preferences))
+	for _, preference := range preferences {
+		if tag, err := language.Parse(preference); err == nil {
+			desired = append(desired, tag)
+		}
+	}
+
+	_, index, confidence := language.NewMatcher(supported).Match(desired...)
+	if confidence == language.No {
+		if strict {
+			return nil, domain.ErrLyricsNotFound
+		}
+		return &original, nil
+	}
+	return &candidates[index], nil
+}
+
+// AlignLyrics lays the lyrics of a song in a language side by side with the
+// original lyrics, line by line
+func (s *LyricsService) AlignLyrics(ctx context.Context, songID int, lang string) (*domain.AlignedLyrics, error) {
+	lang, err := domain.NormalizeLanguage(lang)
+	if err != nil {
+		return nil, err
+	}
+
+	song, err := s.songs.GetSong(ctx, songID)
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+		return nil, domain.ErrSongNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	variants, err := s.repo.ListLyrics(ctx, songID)
+	if err != nil {
+		return nil, err
+	}
+	for _, variant := range variants {
+		if variant.Language == lang {
+			return domain.AlignLyrics(originalLyrics(song, variants), variant), nil
+		}
+	}
+	return nil, domain.ErrLyricsNotFound
+}
+
+// originalLyrics returns the original variant among the lyrics of a song,
+// or its text in the detected language when none is marked original
+func originalLyrics(song *domain.Song, variants []domain.Lyrics) domain.Lyrics {
+	for _, variant := range variants {
+		if variant.Original {
+			variant.Text = song.Text
+			return variant
+		}
+	}
+	return domain.Lyrics{SongID: song.ID, Language: song.Language, Text: song.Text, Original: true}
+}
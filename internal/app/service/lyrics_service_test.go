@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockLyricsRepo is a mock implementation of LyricsRepository
+type MockLyricsRepo struct {
+	mock.Mock
+}
+
+func (m *MockLyricsRepo) ListLyrics(ctx context.Context, songID int) ([]domain.Lyrics, error) {
+	args := m.Called(ctx, songID)
+	lyrics, _ := args.Get(0).([]domain.Lyrics)
+	return lyrics, args.Error(1)
+}
+
+func (m *MockLyricsRepo) GetLyrics(ctx context.Context, songID int, lang string) (*domain.Lyrics, error) {
+	args := m.Called(ctx, songID, lang)
+	lyrics, _ := args.Get(0).(*domain.Lyrics)
+	return lyrics, args.Error(1)
+}
+
+func (m *MockLyricsRepo) SaveLyrics(ctx context.Context, lyrics domain.Lyrics) (*domain.Lyrics, error) {
+	args := m.Called(ctx, lyrics)
+	saved, _ := args.Get(0).(*domain.Lyrics)
+	return saved, args.Error(1)
+}
+
+func (m *MockLyricsRepo) DeleteLyrics(ctx context.Context, songID int, lang string) error {
+	return m.Called(ctx, songID, lang).Error(0)
+}
+
+var frenchSong = &domain.Song{ID: 1, Title: "La Mer", Text: "La mer\nQu'on voit danser\n\nLe long des golfes clairs"}
+
+var songVariants = []domain.Lyrics{
+	{SongID: 1, Language: "fr", Text: "stale copy", Original: true},
+	{SongID: 1, Language: "en", Text: "The sea\nWe see dancing", Translator: "J. Doe"},
+	{SongID: 1, Language: "pt-BR", Text: "O mar"},
+}
+
+func TestPutLyrics(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockLyricsRepo)
+	songs := new(MockSongRepo)
+	service := NewLyricsService(repo, songs, nil, &fakeTransactor{})
+
+	want := domain.Lyrics{SongID: 1, Language: "pt-BR", Text: "O mar", Translator: "Ana"}
+	songs.On("GetSong", ctx, 1).Return(frenchSong, nil)
+	repo.On("GetLyrics", ctx, 1, "pt-BR").Return(nil, domain.ErrNotFound)
+	repo.On("SaveLyrics", ctx, want).Return(&want, nil)
+
+	saved, err := service.PutLyrics(ctx, domain.Lyrics{SongID: 1, Language: "pt-br", Text: "O mar", Translator: " Ana "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pt-BR", saved.Language)
+	repo.AssertExpectations(t)
+}
+
+func TestPutLyrics_Original(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockLyricsRepo)
+	songs := new(MockSongRepo)
+	// The detector guesses wrong; the tag of the lyrics is kept
+	detector := fakeDetector{"Oh merde": {Language: "en", Script: "Latn", Confidence: 0.4}}
+	service := NewLyricsService(repo, songs, NewSongService(songs, detector, fakeScanner{}, nil), &fakeTransactor{})
+
+	want := domain.Lyrics{SongID: 1, Language: "fr", Text: "Oh merde", Original: true}
+	songs.On("GetSong", ctx, 1).Return(frenchSong, nil)
+	repo.On("GetLyrics", ctx, 1, "fr").Return(&songVariants[0], nil)
+	repo.On("SaveLyrics", ctx, want).Return(&want, nil)
+	songs.On("PartialUpdateSong", ctx, 1, map[string]interface{}{
+		"text": "Oh merde", "language": "fr", "language_confidence": 1.0, "script": "Latn", "explicit": true,
+	}).Return(&domain.Song{ID: 1, Text: "Oh merde", Language: "fr", Script: "Latn", Explicit: true}, nil)
+
+	_, err := service.PutLyrics(ctx, domain.Lyrics{SongID: 1, Language: "fr", Text: "Oh merde", Original: true})
+
+	require.NoError(t, err)
+	songs.AssertExpectations(t)
+}
+
+func TestPutLyrics_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		lyrics  domain.Lyrics
+		wantErr error
+	}{
+		{name: "bad language", lyrics: domain.Lyrics{SongID: 1, Language: "not a tag", Text: "x"}, wantErr: domain.ErrInvalidLanguage},
+		{name: "no text", lyrics: domain.Lyrics{SongID: 1, Language: "en", Text: " \n"}, wantErr: domain.ErrRequired},
+		{name: "long source", lyrics: domain.Lyrics{SongID: 1, Language: "en", Text: "x", Source: strings.Repeat("s", 256)}, wantErr: domain.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTransactor{}
+			service := NewLyricsService(new(MockLyricsRepo), new(MockSongRepo), nil, tx)
+
+			_, err := service.PutLyrics(context.Background(), tt.lyrics)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 0, tx.calls)
+		})
+	}
+}
+
+func TestPutLyrics_KeepsOriginal(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockLyricsRepo)
+	songs := new(MockSongRepo)
+	service := NewLyricsService(repo, songs, nil, &fakeTransactor{})
+
+	songs.On("GetSong", ctx, 1).Return(frenchSong, nil)
+	repo.On("GetLyrics", ctx, 1, "fr").Return(&songVariants[0], nil)
+
+	_, err := service.PutLyrics(ctx, domain.Lyrics{SongID: 1, Language: "fr", Text: "La mer"})
+
+	assert.ErrorIs(t, err, domain.ErrOriginalLyrics)
+	repo.AssertNotCalled(t, "SaveLyrics", mock.Anything, mock.Anything)
+}
+
+func TestDeleteLyrics_Original(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockLyricsRepo)
+	service := NewLyricsService(repo, new(MockSongRepo), nil, &fakeTransactor{})
+
+	repo.On("GetLyrics", ctx, 1, "fr").Return(&songVariants[0], nil)
+
+	err := service.DeleteLyrics(ctx, 1, "FR")
+
+	assert.ErrorIs(t, err, domain.ErrOriginalLyrics)
+	repo.AssertNotCalled(t, "DeleteLyrics", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNegotiateLyrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		preferences []string
+		strict      bool
+		wantLang    string
+		wantText    string
+		wantErr     error
+	}{
+		{name: "exact", preferences: []string{"en"}, wantLang: "en", wantText: "The sea\nWe see dancing"},
+		{name: "regional variant", preferences: []string{"en-GB", "fr"}, wantLang: "en"},
+		{name: "second choice", preferences: []string{"de", "pt-BR"}, wantLang: "pt-BR"},
+		{name: "original from song text", preferences: []string{"fr"}, wantLang: "fr", wantText: frenchSong.Text},
+		{name: "falls back to original", preferences: []string{"ja"}, wantLang: "fr", wantText: frenchSong.Text},
+		{name: "strict", preferences: []string{"ja"}, strict: true, wantErr: domain.ErrLyricsNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := new(MockLyricsRepo)
+			service := NewLyricsService(repo, new(MockSongRepo), nil, &fakeTransactor{})
+
+			repo.On("ListLyrics", ctx, 1).Return(songVariants, nil)
+
+			lyrics, err := service.NegotiateLyrics(ctx, frenchSong, tt.preferences, tt.strict)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLang, lyrics.Language)
+			if tt.wantText != "" {
+				assert.Equal(t, tt.wantText, lyrics.Text)
+			}
+		})
+	}
+}
+
+func TestNegotiateLyrics_NoVariants(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockLyricsRepo)
+	service := NewLyricsService(repo, new(MockSongRepo), nil, &fakeTransactor{})
+
+	repo.On("ListLyrics", ctx, 1).Return([]domain.Lyrics{}, nil)
+
+	lyrics, err := service.NegotiateLyrics(ctx, frenchSong, []string{"en"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, frenchSong.Text, lyrics.Text)
+	assert.Empty(t, lyrics.Language)
+
+	// The detected language stands in for an original variant
+	detected := *frenchSong
+	detected.Language = "fr"
+	lyrics, err = service.NegotiateLyrics(ctx, &detected, []string{"en"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "fr", lyrics.Language)
+
+	_, err = service.NegotiateLyrics(ctx, frenchSong, []string{"en"}, true)
+	assert.ErrorIs(t, err, domain.ErrLyricsNotFound)
+}
+
+func TestAlignLyrics(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockLyricsRepo)
+	songs := new(MockSongRepo)
+	service := NewLyricsService(repo, songs, nil, &fakeTransactor{})
+
+	songs.On("GetSong", ctx, 1).Return(frenchSong, nil)
+	repo.On("ListLyrics", ctx, 1).Return(songVariants, nil)
+
+	aligned, err := service.AlignLyrics(ctx, 1, "en")
+
+	require.NoError(t, err)
+	assert.Equal(t, "fr", aligned.Original.Language)
+	assert.Equal(t, []domain.AlignedLine{
+		{Verse: 1, Line: 1, Original: "La mer", Translation: "The sea"},
+		{Verse: 1, Line: 2, Original: "Qu'on voit danser", Translation: "We see dancing"},
+		{Verse: 2, Line: 1, Original: "Le long des golfes clairs"},
+	}, aligned.Lines)
+
+	_, err = service.AlignLyrics(ctx, 1, "de")
+	assert.ErrorIs(t, err, domain.ErrLyricsNotFound)
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"songs/internal/app/domain"
+	"songs/internal/pkg/shingle"
+)
+
+// NearDuplicateService catches lyrics submitted again under another title.
+// It keeps the shingles of every song in memory, built by BuildIndex and
+// kept up to date with the committed events of the change feed, whatever
+// wrote the songs and on whichever instance, and checks the lyrics of songs
+// written through the SongService as their NearDuplicateChecker.
+type NearDuplicateService struct {
+	index     NearDuplicateIndex
+	songs     NearDuplicateSongRepository
+	mode      domain.NearDuplicateMode
+	threshold float64
+}
+
+// NearDuplicateIndex finds texts nearly the same as others
+type NearDuplicateIndex interface {
+	Put(id int, text string)
+	Remove(id int)
+	Matches(id int, text string, threshold float64) []shingle.Match
+	Clusters(threshold float64) []shingle.Cluster
+}
+
+// NearDuplicateSongRepository defines the song repository operations near
+// duplicate detection needs
+type NearDuplicateSongRepository interface {
+	GetSong(ctx context.Context, id int) (*domain.Song, error)
+	StreamSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error
+}
+
+// NewNearDuplicateService creates a new instance of NearDuplicateService.
+// Lyrics at least threshold similar to those of another song are warned
+// about or rejected depending on mode.
+func NewNearDuplicateService(index NearDuplicateIndex, songs NearDuplicateSongRepository, mode domain.NearDuplicateMode, threshold float64) *NearDuplicateService {
+	return &NearDuplicateService{
+		index:     index,
+		songs:     songs,
+		mode:      mode,
+		threshold: threshold,
+	}
+}
+
+// BuildIndex indexes the lyrics of every song, returning how many songs were indexed
+func (s *NearDuplicateService) BuildIndex(ctx context.Context) (int, error) {
+	indexed := 0
+	err := s.songs.StreamSongs(ctx, map[string]string{}, func(song *domain.Song) error {
+		s.index.Put(song.ID, song.Text)
+		indexed++
+		return nil
+	})
+	return indexed, err
+}
+
+// SongChanged indexes the lyrics of new and updated songs and drops deleted
+// songs, as read from the change feed. After a reset of the feed, events
+// having been missed, every song is indexed again.
+func (s *NearDuplicateService) SongChanged(ctx context.Context, event domain.SongEvent) {
+	switch event.Type {
+	case domain.SongEventDeleted:
+		s.index.Remove(event.SongID)
+	case domain.SongEventReset:
+		if _, err := s.BuildIndex(ctx); err != nil {
+			log.Printf("index songs for near duplicates: %v", err)
+		}
+	default:
+		s.index.Put(event.Song.ID, event.Song.Text)
+	}
+}
+
+// CheckLyrics compares the lyrics about to be written to song id, 0 for a
+// new song, with those of the other songs. The near duplicates are returned
+// as warnings, or rejected with a NearDuplicateError in reject mode.
+func (s *NearDuplicateService) CheckLyrics(ctx context.Context, songID int, text string) ([]domain.NearDuplicate, error) {
+	if s.mode == domain.NearDuplicateOff {
+		return nil, nil
+	}
+
+	var found []domain.NearDuplicate
+	for _, match := range s.index.Matches(songID, text, s.threshold) {
+		song, ok, err := s.indexedSong(ctx, match.ID)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			found = append(found, domain.NearDuplicate{SongID: song.ID, Title: song.Title, Similarity: match.Similarity})
+		}
+	}
+
+	if len(found) > 0 && s.mode == domain.NearDuplicateReject {
+		return nil, &domain.NearDuplicateError{Matches: found}
+	}
+	return found, nil
+}
+
+// NearDuplicateClusters lists the sets of songs linked by lyrics at least
+// threshold similar, the configured threshold when 0
+func (s *NearDuplicateService) NearDuplicateClusters(ctx context.Context, threshold float64) ([]domain.NearDuplicateCluster, error) {
+	if threshold == 0 {
+		threshold = s.threshold
+	}
+
+	var clusters []domain.NearDuplicateCluster
+	for _, cluster := range s.index.Clusters(threshold) {
+		songs := make(map[int]*domain.Song, len(cluster.IDs))
+		for _, id := range cluster.IDs {
+			song, ok, err := s.indexedSong(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				songs[id] = song
+			}
+		}
+
+		// Songs found deleted are left out with their pairs, and so are
+		// the songs only they linked to the others
+		var result domain.NearDuplicateCluster
+		linked := make(map[int]bool)
+		for _, pair := range cluster.Pairs {
+			if songs[pair.A] != nil && songs[pair.B] != nil {
+				result.Pairs = append(result.Pairs, domain.NearDuplicatePair{SongID: pair.A, OtherID: pair.B, Similarity: pair.Similarity})
+				linked[pair.A], linked[pair.B] = true, true
+			}
+		}
+		for _, id := range cluster.IDs {
+			if linked[id] {
+				result.Songs = append(result.Songs, songs[id])
+			}
+		}
+		if len(result.Pairs) > 0 {
+			clusters = append(clusters, result)
+		}
+	}
+	return clusters, nil
+}
+
+// indexedSong loads an indexed song, dropping it from the index when it
+// turns out to have been deleted before the change feed told
+func (s *NearDuplicateService) indexedSong(ctx context.Context, id int) (*domain.Song, bool, error) {
+	song, err := s.songs.GetSong(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		s.index.Remove(id)
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return song, true, nil
+}
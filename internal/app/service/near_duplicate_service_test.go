@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+	"songs/internal/pkg/shingle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const yesterday = "Yesterday, all my troubles seemed so far away\nNow it looks as though they're here to stay\nOh, I believe in yesterday"
+
+var (
+	original = &domain.Song{ID: 1, Title: "Yesterday", Text: yesterday}
+	copied   = &domain.Song{ID: 2, Title: "Yesterday (lyrics)", Text: "YESTERDAY all my troubles seemed so far away, now it looks as though they're here to stay. Oh I believe in yesterday!"}
+	sun      = &domain.Song{ID: 3, Title: "Here Comes the Sun", Text: "Here comes the sun, and I say it's all right, little darling"}
+)
+
+func newNearDuplicateService(songs *MockSongRepo, mode domain.NearDuplicateMode) *NearDuplicateService {
+	service := NewNearDuplicateService(shingle.New(), songs, mode, 0.8)
+	service.SongChanged(context.Background(), domain.SongEvent{Type: domain.SongEventCreated, SongID: original.ID, Song: original})
+	service.SongChanged(context.Background(), domain.SongEvent{Type: domain.SongEventCreated, SongID: sun.ID, Song: sun})
+	return service
+}
+
+func TestCheckLyrics_Warn(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := newNearDuplicateService(songs, domain.NearDuplicateWarn)
+
+	songs.On("GetSong", ctx, 1).Return(original, nil)
+
+	found, err := service.CheckLyrics(ctx, 0, copied.Text)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.NearDuplicate{{SongID: 1, Title: "Yesterday", Similarity: 1}}, found)
+
+	found, err = service.CheckLyrics(ctx, 1, yesterday)
+	require.NoError(t, err)
+	assert.Empty(t, found, "a song is no duplicate of itself")
+}
+
+func TestCheckLyrics_Reject(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := newNearDuplicateService(songs, domain.NearDuplicateReject)
+
+	songs.On("GetSong", ctx, 1).Return(original, nil)
+
+	_, err := service.CheckLyrics(ctx, 0, copied.Text)
+	var dupErr *domain.NearDuplicateError
+	require.ErrorAs(t, err, &dupErr)
+	assert.ErrorIs(t, err, domain.ErrNearDuplicate)
+	assert.Equal(t, 1, dupErr.Matches[0].SongID)
+
+	found, err := service.CheckLyrics(ctx, 0, "Let it be, let it be, let it be, let it be, whisper words of wisdom")
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestCheckLyrics_Off(t *testing.T) {
+	service := newNearDuplicateService(new(MockSongRepo), domain.NearDuplicateOff)
+
+	found, err := service.CheckLyrics(context.Background(), 0, yesterday)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestCheckLyrics_SkipsDeletedSongs(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := newNearDuplicateService(songs, domain.NearDuplicateReject)
+
+	songs.On("GetSong", ctx, 1).Return(nil, domain.ErrNotFound).Once()
+
+	found, err := service.CheckLyrics(ctx, 0, yesterday)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	found, err = service.CheckLyrics(ctx, 0, yesterday)
+	require.NoError(t, err)
+	assert.Empty(t, found, "the deleted song was dropped from the index")
+	songs.AssertExpectations(t)
+}
+
+func TestNearDuplicateClusters(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := NewNearDuplicateService(shingle.New(), songs, domain.NearDuplicateWarn, 0.8)
+
+	songs.On("StreamSongs", ctx, map[string]string{}, mock.Anything).Return([]*domain.Song{original, copied, sun}, nil)
+	songs.On("GetSong", ctx, 1).Return(original, nil)
+	songs.On("GetSong", ctx, 2).Return(copied, nil)
+
+	_, err := service.BuildIndex(ctx)
+	require.NoError(t, err)
+
+	clusters, err := service.NearDuplicateClusters(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	assert.Equal(t, []*domain.Song{original, copied}, clusters[0].Songs)
+	assert.Equal(t, []domain.NearDuplicatePair{{SongID: 1, OtherID: 2, Similarity: 1}}, clusters[0].Pairs)
+}
+
+func TestSongService_NearDuplicates(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockSongRepo)
+	checker := newNearDuplicateService(repo, domain.NearDuplicateWarn)
+	songService := NewSongService(repo, nil, nil, checker)
+
+	repo.On("GetSong", ctx, 1).Return(original, nil)
+	repo.On("CreateSong", ctx, mock.Anything).Return(&domain.Song{ID: 2, Title: copied.Title, Text: copied.Text}, nil)
+
+	created, err := songService.CreateSong(ctx, &domain.Song{Title: copied.Title, Text: copied.Text})
+	require.NoError(t, err)
+	require.Len(t, created.NearDuplicates, 1)
+	assert.Equal(t, 1, created.NearDuplicates[0].SongID)
+}
+
+func TestNearDuplicateService_FollowsChangeFeed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := new(MockSongRepo)
+	checker := NewNearDuplicateService(shingle.New(), repo, domain.NearDuplicateReject, 0.8)
+	events := &fakeSongEvents{}
+	feed := NewChangeFeedService(events, time.Hour)
+	go feed.Follow(ctx, 0, func(event domain.SongEvent) { checker.SongChanged(ctx, event) })
+
+	repo.On("GetSong", ctx, 1).Return(original, nil)
+
+	// A song imported around the SongService is caught once committed
+	created, _ := events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: 1, Song: original})
+	feed.SongChanged(ctx, *created)
+	require.Eventually(t, func() bool {
+		_, err := checker.CheckLyrics(ctx, 0, copied.Text)
+		return errors.Is(err, domain.ErrNearDuplicate)
+	}, time.Second, 5*time.Millisecond)
+
+	// And forgotten once merged away
+	deleted, _ := events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventDeleted, SongID: 1})
+	feed.SongChanged(ctx, *deleted)
+	require.Eventually(t, func() bool {
+		found, err := checker.CheckLyrics(ctx, 0, copied.Text)
+		return err == nil && len(found) == 0
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSongService_NearDuplicateRejected(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockSongRepo)
+	checker := newNearDuplicateService(repo, domain.NearDuplicateReject)
+	songService := NewSongService(repo, nil, nil, checker)
+
+	repo.On("GetSong", ctx, 1).Return(original, nil)
+
+	_, err := songService.PartialUpdateSong(ctx, 3, map[string]interface{}{"text": copied.Text})
+	assert.ErrorIs(t, err, domain.ErrNearDuplicate)
+	repo.AssertNotCalled(t, "PartialUpdateSong", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"songs/internal/app/domain"
+	"time"
+)
+
+const (
+	// outboxBatchSize is the number of events claimed at a time by the relay
+	outboxBatchSize = 50
+	// outboxLease is how long the relay has to deliver the events it claimed
+	// before another relay may claim them again
+	outboxLease = time.Minute
+	// outboxMinBackoff and outboxMaxBackoff bound the delay before an event
+	// whose delivery failed is tried again, doubling with every attempt
+	outboxMinBackoff = time.Second
+	outboxMaxBackoff = 10 * time.Minute
+	// outboxStuckLimit is the most stuck events listed by Status
+	outboxStuckLimit = 100
+)
+
+// OutboxService relays the song events of the outbox, written in the
+// transaction of every song write, to the sinks. Events are delivered at
+// least once to every sink, in order per song: a song's next event waits
+// until the previous one reached every sink. An event failing on one sink
+// is delivered again to all of them, so sinks should ignore event IDs they
+// already handled.
+type OutboxService struct {
+	repo       OutboxRepository
+	sinks      []OutboxSink
+	retention  time.Duration
+	stuckAfter time.Duration
+	now        func() time.Time
+}
+
+// OutboxRepository defines the interface for the outbox storage
+type OutboxRepository interface {
+	ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit int) ([]domain.OutboxEvent, error)
+	MarkOutboxEventDelivered(ctx context.Context, id int64, at time.Time) error
+	MarkOutboxEventFailed(ctx context.Context, id int64, nextAttemptAt time.Time, reason string) error
+	OutboxStatus(ctx context.Context, stuckBefore time.Time, limit int) (*domain.OutboxStatus, error)
+	RetryOutboxEvent(ctx context.Context, id int64, now time.Time) error
+	DeleteDeliveredBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OutboxSink delivers the events of the outbox out of the process
+type OutboxSink interface {
+	// Name identifies the sink in delivery errors
+	Name() string
+	// Deliver sends message, the JSON document of event
+	Deliver(ctx context.Context, event domain.OutboxEvent, message []byte) error
+}
+
+// outboxMessage is the JSON document delivered for an event
+type outboxMessage struct {
+	ID     int64  `json:"id"`
+	Type   string `json:"type"`
+	SongID int    `json:"song_id"`
+	// Song is the song as written, left out of deleted events
+	Song json.RawMessage `json:"song,omitempty"`
+	Time string          `json:"time"`
+}
+
+// NewOutboxService creates a new instance of OutboxService delivering to
+// sinks. Delivered events are kept for retention; events pending for longer
+// than stuckAfter are reported as stuck.
+func NewOutboxService(repo OutboxRepository, sinks []OutboxSink, retention, stuckAfter time.Duration) *OutboxService {
+	return &OutboxService{
+		repo:       repo,
+		sinks:      sinks,
+		retention:  retention,
+		stuckAfter: stuckAfter,
+		now:        time.Now,
+	}
+}
+
+// Run relays the events due every interval until ctx is done
+func (s *OutboxService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Relay(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("outbox: relay: %v", err)
+			}
+		}
+	}
+}
+
+// Relay delivers the events due until none is left and returns how many
+// were delivered. Events failing to deliver are scheduled again with a
+// backoff.
+func (s *OutboxService) Relay(ctx context.Context) (int, error) {
+	delivered := 0
+	for {
+		now := s.now()
+		events, err := s.repo.ClaimOutboxEvents(ctx, now, now.Add(outboxLease), outboxBatchSize)
+		if err != nil {
+			return delivered, err
+		}
+		if len(events) == 0 {
+			return delivered, nil
+		}
+
+		for _, event := range events {
+			if err := s.deliver(ctx, event); err != nil {
+				next := s.now().Add(outboxBackoff(event.Attempts))
+				if err := s.repo.MarkOutboxEventFailed(ctx, event.ID, next, err.Error()); err != nil {
+					return delivered, err
+				}
+				continue
+			}
+			if err := s.repo.MarkOutboxEventDelivered(ctx, event.ID, s.now()); err != nil {
+				return delivered, err
+			}
+			delivered++
+		}
+	}
+}
+
+// deliver sends an event to every sink, stopping at the first failure
+func (s *OutboxService) deliver(ctx context.Context, event domain.OutboxEvent) error {
+	message, err := json.Marshal(outboxMessage{
+		ID:     event.ID,
+		Type:   string(event.Type),
+		SongID: event.SongID,
+		Song:   event.Payload,
+		Time:   event.CreatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, sink := range s.sinks {
+		if err := sink.Deliver(ctx, event, message); err != nil {
+			return fmt.Errorf("%s: %w", sink.Name(), err)
+		}
+	}
+	return nil
+}
+
+// outboxBackoff is the delay before the next attempt after the given
+// number of failed ones
+func outboxBackoff(attempts int) time.Duration {
+	backoff := outboxMinBackoff
+	for i := 1; i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, outboxMaxBackoff)
+}
+
+// Status counts the pending events and lists those stuck, pending for
+// longer than expected because their delivery keeps failing, an earlier
+// event of their song does, or no relay is running
+func (s *OutboxService) Status(ctx context.Context) (*domain.OutboxStatus, error) {
+	return s.repo.OutboxStatus(ctx, s.now().Add(-s.stuckAfter), outboxStuckLimit)
+}
+
+// Retry makes a pending event due now, for instance once the sink failing
+// it was fixed
+func (s *OutboxService) Retry(ctx context.Context, id int64) error {
+	return s.repo.RetryOutboxEvent(ctx, id, s.now())
+}
+
+// PurgeDelivered removes the events delivered longer than the retention
+// ago and returns how many were removed
+func (s *OutboxService) PurgeDelivered(ctx context.Context) (int64, error) {
+	return s.repo.DeleteDeliveredBefore(ctx, s.now().Add(-s.retention))
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOutboxRepo is a mock implementation of OutboxRepository
+type MockOutboxRepo struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepo) ClaimOutboxEvents(ctx context.Context, now, leaseUntil time.Time, limit int) ([]domain.OutboxEvent, error) {
+	args := m.Called(ctx, now, leaseUntil, limit)
+	events, _ := args.Get(0).([]domain.OutboxEvent)
+	return events, args.Error(1)
+}
+
+func (m *MockOutboxRepo) MarkOutboxEventDelivered(ctx context.Context, id int64, at time.Time) error {
+	return m.Called(ctx, id, at).Error(0)
+}
+
+func (m *MockOutboxRepo) MarkOutboxEventFailed(ctx context.Context, id int64, nextAttemptAt time.Time, reason string) error {
+	return m.Called(ctx, id, nextAttemptAt, reason).Error(0)
+}
+
+func (m *MockOutboxRepo) OutboxStatus(ctx context.Context, stuckBefore time.Time, limit int) (*domain.OutboxStatus, error) {
+	args := m.Called(ctx, stuckBefore, limit)
+	status, _ := args.Get(0).(*domain.OutboxStatus)
+	return status, args.Error(1)
+}
+
+func (m *MockOutboxRepo) RetryOutboxEvent(ctx context.Context, id int64, now time.Time) error {
+	return m.Called(ctx, id, now).Error(0)
+}
+
+func (m *MockOutboxRepo) DeleteDeliveredBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// fakeSink records the messages delivered to it, failing the events in fail
+type fakeSink struct {
+	messages []string
+	fail     map[int64]bool
+}
+
+func (f *fakeSink) Name() string { return "fake" }
+
+func (f *fakeSink) Deliver(_ context.Context, event domain.OutboxEvent, message []byte) error {
+	if f.fail[event.ID] {
+		return errors.New("unavailable")
+	}
+	f.messages = append(f.messages, string(message))
+	return nil
+}
+
+func newTestOutboxService(repo *MockOutboxRepo, sinks ...OutboxSink) (*OutboxService, time.Time) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	service := NewOutboxService(repo, sinks, 24*time.Hour, 5*time.Minute)
+	service.now = func() time.Time { return now }
+	return service, now
+}
+
+func TestOutboxRelay(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockOutboxRepo)
+	sink := &fakeSink{fail: map[int64]bool{2: true}}
+	service, now := newTestOutboxService(repo, sink)
+
+	repo.On("ClaimOutboxEvents", ctx, now, now.Add(outboxLease), outboxBatchSize).Return([]domain.OutboxEvent{
+		{ID: 1, Type: domain.SongEventCreated, SongID: 7, Payload: []byte(`{"id":7,"title":"Yesterday"}`), CreatedAt: now, Attempts: 1},
+		{ID: 2, Type: domain.SongEventDeleted, SongID: 8, CreatedAt: now, Attempts: 3},
+	}, nil).Once()
+	repo.On("ClaimOutboxEvents", ctx, now, now.Add(outboxLease), outboxBatchSize).Return(nil, nil).Once()
+	repo.On("MarkOutboxEventDelivered", ctx, int64(1), now).Return(nil)
+	repo.On("MarkOutboxEventFailed", ctx, int64(2), now.Add(4*time.Second), "fake: unavailable").Return(nil)
+
+	delivered, err := service.Relay(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	require.Len(t, sink.messages, 1)
+	assert.JSONEq(t, `{"id":1,"type":"created","song_id":7,"song":{"id":7,"title":"Yesterday"},"time":"2024-05-01T12:00:00Z"}`, sink.messages[0])
+	repo.AssertExpectations(t)
+}
+
+func TestOutboxRelay_StopsAtFailingSink(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockOutboxRepo)
+	failing := &fakeSink{fail: map[int64]bool{1: true}}
+	after := &fakeSink{}
+	service, now := newTestOutboxService(repo, failing, after)
+
+	repo.On("ClaimOutboxEvents", ctx, now, now.Add(outboxLease), outboxBatchSize).Return([]domain.OutboxEvent{
+		{ID: 1, Type: domain.SongEventDeleted, SongID: 7, Attempts: 1},
+	}, nil).Once()
+	repo.On("ClaimOutboxEvents", ctx, now, now.Add(outboxLease), outboxBatchSize).Return(nil, nil).Once()
+	repo.On("MarkOutboxEventFailed", ctx, int64(1), now.Add(time.Second), "fake: unavailable").Return(nil)
+
+	delivered, err := service.Relay(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, delivered)
+	assert.Empty(t, after.messages)
+	repo.AssertExpectations(t)
+}
+
+func TestOutboxRelay_ClaimError(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockOutboxRepo)
+	service, now := newTestOutboxService(repo)
+
+	repo.On("ClaimOutboxEvents", ctx, now, now.Add(outboxLease), outboxBatchSize).Return(nil, domain.ErrDatabase)
+
+	_, err := service.Relay(ctx)
+	assert.ErrorIs(t, err, domain.ErrDatabase)
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, outboxBackoff(1))
+	assert.Equal(t, 8*time.Second, outboxBackoff(4))
+	assert.Equal(t, outboxMaxBackoff, outboxBackoff(50))
+}
+
+func TestOutboxStatus(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockOutboxRepo)
+	service, now := newTestOutboxService(repo)
+
+	expected := &domain.OutboxStatus{Pending: 3, Stuck: []domain.OutboxEvent{{ID: 1, LastError: "webhook: timeout"}}}
+	repo.On("OutboxStatus", ctx, now.Add(-5*time.Minute), outboxStuckLimit).Return(expected, nil)
+	repo.On("RetryOutboxEvent", ctx, int64(9), now).Return(domain.ErrOutboxEventNotFound)
+	repo.On("DeleteDeliveredBefore", ctx, now.Add(-24*time.Hour)).Return(int64(2), nil)
+
+	status, err := service.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, status)
+	assert.ErrorIs(t, service.Retry(ctx, 9), domain.ErrOutboxEventNotFound)
+	purged, err := service.PurgeDelivered(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+}
+
+func TestOutboxMessage_DeletedSong(t *testing.T) {
+	sink := &fakeSink{}
+	service, now := newTestOutboxService(new(MockOutboxRepo), sink)
+
+	require.NoError(t, service.deliver(context.Background(), domain.OutboxEvent{ID: 5, Type: domain.SongEventDeleted, SongID: 7, CreatedAt: now}))
+
+	var message map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(sink.messages[0]), &message))
+	assert.NotContains(t, message, "song")
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"songs/internal/app/domain"
+	"strconv"
+	"sync"
+)
+
+// LogSink writes the events of the outbox to the standard logger
+type LogSink struct{}
+
+// NewLogSink creates a new instance of LogSink
+func NewLogSink() *LogSink {
+	return &LogSink{}
+}
+
+func (s *LogSink) Name() string { return "log" }
+
+// Deliver logs the message of the event
+func (s *LogSink) Deliver(_ context.Context, _ domain.OutboxEvent, message []byte) error {
+	log.Printf("outbox event: %s", message)
+	return nil
+}
+
+// WebhookSink posts the events of the outbox to a URL. Any status other
+// than 2xx fails the delivery.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a new instance of WebhookSink posting to url with client
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: client,
+	}
+}
+
+func (s *WebhookSink) Name() string { return "webhook" }
+
+// Deliver posts the message of the event, its ID and type in the Event-ID
+// and Event-Type headers
+func (s *WebhookSink) Deliver(ctx context.Context, event domain.OutboxEvent, message []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(message))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Event-ID", strconv.FormatInt(event.ID, 10))
+	req.Header.Set("Event-Type", string(event.Type))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s responded %d", s.url, resp.StatusCode)
+	}
+	return nil
+}
+
+// FileSink appends the events of the outbox to a file, one JSON document
+// per line, synced to disk before the delivery is recorded
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens, or creates, the file at path to append events to it
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Name() string { return "file" }
+
+// Deliver appends the message of the event as a line
+func (s *FileSink) Deliver(_ context.Context, _ domain.OutboxEvent, message []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(append(message, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink := NewWebhookSink(srv.URL, srv.Client())
+	event := domain.OutboxEvent{ID: 12, Type: domain.SongEventUpdated, SongID: 7}
+
+	require.NoError(t, sink.Deliver(context.Background(), event, []byte(`{"id":12}`)))
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "12", received.Header.Get("Event-ID"))
+	assert.Equal(t, "updated", received.Header.Get("Event-Type"))
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, `{"id":12}`, string(body))
+
+	status = http.StatusServiceUnavailable
+	assert.ErrorContains(t, sink.Deliver(context.Background(), event, []byte(`{"id":12}`)), "responded 503")
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Deliver(context.Background(), domain.OutboxEvent{ID: 1}, []byte(`{"id":1}`)))
+	require.NoError(t, sink.Deliver(context.Background(), domain.OutboxEvent{ID: 2}, []byte(`{"id":2}`)))
+	require.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":1}\n{\"id\":2}\n", string(data))
+}
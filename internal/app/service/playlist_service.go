@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"strings"
+	"unicode/utf8"
+)
+
+// playlistCopySuffix is appended to the name of a duplicated playlist when no name is given
+const playlistCopySuffix = " (copy)"
+
+// PlaylistService manages playlists and the order of their songs
+type PlaylistService struct {
+	repo  PlaylistRepository
+	songs SongReader
+	tx    Transactor
+}
+
+// PlaylistRepository defines the interface for playlist repository operations
+type PlaylistRepository interface {
+	CreatePlaylist(ctx context.Context, playlist *domain.Playlist) (*domain.Playlist, error)
+	GetPlaylist(ctx context.Context, id int) (*domain.Playlist, error)
+	LockPlaylist(ctx context.Context, id int) (*domain.Playlist, error)
+	ListPlaylists(ctx context.Context, page, pageSize int) ([]*domain.Playlist, int64, error)
+	FindPlaylistsBySong(ctx context.Context, songID int) ([]*domain.Playlist, error)
+	RenamePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error)
+	DeletePlaylist(ctx context.Context, id int) error
+	GetPlaylistEntries(ctx context.Context, playlistID int) ([]domain.PlaylistEntry, error)
+	InsertPlaylistEntry(ctx context.Context, playlistID, position, songID int) error
+	RemovePlaylistEntry(ctx context.Context, playlistID, position int) error
+	MovePlaylistEntry(ctx context.Context, playlistID, from, to int) error
+	CopyPlaylist(ctx context.Context, sourceID int, name string) (*domain.Playlist, error)
+}
+
+// SongReader looks songs up; the song repository satisfies it
+type SongReader interface {
+	GetSong(ctx context.Context, id int) (*domain.Song, error)
+}
+
+// NewPlaylistService creates a new instance of PlaylistService
+func NewPlaylistService(repo PlaylistRepository, songs SongReader, tx Transactor) *PlaylistService {
+	return &PlaylistService{
+		repo:  repo,
+		songs: songs,
+		tx:    tx,
+	}
+}
+
+// CreatePlaylist creates an empty playlist
+func (s *PlaylistService) CreatePlaylist(ctx context.Context, name string) (*domain.Playlist, error) {
+	name, err := validatePlaylistName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := s.repo.CreatePlaylist(ctx, &domain.Playlist{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	playlist.Entries = []domain.PlaylistEntry{}
+	return playlist, nil
+}
+
+// GetPlaylist retrieves a playlist with its songs in order
+func (s *PlaylistService) GetPlaylist(ctx context.Context, id int) (*domain.Playlist, error) {
+	playlist, err := s.repo.GetPlaylist(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	playlist.Entries, err = s.repo.GetPlaylistEntries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// ListPlaylists retrieves playlists with pagination, without their songs
+func (s *PlaylistService) ListPlaylists(ctx context.Context, page, pageSize int) ([]*domain.Playlist, int64, error) {
+	return s.repo.ListPlaylists(ctx, page, pageSize)
+}
+
+// RenamePlaylist changes the name of a playlist
+func (s *PlaylistService) RenamePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error) {
+	name, err := validatePlaylistName(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.RenamePlaylist(ctx, id, name)
+}
+
+// DeletePlaylist deletes a playlist; its songs are kept
+func (s *PlaylistService) DeletePlaylist(ctx context.Context, id int) error {
+	return s.repo.DeletePlaylist(ctx, id)
+}
+
+// AddSong inserts a song at a 1-based position of a playlist, moving the
+// songs from that position on down by one. Position 0 appends the song.
+func (s *PlaylistService) AddSong(ctx context.Context, playlistID, songID, position int) (*domain.Playlist, error) {
+	if position < 0 {
+		return nil, domain.ErrInvalidPosition
+	}
+
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		playlist, err := s.repo.LockPlaylist(ctx, playlistID)
+		if err != nil {
+			return err
+		}
+		if err := checkSong(ctx, s.songs, songID); err != nil {
+			return err
+		}
+
+		if position == 0 {
+			position = playlist.SongCount + 1
+		}
+		if position > playlist.SongCount+1 {
+			return domain.ErrInvalidPosition
+		}
+		return s.repo.InsertPlaylistEntry(ctx, playlistID, position, songID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPlaylist(ctx, playlistID)
+}
+
+// RemoveSong removes the song at a position of a playlist; the songs after
+// it move up by one
+func (s *PlaylistService) RemoveSong(ctx context.Context, playlistID, position int) (*domain.Playlist, error) {
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		playlist, err := s.repo.LockPlaylist(ctx, playlistID)
+		if err != nil {
+			return err
+		}
+		if position < 1 || position > playlist.SongCount {
+			return domain.ErrInvalidPosition
+		}
+		return s.repo.RemovePlaylistEntry(ctx, playlistID, position)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPlaylist(ctx, playlistID)
+}
+
+// MoveSong moves the song at position from to position to; the songs in
+// between shift by one to make room
+func (s *PlaylistService) MoveSong(ctx context.Context, playlistID, from, to int) (*domain.Playlist, error) {
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		playlist, err := s.repo.LockPlaylist(ctx, playlistID)
+		if err != nil {
+			return err
+		}
+		if from < 1 || from > playlist.SongCount || to < 1 || to > playlist.SongCount {
+			return domain.ErrInvalidPosition
+		}
+		return s.repo.MovePlaylistEntry(ctx, playlistID, from, to)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPlaylist(ctx, playlistID)
+}
+
+// DuplicatePlaylist copies a playlist and its songs under a new name. An
+// empty name reuses the source name with a " (copy)" suffix.
+func (s *PlaylistService) DuplicatePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error) {
+	var copied *domain.Playlist
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Lock the source so the copy is a consistent snapshot
+		source, err := s.repo.LockPlaylist(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if strings.TrimSpace(name) == "" {
+			name = copyName(source.Name)
+		}
+		if name, err = validatePlaylistName(name); err != nil {
+			return err
+		}
+
+		copied, err = s.repo.CopyPlaylist(ctx, id, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPlaylist(ctx, copied.ID)
+}
+
+// PlaylistsWithSong lists the playlists containing a song
+func (s *PlaylistService) PlaylistsWithSong(ctx context.Context, songID int) ([]*domain.Playlist, error) {
+	if err := checkSong(ctx, s.songs, songID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindPlaylistsBySong(ctx, songID)
+}
+
+// checkSong makes sure a song exists, telling a missing song apart from a
+// missing playlist, genre, ...
+func checkSong(ctx context.Context, songs SongReader, songID int) error {
+	_, err := songs.GetSong(ctx, songID)
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+		return domain.ErrSongNotFound
+	}
+	return err
+}
+
+func validatePlaylistName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", domain.ErrRequired
+	}
+	if utf8.RuneCountInString(name) > domain.MaxPlaylistNameLength {
+		return "", domain.ErrValidation
+	}
+	return name, nil
+}
+
+// copyName derives the name of a copy, shortening the original name so the
+// suffix fits
+func copyName(name string) string {
+	runes := []rune(name)
+	if limit := domain.MaxPlaylistNameLength - utf8.RuneCountInString(playlistCopySuffix); len(runes) > limit {
+		runes = runes[:limit]
+	}
+	return string(runes) + playlistCopySuffix
+}
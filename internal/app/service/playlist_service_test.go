@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPlaylistRepo is a mock implementation of PlaylistRepository
+type MockPlaylistRepo struct {
+	mock.Mock
+}
+
+func (m *MockPlaylistRepo) CreatePlaylist(ctx context.Context, playlist *domain.Playlist) (*domain.Playlist, error) {
+	args := m.Called(ctx, playlist)
+	created, _ := args.Get(0).(*domain.Playlist)
+	return created, args.Error(1)
+}
+
+func (m *MockPlaylistRepo) GetPlaylist(ctx context.Context, id int) (*domain.Playlist, error) {
+	args := m.Called(ctx, id)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistRepo) LockPlaylist(ctx context.Context, id int) (*domain.Playlist, error) {
+	args := m.Called(ctx, id)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistRepo) ListPlaylists(ctx context.Context, page, pageSize int) ([]*domain.Playlist, int64, error) {
+	args := m.Called(ctx, page, pageSize)
+	playlists, _ := args.Get(0).([]*domain.Playlist)
+	return playlists, args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockPlaylistRepo) FindPlaylistsBySong(ctx context.Context, songID int) ([]*domain.Playlist, error) {
+	args := m.Called(ctx, songID)
+	playlists, _ := args.Get(0).([]*domain.Playlist)
+	return playlists, args.Error(1)
+}
+
+func (m *MockPlaylistRepo) RenamePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error) {
+	args := m.Called(ctx, id, name)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistRepo) DeletePlaylist(ctx context.Context, id int) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockPlaylistRepo) GetPlaylistEntries(ctx context.Context, playlistID int) ([]domain.PlaylistEntry, error) {
+	args := m.Called(ctx, playlistID)
+	entries, _ := args.Get(0).([]domain.PlaylistEntry)
+	return entries, args.Error(1)
+}
+
+func (m *MockPlaylistRepo) InsertPlaylistEntry(ctx context.Context, playlistID, position, songID int) error {
+	return m.Called(ctx, playlistID, position, songID).Error(0)
+}
+
+func (m *MockPlaylistRepo) RemovePlaylistEntry(ctx context.Context, playlistID, position int) error {
+	return m.Called(ctx, playlistID, position).Error(0)
+}
+
+func (m *MockPlaylistRepo) MovePlaylistEntry(ctx context.Context, playlistID, from, to int) error {
+	return m.Called(ctx, playlistID, from, to).Error(0)
+}
+
+func (m *MockPlaylistRepo) CopyPlaylist(ctx context.Context, sourceID int, name string) (*domain.Playlist, error) {
+	args := m.Called(ctx, sourceID, name)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func TestCreatePlaylist_Validation(t *testing.T) {
+	service := NewPlaylistService(new(MockPlaylistRepo), new(MockSongRepo), &fakeTransactor{})
+
+	_, err := service.CreatePlaylist(context.Background(), "   ")
+	assert.ErrorIs(t, err, domain.ErrRequired)
+
+	_, err = service.CreatePlaylist(context.Background(), strings.Repeat("a", domain.MaxPlaylistNameLength+1))
+	assert.ErrorIs(t, err, domain.ErrValidation)
+}
+
+func TestAddSong(t *testing.T) {
+	ctx := context.Background()
+	playlist := &domain.Playlist{ID: 1, Name: "Road trip", SongCount: 2}
+
+	tests := []struct {
+		name         string
+		position     int
+		songErr      error
+		wantPosition int
+		wantErr      error
+	}{
+		{name: "append", position: 0, wantPosition: 3},
+		{name: "insert first", position: 1, wantPosition: 1},
+		{name: "insert after last", position: 3, wantPosition: 3},
+		{name: "past the end", position: 4, wantErr: domain.ErrInvalidPosition},
+		{name: "negative", position: -1, wantErr: domain.ErrInvalidPosition},
+		{name: "unknown song", position: 0, songErr: domain.ErrNotFound, wantErr: domain.ErrSongNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockPlaylistRepo)
+			songs := new(MockSongRepo)
+			service := NewPlaylistService(repo, songs, &fakeTransactor{})
+
+			repo.On("LockPlaylist", ctx, 1).Return(playlist, nil).Maybe()
+			if tt.songErr != nil {
+				songs.On("GetSong", ctx, 7).Return(nil, tt.songErr)
+			} else {
+				songs.On("GetSong", ctx, 7).Return(&domain.Song{ID: 7}, nil).Maybe()
+			}
+			if tt.wantErr == nil {
+				repo.On("InsertPlaylistEntry", ctx, 1, tt.wantPosition, 7).Return(nil)
+				repo.On("GetPlaylist", ctx, 1).Return(&domain.Playlist{ID: 1, SongCount: 3}, nil)
+				repo.On("GetPlaylistEntries", ctx, 1).Return([]domain.PlaylistEntry{}, nil)
+			}
+
+			result, err := service.AddSong(ctx, 1, 7, tt.position)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				repo.AssertNotCalled(t, "InsertPlaylistEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 3, result.SongCount)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMoveSong_ValidatesPositions(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPlaylistRepo)
+	service := NewPlaylistService(repo, new(MockSongRepo), &fakeTransactor{})
+
+	repo.On("LockPlaylist", ctx, 1).Return(&domain.Playlist{ID: 1, SongCount: 3}, nil)
+	repo.On("MovePlaylistEntry", ctx, 1, 3, 1).Return(nil)
+	repo.On("GetPlaylist", ctx, 1).Return(&domain.Playlist{ID: 1, SongCount: 3}, nil)
+	repo.On("GetPlaylistEntries", ctx, 1).Return([]domain.PlaylistEntry{}, nil)
+
+	_, err := service.MoveSong(ctx, 1, 3, 1)
+	require.NoError(t, err)
+
+	_, err = service.MoveSong(ctx, 1, 1, 4)
+	assert.ErrorIs(t, err, domain.ErrInvalidPosition)
+
+	_, err = service.RemoveSong(ctx, 1, 0)
+	assert.ErrorIs(t, err, domain.ErrInvalidPosition)
+	repo.AssertNumberOfCalls(t, "MovePlaylistEntry", 1)
+}
+
+func TestDuplicatePlaylist_DefaultName(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPlaylistRepo)
+	tx := &fakeTransactor{}
+	service := NewPlaylistService(repo, new(MockSongRepo), tx)
+
+	long := strings.Repeat("a", domain.MaxPlaylistNameLength)
+	repo.On("LockPlaylist", ctx, 1).Return(&domain.Playlist{ID: 1, Name: long}, nil)
+	repo.On("CopyPlaylist", ctx, 1, mock.MatchedBy(func(name string) bool {
+		return len(name) == domain.MaxPlaylistNameLength && strings.HasSuffix(name, " (copy)")
+	})).Return(&domain.Playlist{ID: 2}, nil)
+	repo.On("GetPlaylist", ctx, 2).Return(&domain.Playlist{ID: 2}, nil)
+	repo.On("GetPlaylistEntries", ctx, 2).Return([]domain.PlaylistEntry{}, nil)
+
+	copied, err := service.DuplicatePlaylist(ctx, 1, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, copied.ID)
+	assert.Equal(t, 1, tx.calls)
+	repo.AssertExpectations(t)
+}
+
+func TestPlaylistsWithSong_UnknownSong(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPlaylistRepo)
+	songs := new(MockSongRepo)
+	service := NewPlaylistService(repo, songs, &fakeTransactor{})
+
+	songs.On("GetSong", ctx, 9).Return(nil, domain.ErrNotFound)
+
+	_, err := service.PlaylistsWithSong(ctx, 9)
+
+	assert.ErrorIs(t, err, domain.ErrSongNotFound)
+	repo.AssertNotCalled(t, "FindPlaylistsBySong", mock.Anything, mock.Anything)
+}
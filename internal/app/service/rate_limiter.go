@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"songs/internal/app/domain"
+	"sync"
+	"time"
+)
+
+// memoryBucketSweepInterval is how often idle buckets are dropped from memory
+const memoryBucketSweepInterval = time.Minute
+
+// RateLimiter charges requests against per-client token buckets. A bucket
+// holds up to capacity tokens and refills at rate tokens per second; every
+// request takes as many tokens as it costs.
+type RateLimiter struct {
+	store    RateLimitStore
+	capacity int
+	rate     float64
+}
+
+// RateLimitStore keeps token buckets. Take refills the bucket of key for the
+// elapsed time, takes cost tokens if there are enough and returns the tokens
+// left and whether the cost was taken.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, cost, capacity int, rate float64) (float64, bool, error)
+}
+
+// NewRateLimiter creates a new instance of RateLimiter
+func NewRateLimiter(store RateLimitStore, capacity int, rate float64) *RateLimiter {
+	return &RateLimiter{
+		store:    store,
+		capacity: capacity,
+		rate:     rate,
+	}
+}
+
+// Allow charges cost tokens to the bucket of key. When the store fails the
+// request is let through: the limiter must not take the API down with it.
+func (l *RateLimiter) Allow(ctx context.Context, key string, cost int) domain.RateLimitDecision {
+	tokens, allowed, err := l.store.Take(ctx, key, cost, l.capacity, l.rate)
+	if err != nil {
+		log.Printf("rate limit: %v", err)
+		return domain.RateLimitDecision{Allowed: true, Limit: l.capacity, Remaining: l.capacity}
+	}
+
+	decision := domain.RateLimitDecision{
+		Allowed:   allowed,
+		Limit:     l.capacity,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     l.duration(float64(l.capacity) - tokens),
+	}
+	if !allowed {
+		if cost > l.capacity {
+			// Never affordable, the caller must not keep retrying
+			decision.RetryAfter = l.duration(float64(l.capacity))
+		} else {
+			decision.RetryAfter = l.duration(float64(cost) - tokens)
+		}
+	}
+	return decision
+}
+
+// Window is the time an empty bucket takes to refill
+func (l *RateLimiter) Window() time.Duration {
+	return l.duration(float64(l.capacity))
+}
+
+// duration is the time needed to refill the given number of tokens
+func (l *RateLimiter) duration(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(tokens / l.rate * float64(time.Second)))
+}
+
+// MemoryRateLimitStore keeps token buckets in process memory, so limits
+// apply per instance
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// NewMemoryRateLimitStore creates a new in-memory bucket store
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// Take implements RateLimitStore
+func (s *MemoryRateLimitStore) Take(_ context.Context, key string, cost, capacity int, rate float64) (float64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) > memoryBucketSweepInterval {
+		s.sweep(now, capacity, rate)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(capacity), updatedAt: now}
+		s.buckets[key] = b
+	}
+	b.tokens = refill(b.tokens, now.Sub(b.updatedAt), capacity, rate)
+	b.updatedAt = now
+
+	if b.tokens < float64(cost) {
+		return b.tokens, false, nil
+	}
+	b.tokens -= float64(cost)
+	return b.tokens, true, nil
+}
+
+// sweep drops buckets that refilled completely; they are recreated full on demand
+func (s *MemoryRateLimitStore) sweep(now time.Time, capacity int, rate float64) {
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if refill(b.tokens, now.Sub(b.updatedAt), capacity, rate) >= float64(capacity) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func refill(tokens float64, elapsed time.Duration, capacity int, rate float64) float64 {
+	return math.Min(float64(capacity), tokens+elapsed.Seconds()*rate)
+}
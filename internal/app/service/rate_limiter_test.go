@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a manually advanced clock for the in-memory bucket store
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestLimiter(capacity int, rate float64) (*RateLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := NewMemoryRateLimitStore()
+	store.now = clock.Now
+	return NewRateLimiter(store, capacity, rate), clock
+}
+
+func TestRateLimiter_Allow(t *testing.T) {
+	limiter, clock := newTestLimiter(10, 2)
+	ctx := context.Background()
+
+	decision := limiter.Allow(ctx, "apikey:1", 3)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 10, decision.Limit)
+	assert.Equal(t, 7, decision.Remaining)
+	assert.Equal(t, 1500*time.Millisecond, decision.Reset)
+
+	decision = limiter.Allow(ctx, "apikey:1", 7)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 0, decision.Remaining)
+
+	// Empty bucket: 3 tokens refill in 1.5s
+	decision = limiter.Allow(ctx, "apikey:1", 3)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, 1500*time.Millisecond, decision.RetryAfter)
+	assert.Equal(t, 5*time.Second, decision.Reset)
+
+	// Other callers have their own bucket
+	assert.True(t, limiter.Allow(ctx, "ip:10.0.0.1", 10).Allowed)
+
+	clock.now = clock.now.Add(1500 * time.Millisecond)
+	decision = limiter.Allow(ctx, "apikey:1", 3)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 0, decision.Remaining)
+
+	// Refill never exceeds the capacity
+	clock.now = clock.now.Add(time.Hour)
+	decision = limiter.Allow(ctx, "apikey:1", 1)
+	assert.Equal(t, 9, decision.Remaining)
+}
+
+func TestRateLimiter_CostAboveCapacity(t *testing.T) {
+	limiter, _ := newTestLimiter(5, 1)
+
+	decision := limiter.Allow(context.Background(), "apikey:1", 10)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, 5, decision.Remaining)
+	assert.Equal(t, 5*time.Second, decision.RetryAfter)
+}
+
+func TestMemoryRateLimitStore_SweepsFullBuckets(t *testing.T) {
+	limiter, clock := newTestLimiter(10, 1)
+	store := limiter.store.(*MemoryRateLimitStore)
+	ctx := context.Background()
+
+	limiter.Allow(ctx, "apikey:1", 5)
+	limiter.Allow(ctx, "apikey:2", 1)
+	assert.Len(t, store.buckets, 2)
+
+	// apikey:1 is full again after 5s, apikey:2 after 1s
+	clock.now = clock.now.Add(memoryBucketSweepInterval + time.Second)
+	limiter.Allow(ctx, "apikey:3", 1)
+	assert.Len(t, store.buckets, 1)
+	assert.Contains(t, store.buckets, "apikey:3")
+}
+
+// failingStore always fails, like an unreachable database
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, int, int, float64) (float64, bool, error) {
+	return 0, false, errors.New("connection refused")
+}
+
+func TestRateLimiter_FailsOpen(t *testing.T) {
+	limiter := NewRateLimiter(failingStore{}, 10, 1)
+
+	decision := limiter.Allow(context.Background(), "apikey:1", 3)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 10, decision.Remaining)
+}
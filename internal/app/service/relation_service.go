@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"sort"
+)
+
+// RelationService links songs to the songs they are versions of
+type RelationService struct {
+	repo  RelationRepository
+	songs SongReader
+	tx    Transactor
+}
+
+// RelationRepository defines the interface for song relation repository operations
+type RelationRepository interface {
+	LockRelations(ctx context.Context) error
+	IsVersionOf(ctx context.Context, songID, originalID int) (bool, error)
+	CreateRelation(ctx context.Context, relation domain.SongRelation) (*domain.SongRelation, error)
+	DeleteRelation(ctx context.Context, songID, originalID int) error
+	SongRelations(ctx context.Context, songID int) ([]domain.SongRelation, error)
+	SongFamily(ctx context.Context, songID int) ([]domain.SongRelation, error)
+}
+
+// NewRelationService creates a new instance of RelationService
+func NewRelationService(repo RelationRepository, songs SongReader, tx Transactor) *RelationService {
+	return &RelationService{
+		repo:  repo,
+		songs: songs,
+		tx:    tx,
+	}
+}
+
+// LinkSongs records that songID is a version of originalID. A song cannot
+// be linked to one of its own versions, so originals never form a cycle.
+func (s *RelationService) LinkSongs(ctx context.Context, songID, originalID int, relationType domain.RelationType) (*domain.SongRelation, error) {
+	if !relationType.Valid() || songID == originalID {
+		return nil, domain.ErrInvalidRelation
+	}
+
+	var relation *domain.SongRelation
+	err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.LockRelations(ctx); err != nil {
+			return err
+		}
+		for _, id := range []int{songID, originalID} {
+			if err := checkSong(ctx, s.songs, id); err != nil {
+				return err
+			}
+		}
+
+		cycle, err := s.repo.IsVersionOf(ctx, originalID, songID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return domain.ErrRelationCycle
+		}
+
+		relation, err = s.repo.CreateRelation(ctx, domain.SongRelation{SongID: songID, OriginalID: originalID, Type: relationType})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return relation, nil
+}
+
+// UnlinkSongs removes the relation between a song and its original
+func (s *RelationService) UnlinkSongs(ctx context.Context, songID, originalID int) error {
+	return s.repo.DeleteRelation(ctx, songID, originalID)
+}
+
+// SongRelations retrieves the originals of a song and its versions
+func (s *RelationService) SongRelations(ctx context.Context, songID int) ([]domain.SongRelation, error) {
+	if err := checkSong(ctx, s.songs, songID); err != nil {
+		return nil, err
+	}
+	return s.repo.SongRelations(ctx, songID)
+}
+
+// SongFamily retrieves every song connected to a song through relations,
+// including the song itself
+func (s *RelationService) SongFamily(ctx context.Context, songID int) (*domain.VersionFamily, error) {
+	song, err := s.songs.GetSong(ctx, songID)
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+		return nil, domain.ErrSongNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	relations, err := s.repo.SongFamily(ctx, songID)
+	if err != nil {
+		return nil, err
+	}
+
+	titles := map[int]string{song.ID: song.Title}
+	versions := make(map[int]bool)
+	for _, relation := range relations {
+		titles[relation.SongID] = relation.SongTitle
+		titles[relation.OriginalID] = relation.OriginalTitle
+		versions[relation.SongID] = true
+	}
+
+	family := &domain.VersionFamily{
+		Songs:     make([]domain.FamilySong, 0, len(titles)),
+		Relations: relations,
+	}
+	for id, title := range titles {
+		family.Songs = append(family.Songs, domain.FamilySong{ID: id, Title: title, Original: !versions[id]})
+	}
+	sort.Slice(family.Songs, func(i, j int) bool {
+		a, b := family.Songs[i], family.Songs[j]
+		if a.Original != b.Original {
+			return a.Original
+		}
+		return a.ID < b.ID
+	})
+	return family, nil
+}
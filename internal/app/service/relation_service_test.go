@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRelationRepo is a mock implementation of RelationRepository
+type MockRelationRepo struct {
+	mock.Mock
+}
+
+func (m *MockRelationRepo) LockRelations(ctx context.Context) error {
+	return m.Called(ctx).Error(0)
+}
+
+func (m *MockRelationRepo) IsVersionOf(ctx context.Context, songID, originalID int) (bool, error) {
+	args := m.Called(ctx, songID, originalID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRelationRepo) CreateRelation(ctx context.Context, relation domain.SongRelation) (*domain.SongRelation, error) {
+	args := m.Called(ctx, relation)
+	created, _ := args.Get(0).(*domain.SongRelation)
+	return created, args.Error(1)
+}
+
+func (m *MockRelationRepo) DeleteRelation(ctx context.Context, songID, originalID int) error {
+	return m.Called(ctx, songID, originalID).Error(0)
+}
+
+func (m *MockRelationRepo) SongRelations(ctx context.Context, songID int) ([]domain.SongRelation, error) {
+	args := m.Called(ctx, songID)
+	relations, _ := args.Get(0).([]domain.SongRelation)
+	return relations, args.Error(1)
+}
+
+func (m *MockRelationRepo) SongFamily(ctx context.Context, songID int) ([]domain.SongRelation, error) {
+	args := m.Called(ctx, songID)
+	relations, _ := args.Get(0).([]domain.SongRelation)
+	return relations, args.Error(1)
+}
+
+func TestLinkSongs(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRelationRepo)
+	songs := new(MockSongRepo)
+	service := NewRelationService(repo, songs, &fakeTransactor{})
+
+	relation := domain.SongRelation{SongID: 2, OriginalID: 1, Type: domain.RelationCover}
+	repo.On("LockRelations", ctx).Return(nil)
+	songs.On("GetSong", ctx, 2).Return(&domain.Song{ID: 2}, nil)
+	songs.On("GetSong", ctx, 1).Return(&domain.Song{ID: 1}, nil)
+	repo.On("IsVersionOf", ctx, 1, 2).Return(false, nil)
+	repo.On("CreateRelation", ctx, relation).Return(&relation, nil)
+
+	created, err := service.LinkSongs(ctx, 2, 1, domain.RelationCover)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.RelationCover, created.Type)
+	repo.AssertExpectations(t)
+}
+
+func TestLinkSongs_Cycle(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRelationRepo)
+	songs := new(MockSongRepo)
+	tx := &fakeTransactor{}
+	service := NewRelationService(repo, songs, tx)
+
+	// 1 is already a version of 2, so 2 cannot become a version of 1
+	repo.On("LockRelations", ctx).Return(nil)
+	songs.On("GetSong", ctx, mock.Anything).Return(&domain.Song{}, nil)
+	repo.On("IsVersionOf", ctx, 1, 2).Return(true, nil)
+
+	_, err := service.LinkSongs(ctx, 2, 1, domain.RelationRemix)
+
+	assert.ErrorIs(t, err, domain.ErrRelationCycle)
+	assert.True(t, tx.rolledBack)
+	repo.AssertNotCalled(t, "CreateRelation", mock.Anything, mock.Anything)
+}
+
+func TestLinkSongs_Invalid(t *testing.T) {
+	tx := &fakeTransactor{}
+	service := NewRelationService(new(MockRelationRepo), new(MockSongRepo), tx)
+
+	_, err := service.LinkSongs(context.Background(), 2, 2, domain.RelationCover)
+	assert.ErrorIs(t, err, domain.ErrInvalidRelation)
+
+	_, err = service.LinkSongs(context.Background(), 2, 1, "sample")
+	assert.ErrorIs(t, err, domain.ErrInvalidRelation)
+	assert.Equal(t, 0, tx.calls)
+}
+
+func TestLinkSongs_UnknownOriginal(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRelationRepo)
+	songs := new(MockSongRepo)
+	service := NewRelationService(repo, songs, &fakeTransactor{})
+
+	repo.On("LockRelations", ctx).Return(nil)
+	songs.On("GetSong", ctx, 2).Return(&domain.Song{ID: 2}, nil)
+	songs.On("GetSong", ctx, 9).Return(nil, domain.ErrNotFound)
+
+	_, err := service.LinkSongs(ctx, 2, 9, domain.RelationLive)
+
+	assert.ErrorIs(t, err, domain.ErrSongNotFound)
+}
+
+func TestSongFamily(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRelationRepo)
+	songs := new(MockSongRepo)
+	service := NewRelationService(repo, songs, &fakeTransactor{})
+
+	songs.On("GetSong", ctx, 3).Return(&domain.Song{ID: 3, Title: "Traduction"}, nil)
+	repo.On("SongFamily", ctx, 3).Return([]domain.SongRelation{
+		{SongID: 2, SongTitle: "Cover", OriginalID: 5, OriginalTitle: "Original", Type: domain.RelationCover},
+		{SongID: 3, SongTitle: "Traduction", OriginalID: 2, OriginalTitle: "Cover", Type: domain.RelationTranslation},
+	}, nil)
+
+	family, err := service.SongFamily(ctx, 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.FamilySong{
+		{ID: 5, Title: "Original", Original: true},
+		{ID: 2, Title: "Cover"},
+		{ID: 3, Title: "Traduction"},
+	}, family.Songs)
+	assert.Len(t, family.Relations, 2)
+}
+
+func TestSongFamily_NoRelations(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRelationRepo)
+	songs := new(MockSongRepo)
+	service := NewRelationService(repo, songs, &fakeTransactor{})
+
+	songs.On("GetSong", ctx, 4).Return(&domain.Song{ID: 4, Title: "Alone"}, nil)
+	repo.On("SongFamily", ctx, 4).Return([]domain.SongRelation{}, nil)
+
+	family, err := service.SongFamily(ctx, 4)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.FamilySong{{ID: 4, Title: "Alone", Original: true}}, family.Songs)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"songs/internal/app/domain"
+	"songs/internal/pkg/similarity"
+)
+
+// SimilarityService recommends songs with lyrics and titles like those of
+// another song. It keeps an index of every song in memory, built by
+// BuildIndex and kept up to date with the committed events of the change
+// feed, whatever wrote the songs and on whichever instance.
+type SimilarityService struct {
+	index SimilarityIndex
+	songs SimilaritySongRepository
+}
+
+// SimilarityIndex ranks songs by the similarity of their titles and lyrics
+type SimilarityIndex interface {
+	Put(id int, title, text string)
+	Remove(id int)
+	Similar(id, limit int) ([]similarity.Match, bool)
+}
+
+// SimilaritySongRepository defines the song repository operations the
+// similarity index needs
+type SimilaritySongRepository interface {
+	GetSong(ctx context.Context, id int) (*domain.Song, error)
+	StreamSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error
+}
+
+// NewSimilarityService creates a new instance of SimilarityService
+func NewSimilarityService(index SimilarityIndex, songs SimilaritySongRepository) *SimilarityService {
+	return &SimilarityService{
+		index: index,
+		songs: songs,
+	}
+}
+
+// BuildIndex indexes every song, returning how many were indexed
+func (s *SimilarityService) BuildIndex(ctx context.Context) (int, error) {
+	indexed := 0
+	err := s.songs.StreamSongs(ctx, map[string]string{}, func(song *domain.Song) error {
+		s.index.Put(song.ID, song.Title, song.Text)
+		indexed++
+		return nil
+	})
+	return indexed, err
+}
+
+// SongChanged indexes new and updated songs and drops deleted ones, as
+// read from the change feed. After a reset of the feed, events having been
+// missed, every song is indexed again.
+func (s *SimilarityService) SongChanged(ctx context.Context, event domain.SongEvent) {
+	switch event.Type {
+	case domain.SongEventDeleted:
+		s.index.Remove(event.SongID)
+	case domain.SongEventReset:
+		if _, err := s.BuildIndex(ctx); err != nil {
+			log.Printf("index songs for recommendations: %v", err)
+		}
+	default:
+		s.index.Put(event.Song.ID, event.Song.Title, event.Song.Text)
+	}
+}
+
+// SimilarSongs returns the limit songs most similar to a song, most similar
+// first. The song is indexed again first, so songs written since the last
+// event read from the change feed are compared as they are now, and
+// recommended songs found deleted are dropped from the index.
+func (s *SimilarityService) SimilarSongs(ctx context.Context, songID, limit int) ([]domain.SimilarSong, error) {
+	song, err := s.songs.GetSong(ctx, songID)
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+		return nil, domain.ErrSongNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.index.Put(song.ID, song.Title, song.Text)
+
+	matches, _ := s.index.Similar(songID, limit)
+	similar := make([]domain.SimilarSong, 0, len(matches))
+	for _, match := range matches {
+		other, err := s.songs.GetSong(ctx, match.ID)
+		if errors.Is(err, domain.ErrNotFound) {
+			s.index.Remove(match.ID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		similar = append(similar, domain.SimilarSong{Song: other, Score: match.Score})
+	}
+	return similar, nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+	"songs/internal/pkg/similarity"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	submarine = &domain.Song{ID: 1, Title: "Yellow Submarine", Text: "We all live in a yellow submarine\nA yellow submarine"}
+	remix     = &domain.Song{ID: 2, Title: "Yellow Submarine (Remix)", Text: "Yellow submarine, yellow submarine"}
+	garden    = &domain.Song{ID: 3, Title: "Octopus's Garden", Text: "I'd like to be under the sea, octopus's garden"}
+)
+
+func TestSimilarSongs(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := NewSimilarityService(similarity.New(), songs)
+
+	songs.On("StreamSongs", ctx, map[string]string{}, mock.Anything).Return([]*domain.Song{submarine, remix, garden}, nil)
+	songs.On("GetSong", ctx, 1).Return(submarine, nil)
+	songs.On("GetSong", ctx, 2).Return(remix, nil)
+
+	indexed, err := service.BuildIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, indexed)
+
+	similar, err := service.SimilarSongs(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, similar, 1, "songs sharing no word are not recommended")
+	assert.Equal(t, remix, similar[0].Song)
+	assert.Greater(t, similar[0].Score, 0.5)
+	songs.AssertExpectations(t)
+}
+
+func TestSimilarSongs_DropsDeletedSongs(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	index := similarity.New()
+	service := NewSimilarityService(index, songs)
+
+	service.SongChanged(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: remix.ID, Song: remix})
+	songs.On("GetSong", ctx, 1).Return(submarine, nil)
+	songs.On("GetSong", ctx, 2).Return(nil, domain.ErrNotFound)
+
+	similar, err := service.SimilarSongs(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, similar)
+	assert.Equal(t, 1, index.Len(), "the deleted song is dropped, the queried one indexed")
+}
+
+func TestSimilarSongs_NotFound(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := NewSimilarityService(similarity.New(), songs)
+
+	songs.On("GetSong", ctx, 9).Return(nil, domain.ErrNotFound)
+
+	_, err := service.SimilarSongs(ctx, 9, 10)
+	assert.ErrorIs(t, err, domain.ErrSongNotFound)
+}
+
+func TestSimilarityService_FollowsChangeFeed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo := new(MockSongRepo)
+	index := similarity.New()
+	service := NewSimilarityService(index, repo)
+	events := &fakeSongEvents{}
+	feed := NewChangeFeedService(events, time.Hour)
+
+	// Songs written around the SongService, by an import
+	_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: 1, Song: submarine})
+	_, _ = events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventCreated, SongID: 2, Song: remix})
+	go feed.Follow(ctx, 0, func(event domain.SongEvent) { service.SongChanged(ctx, event) })
+
+	require.Eventually(t, func() bool { return index.Len() == 2 }, time.Second, 5*time.Millisecond)
+	matches, ok := index.Similar(2, 10)
+	require.True(t, ok)
+	assert.Equal(t, 1, matches[0].ID)
+
+	// By a merge
+	deleted, _ := events.AppendSongEvent(ctx, domain.SongEvent{Type: domain.SongEventDeleted, SongID: 1})
+	feed.SongChanged(ctx, *deleted)
+	require.Eventually(t, func() bool { return index.Len() == 1 }, time.Second, 5*time.Millisecond)
+	_, ok = index.Similar(1, 10)
+	assert.False(t, ok)
+
+	// Events were missed, the index is built again
+	repo.On("StreamSongs", ctx, map[string]string{}, mock.Anything).Return([]*domain.Song{submarine}, nil)
+	service.SongChanged(ctx, domain.SongEvent{Seq: 9, Type: domain.SongEventReset})
+	assert.Equal(t, 2, index.Len())
+}
@@ -2,12 +2,20 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"songs/internal/app/domain"
+	"songs/internal/pkg/langdetect"
+	"time"
 )
 
 // SongService implements the SongService interface
 type SongService struct {
-	repo SongRepository
+	repo     SongRepository
+	detector LanguageDetector
+	scanner  ExplicitScanner
+	checker  NearDuplicateChecker
+	// observers are told about every song written
+	observers []SongObserver
 }
 
 // SongRepository defines the interface for song repository operations
@@ -19,12 +27,49 @@ type SongRepository interface {
 	PartialUpdateSong(ctx context.Context, id int, updates map[string]interface{}) (*domain.Song, error)
 	DeleteSong(ctx context.Context, id int) error
 	GetSongVerses(ctx context.Context, id int, page, size int) ([]string, int, error)
+	StreamSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error
+	SongsWithoutLanguage(ctx context.Context, afterID, limit int, all bool) ([]*domain.Song, error)
+	SetSongLanguage(ctx context.Context, id int, lang, script string, confidence float64) error
+	SongsWithComputedExplicit(ctx context.Context, afterID, limit int) ([]*domain.Song, error)
+	SetSongExplicit(ctx context.Context, id int, explicit bool) error
 }
 
-// NewSongService creates a new instance of SongService
-func NewSongService(repo SongRepository) *SongService {
+// LanguageDetector guesses the language and script of lyrics
+type LanguageDetector interface {
+	Detect(text string) langdetect.Detection
+}
+
+// ExplicitScanner finds explicit words in lyrics of a language
+type ExplicitScanner interface {
+	Explicit(text, lang string) bool
+	Mask(text, lang string) string
+}
+
+// NearDuplicateChecker compares lyrics about to be written with those of
+// the other songs, returning the near duplicates to warn about or an error
+// rejecting the lyrics
+type NearDuplicateChecker interface {
+	CheckLyrics(ctx context.Context, songID int, text string) ([]domain.NearDuplicate, error)
+}
+
+// SongObserver is told about the songs written through a SongService, once
+// they are written. Writes made within a transaction that is then rolled
+// back are reported all the same.
+type SongObserver interface {
+	SongChanged(ctx context.Context, event domain.SongEvent)
+}
+
+// NewSongService creates a new instance of SongService. Songs are written
+// without a language when detector is nil, and only flagged explicit by
+// editors when scanner is nil. Lyrics are not checked for near duplicates
+// when checker is nil.
+func NewSongService(repo SongRepository, detector LanguageDetector, scanner ExplicitScanner, checker NearDuplicateChecker, observers ...SongObserver) *SongService {
 	return &SongService{
-		repo: repo,
+		repo:      repo,
+		detector:  detector,
+		scanner:   scanner,
+		checker:   checker,
+		observers: observers,
 	}
 }
 
@@ -38,27 +83,271 @@ func (s *SongService) GetSongs(ctx context.Context, filter map[string]string, pa
 	return s.repo.GetSongs(ctx, filter, page, pageSize)
 }
 
-// CreateSong creates a new song
+// CreateSong creates a new song, detecting the language of its text and
+// whether it is explicit, and checking it for near duplicates
 func (s *SongService) CreateSong(ctx context.Context, song *domain.Song) (*domain.Song, error) {
-	return s.repo.CreateSong(ctx, song)
+	warnings, err := s.checkLyrics(ctx, 0, song.Text)
+	if err != nil {
+		return nil, err
+	}
+	s.Analyze(song)
+	created, err := s.repo.CreateSong(ctx, song)
+	if err != nil {
+		return nil, err
+	}
+	s.notify(ctx, domain.SongEventCreated, created.ID, created)
+	created.NearDuplicates = warnings
+	return created, nil
 }
 
-// UpdateSong updates an existing song
+// UpdateSong updates an existing song, detecting the language of its text
+// and whether it is explicit, and checking it for near duplicates
 func (s *SongService) UpdateSong(ctx context.Context, id int, song *domain.Song) (*domain.Song, error) {
-	return s.repo.UpdateSong(ctx, id, song)
+	warnings, err := s.checkLyrics(ctx, id, song.Text)
+	if err != nil {
+		return nil, err
+	}
+	s.Analyze(song)
+	updated, err := s.repo.UpdateSong(ctx, id, song)
+	if err != nil {
+		return nil, err
+	}
+	s.notify(ctx, domain.SongEventUpdated, updated.ID, updated)
+	updated.NearDuplicates = warnings
+	return updated, nil
 }
 
-// PartialUpdateSong updates specific fields of a song
+// PartialUpdateSong updates specific fields of a song, detecting the
+// language of the text, unless given with it, whether it is explicit and
+// its near duplicates when it changes. Setting
+// explicit to true or false flags the song manually, setting it to nil
+// computes the flag again.
 func (s *SongService) PartialUpdateSong(ctx context.Context, id int, updates map[string]interface{}) (*domain.Song, error) {
-	return s.repo.PartialUpdateSong(ctx, id, updates)
+	explicit, setsExplicit := updates["explicit"]
+	switch explicit.(type) {
+	case bool:
+		updates["explicit_manual"] = true
+	case nil:
+		if setsExplicit {
+			updates["explicit_manual"] = false
+		}
+	default:
+		return nil, fmt.Errorf("%w: explicit must be a boolean or null", domain.ErrInvalidData)
+	}
+
+	var warnings []domain.NearDuplicate
+	if text, ok := updates["text"].(string); ok {
+		var err error
+		if warnings, err = s.checkLyrics(ctx, id, text); err != nil {
+			return nil, err
+		}
+	}
+
+	// A language given with the text, such as the tag of original lyrics,
+	// is known rather than guessed; only its script is detected
+	if lang, ok := updates["language"].(string); ok && lang != "" {
+		updates["language_confidence"] = 1.0
+		if text, ok := updates["text"].(string); ok && s.detector != nil {
+			updates["script"] = s.detector.Detect(text).Script
+		}
+	} else if text, ok := updates["text"].(string); ok && s.detector != nil {
+		detection := s.detector.Detect(text)
+		updates["language"] = detection.Language
+		updates["language_confidence"] = detection.Confidence
+		updates["script"] = detection.Script
+	}
+
+	_, setsText := updates["text"]
+	reset := setsExplicit && explicit == nil
+	if reset || setsText && !setsExplicit {
+		flag, ok, err := s.computedExplicit(ctx, id, updates, reset)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			updates["explicit"] = flag
+		}
+	}
+
+	updated, err := s.repo.PartialUpdateSong(ctx, id, updates)
+	if err != nil {
+		return nil, err
+	}
+	s.notify(ctx, domain.SongEventUpdated, updated.ID, updated)
+	updated.NearDuplicates = warnings
+	return updated, nil
+}
+
+// computedExplicit scans the song as partially updated by updates. ok is
+// false when the flag must be left alone, the song having been flagged by
+// an editor; reset overrides the editor's flag.
+func (s *SongService) computedExplicit(ctx context.Context, id int, updates map[string]interface{}, reset bool) (flag, ok bool, err error) {
+	if s.scanner == nil {
+		return false, reset, nil
+	}
+
+	current, err := s.repo.GetSong(ctx, id)
+	if err != nil {
+		return false, false, err
+	}
+	if current.ExplicitManual && !reset {
+		return false, false, nil
+	}
+
+	text, hasText := updates["text"].(string)
+	if !hasText {
+		text = current.Text
+	}
+	lang, hasLang := updates["language"].(string)
+	if !hasLang {
+		lang = current.Language
+	}
+	return s.scanner.Explicit(text, lang), true, nil
+}
+
+// MaskExplicit replaces the explicit words of lyrics in language lang
+func (s *SongService) MaskExplicit(text, lang string) string {
+	if s.scanner == nil {
+		return text
+	}
+	return s.scanner.Mask(text, lang)
 }
 
 // DeleteSong deletes a song by ID
 func (s *SongService) DeleteSong(ctx context.Context, id int) error {
-	return s.repo.DeleteSong(ctx, id)
+	if err := s.repo.DeleteSong(ctx, id); err != nil {
+		return err
+	}
+	s.notify(ctx, domain.SongEventDeleted, id, nil)
+	return nil
+}
+
+// checkLyrics checks the text of song id, 0 for a new song, for near duplicates
+func (s *SongService) checkLyrics(ctx context.Context, id int, text string) ([]domain.NearDuplicate, error) {
+	if s.checker == nil {
+		return nil, nil
+	}
+	return s.checker.CheckLyrics(ctx, id, text)
+}
+
+// notify tells the observers about a song written by the repository, song
+// being nil when it was deleted
+func (s *SongService) notify(ctx context.Context, eventType domain.SongEventType, id int, song *domain.Song) {
+	event := domain.SongEvent{Type: eventType, SongID: id, Song: song, Time: time.Now()}
+	for _, observer := range s.observers {
+		observer.SongChanged(ctx, event)
+	}
 }
 
 // GetSongVerses retrieves verses of a song with pagination
 func (s *SongService) GetSongVerses(ctx context.Context, id int, page, size int) ([]string, int, error) {
 	return s.repo.GetSongVerses(ctx, id, page, size)
 }
+
+// ExportSongs streams every song matching the filter to fn from a consistent snapshot
+func (s *SongService) ExportSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error {
+	return s.repo.StreamSongs(ctx, filter, fn)
+}
+
+// songSetter writes the fields a backfill changed on song before, giving
+// after
+type songSetter func(ctx context.Context, before, after *domain.Song) error
+
+// DetectLanguages detects the language of the songs that have none yet, or
+// of every song when all is set, batchSize songs at a time. It returns the
+// number of songs updated.
+func (s *SongService) DetectLanguages(ctx context.Context, all bool, batchSize int) (int, error) {
+	return s.detectLanguages(ctx, all, batchSize, s.setLanguage)
+}
+
+func (s *SongService) detectLanguages(ctx context.Context, all bool, batchSize int, set songSetter) (int, error) {
+	if s.detector == nil || batchSize <= 0 {
+		return 0, domain.ErrInvalidData
+	}
+
+	updated, afterID := 0, 0
+	for {
+		songs, err := s.repo.SongsWithoutLanguage(ctx, afterID, batchSize, all)
+		if err != nil {
+			return updated, err
+		}
+
+		for _, song := range songs {
+			detection := s.detector.Detect(song.Text)
+			after := *song
+			after.Language = detection.Language
+			after.LanguageConfidence = detection.Confidence
+			after.Script = detection.Script
+			if err := set(ctx, song, &after); err != nil {
+				return updated, err
+			}
+			updated++
+			afterID = song.ID
+		}
+
+		if len(songs) < batchSize {
+			return updated, nil
+		}
+	}
+}
+
+func (s *SongService) setLanguage(ctx context.Context, _, after *domain.Song) error {
+	return s.repo.SetSongLanguage(ctx, after.ID, after.Language, after.Script, after.LanguageConfidence)
+}
+
+// ScanExplicit computes again whether the songs not flagged by editors are
+// explicit, batchSize songs at a time, for instance after the wordlists
+// changed. It returns the number of songs whose flag changed.
+func (s *SongService) ScanExplicit(ctx context.Context, batchSize int) (int, error) {
+	return s.scanExplicit(ctx, batchSize, s.setExplicit)
+}
+
+func (s *SongService) scanExplicit(ctx context.Context, batchSize int, set songSetter) (int, error) {
+	if s.scanner == nil || batchSize <= 0 {
+		return 0, domain.ErrInvalidData
+	}
+
+	changed, afterID := 0, 0
+	for {
+		songs, err := s.repo.SongsWithComputedExplicit(ctx, afterID, batchSize)
+		if err != nil {
+			return changed, err
+		}
+
+		for _, song := range songs {
+			afterID = song.ID
+			explicit := s.scanner.Explicit(song.Text, song.Language)
+			if explicit == song.Explicit {
+				continue
+			}
+			after := *song
+			after.Explicit = explicit
+			if err := set(ctx, song, &after); err != nil {
+				return changed, err
+			}
+			changed++
+		}
+
+		if len(songs) < batchSize {
+			return changed, nil
+		}
+	}
+}
+
+func (s *SongService) setExplicit(ctx context.Context, _, after *domain.Song) error {
+	return s.repo.SetSongExplicit(ctx, after.ID, after.Explicit)
+}
+
+// Analyze sets the language of song from its text, then whether it is
+// explicit unless an editor decided
+func (s *SongService) Analyze(song *domain.Song) {
+	if s.detector != nil {
+		detection := s.detector.Detect(song.Text)
+		song.Language = detection.Language
+		song.LanguageConfidence = detection.Confidence
+		song.Script = detection.Script
+	}
+	if s.scanner != nil && !song.ExplicitManual {
+		song.Explicit = s.scanner.Explicit(song.Text, song.Language)
+	}
+}
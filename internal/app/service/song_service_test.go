@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"songs/internal/app/domain"
+	"songs/internal/pkg/langdetect"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -55,9 +57,65 @@ func (m *MockSongRepo) GetSongVerses(ctx context.Context, id int, page, size int
 	return args.Get(0).([]string), args.Get(1).(int), args.Error(2)
 }
 
+func (m *MockSongRepo) StreamSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error {
+	args := m.Called(ctx, filter, fn)
+	if songs, ok := args.Get(0).([]*domain.Song); ok {
+		for _, song := range songs {
+			if err := fn(song); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockSongRepo) SongsWithoutLanguage(ctx context.Context, afterID, limit int, all bool) ([]*domain.Song, error) {
+	args := m.Called(ctx, afterID, limit, all)
+	songs, _ := args.Get(0).([]*domain.Song)
+	return songs, args.Error(1)
+}
+
+func (m *MockSongRepo) SetSongLanguage(ctx context.Context, id int, lang, script string, confidence float64) error {
+	args := m.Called(ctx, id, lang, script, confidence)
+	return args.Error(0)
+}
+
+func (m *MockSongRepo) SongsWithComputedExplicit(ctx context.Context, afterID, limit int) ([]*domain.Song, error) {
+	args := m.Called(ctx, afterID, limit)
+	songs, _ := args.Get(0).([]*domain.Song)
+	return songs, args.Error(1)
+}
+
+func (m *MockSongRepo) SetSongExplicit(ctx context.Context, id int, explicit bool) error {
+	args := m.Called(ctx, id, explicit)
+	return args.Error(0)
+}
+
+// fakeScanner flags the word "damn" in English and "merde" in any language
+type fakeScanner struct{}
+
+func (fakeScanner) Explicit(text, lang string) bool {
+	return strings.Contains(text, "merde") || lang == "en" && strings.Contains(text, "damn")
+}
+
+func (fakeScanner) Mask(text, lang string) string {
+	text = strings.ReplaceAll(text, "merde", "*****")
+	if lang == "en" {
+		text = strings.ReplaceAll(text, "damn", "****")
+	}
+	return text
+}
+
+// fakeDetector detects the languages it is given by text
+type fakeDetector map[string]langdetect.Detection
+
+func (d fakeDetector) Detect(text string) langdetect.Detection {
+	return d[text]
+}
+
 func TestGetSong(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	expectedSong := &domain.Song{
@@ -79,7 +137,7 @@ func TestGetSong(t *testing.T) {
 
 func TestGetSongs(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	filter := map[string]string{"title": "Test"}
@@ -110,7 +168,7 @@ func TestGetSongs(t *testing.T) {
 
 func TestCreateSong(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	newSong := &domain.Song{
@@ -141,7 +199,7 @@ func TestCreateSong(t *testing.T) {
 
 func TestUpdateSong(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	songID := 1
@@ -165,7 +223,7 @@ func TestUpdateSong(t *testing.T) {
 
 func TestPartialUpdateSong(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	songID := 1
@@ -191,7 +249,7 @@ func TestPartialUpdateSong(t *testing.T) {
 
 func TestDeleteSong(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	songID := 1
@@ -206,7 +264,7 @@ func TestDeleteSong(t *testing.T) {
 
 func TestGetSongVerses(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	songID := 1
@@ -232,7 +290,7 @@ func TestGetSongVerses(t *testing.T) {
 // Error cases
 func TestGetSong_Error(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	songID := 999
@@ -248,7 +306,7 @@ func TestGetSong_Error(t *testing.T) {
 
 func TestDeleteSong_Error(t *testing.T) {
 	mockRepo := new(MockSongRepo)
-	service := NewSongService(mockRepo)
+	service := NewSongService(mockRepo, nil, nil, nil)
 
 	ctx := context.Background()
 	songID := 999
@@ -260,3 +318,184 @@ func TestDeleteSong_Error(t *testing.T) {
 	assert.Error(t, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestCreateSong_DetectsLanguage(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	service := NewSongService(mockRepo, fakeDetector{
+		"Hola mundo": {Language: "es", Script: "Latn", Confidence: 0.8},
+	}, nil, nil)
+
+	ctx := context.Background()
+	song := &domain.Song{GroupID: 1, Title: "Hola", Text: "Hola mundo"}
+	mockRepo.On("CreateSong", ctx, mock.MatchedBy(func(s *domain.Song) bool {
+		return s.Language == "es" && s.Script == "Latn" && s.LanguageConfidence == 0.8
+	})).Return(song, nil)
+
+	_, err := service.CreateSong(ctx, song)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPartialUpdateSong_DetectsLanguageOfText(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	service := NewSongService(mockRepo, fakeDetector{
+		"Bonjour": {Language: "fr", Script: "Latn", Confidence: 0.5},
+	}, nil, nil)
+
+	ctx := context.Background()
+	mockRepo.On("PartialUpdateSong", ctx, 1, map[string]interface{}{
+		"text":                "Bonjour",
+		"language":            "fr",
+		"language_confidence": 0.5,
+		"script":              "Latn",
+	}).Return(&domain.Song{ID: 1}, nil).Once()
+	mockRepo.On("PartialUpdateSong", ctx, 1, map[string]interface{}{"title": "Salut"}).Return(&domain.Song{ID: 1}, nil).Once()
+
+	_, err := service.PartialUpdateSong(ctx, 1, map[string]interface{}{"text": "Bonjour"})
+	assert.NoError(t, err)
+	_, err = service.PartialUpdateSong(ctx, 1, map[string]interface{}{"title": "Salut"})
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDetectLanguages(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	service := NewSongService(mockRepo, fakeDetector{
+		"Hello world": {Language: "en", Script: "Latn", Confidence: 0.9},
+		"Привет":      {Script: "Cyrl"},
+	}, nil, nil)
+
+	ctx := context.Background()
+	mockRepo.On("SongsWithoutLanguage", ctx, 0, 2, false).Return([]*domain.Song{
+		{ID: 3, Text: "Hello world"}, {ID: 5, Text: "Привет"},
+	}, nil)
+	mockRepo.On("SongsWithoutLanguage", ctx, 5, 2, false).Return([]*domain.Song{}, nil)
+	mockRepo.On("SetSongLanguage", ctx, 3, "en", "Latn", 0.9).Return(nil)
+	mockRepo.On("SetSongLanguage", ctx, 5, "", "Cyrl", 0.0).Return(nil)
+
+	updated, err := service.DetectLanguages(ctx, false, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, updated)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDetectLanguages_Error(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	service := NewSongService(mockRepo, fakeDetector{}, nil, nil)
+
+	ctx := context.Background()
+	mockRepo.On("SongsWithoutLanguage", ctx, 0, 10, true).Return([]*domain.Song{{ID: 1}}, nil)
+	mockRepo.On("SetSongLanguage", ctx, 1, "", "", 0.0).Return(domain.ErrDatabase)
+
+	updated, err := service.DetectLanguages(ctx, true, 10)
+
+	assert.ErrorIs(t, err, domain.ErrDatabase)
+	assert.Equal(t, 0, updated)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateSong_FlagsExplicit(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	service := NewSongService(mockRepo, fakeDetector{"Oh damn": {Language: "en"}}, fakeScanner{}, nil)
+
+	ctx := context.Background()
+	mockRepo.On("CreateSong", ctx, mock.Anything).Return(&domain.Song{}, nil)
+
+	computed := &domain.Song{Text: "Oh damn"}
+	_, err := service.CreateSong(ctx, computed)
+	assert.NoError(t, err)
+	assert.True(t, computed.Explicit)
+
+	// Editors have the last word
+	manual := &domain.Song{Text: "Oh damn", ExplicitManual: true}
+	_, err = service.CreateSong(ctx, manual)
+	assert.NoError(t, err)
+	assert.False(t, manual.Explicit)
+}
+
+func TestPartialUpdateSong_Explicit(t *testing.T) {
+	tests := []struct {
+		name    string
+		current *domain.Song
+		updates map[string]interface{}
+		want    map[string]interface{}
+		wantErr error
+	}{
+		{
+			name:    "set by editor",
+			updates: map[string]interface{}{"explicit": true},
+			want:    map[string]interface{}{"explicit": true, "explicit_manual": true},
+		},
+		{
+			name:    "reset to computed",
+			current: &domain.Song{ID: 1, Text: "merde", ExplicitManual: true},
+			updates: map[string]interface{}{"explicit": nil},
+			want:    map[string]interface{}{"explicit": true, "explicit_manual": false},
+		},
+		{
+			name:    "text of computed song",
+			current: &domain.Song{ID: 1, Language: "en"},
+			updates: map[string]interface{}{"text": "damn"},
+			want:    map[string]interface{}{"text": "damn", "explicit": true},
+		},
+		{
+			name:    "text of flagged song",
+			current: &domain.Song{ID: 1, Language: "en", ExplicitManual: true},
+			updates: map[string]interface{}{"text": "damn"},
+			want:    map[string]interface{}{"text": "damn"},
+		},
+		{
+			name:    "invalid",
+			updates: map[string]interface{}{"explicit": "yes"},
+			wantErr: domain.ErrInvalidData,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := new(MockSongRepo)
+			service := NewSongService(mockRepo, nil, fakeScanner{}, nil)
+
+			if tt.current != nil {
+				mockRepo.On("GetSong", ctx, 1).Return(tt.current, nil)
+			}
+			if tt.want != nil {
+				mockRepo.On("PartialUpdateSong", ctx, 1, tt.want).Return(&domain.Song{ID: 1}, nil)
+			}
+
+			_, err := service.PartialUpdateSong(ctx, 1, tt.updates)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScanExplicit(t *testing.T) {
+	mockRepo := new(MockSongRepo)
+	service := NewSongService(mockRepo, nil, fakeScanner{}, nil)
+
+	ctx := context.Background()
+	mockRepo.On("SongsWithComputedExplicit", ctx, 0, 2).Return([]*domain.Song{
+		{ID: 1, Text: "merde"}, {ID: 2, Text: "merde", Explicit: true},
+	}, nil)
+	mockRepo.On("SongsWithComputedExplicit", ctx, 2, 2).Return([]*domain.Song{
+		{ID: 4, Text: "clean", Explicit: true},
+	}, nil)
+	mockRepo.On("SetSongExplicit", ctx, 1, true).Return(nil)
+	mockRepo.On("SetSongExplicit", ctx, 4, false).Return(nil)
+
+	changed, err := service.ScanExplicit(ctx, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, changed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMaskExplicit(t *testing.T) {
+	assert.Equal(t, "Oh *****", NewSongService(nil, nil, fakeScanner{}, nil).MaskExplicit("Oh merde", "fr"))
+	assert.Equal(t, "Oh merde", NewSongService(nil, nil, nil, nil).MaskExplicit("Oh merde", "fr"))
+}
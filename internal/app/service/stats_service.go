@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+// StatsService describes the content of lyrics
+type StatsService struct {
+	songs  StatsSongRepository
+	groups GroupReader
+}
+
+// StatsSongRepository defines the song repository operations statistics need
+type StatsSongRepository interface {
+	GetSong(ctx context.Context, id int) (*domain.Song, error)
+	StreamSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error
+}
+
+// GroupReader reads single groups
+type GroupReader interface {
+	GetGroup(ctx context.Context, id int) (*domain.SongGroup, error)
+}
+
+// NewStatsService creates a new instance of StatsService
+func NewStatsService(songs StatsSongRepository, groups GroupReader) *StatsService {
+	return &StatsService{
+		songs:  songs,
+		groups: groups,
+	}
+}
+
+// SongStats returns the statistics of the lyrics of a song with its top
+// most frequent words
+func (s *StatsService) SongStats(ctx context.Context, songID, top int) (*domain.LyricsStats, error) {
+	song, err := s.songs.GetSong(ctx, songID)
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+		return nil, domain.ErrSongNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stats := domain.ComputeLyricsStats(song.Text, song.Language, top)
+	return &stats, nil
+}
+
+// GroupStats returns the statistics of the lyrics of every song of a group
+// taken together, with their top most frequent words
+func (s *StatsService) GroupStats(ctx context.Context, groupID, top int) (*domain.GroupStats, error) {
+	if _, err := s.groups.GetGroup(ctx, groupID); err != nil {
+		if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+			return nil, domain.ErrGroupNotFound
+		}
+		return nil, err
+	}
+
+	tally := domain.NewLyricsTally()
+	songs := 0
+	err := s.songs.StreamSongs(ctx, map[string]string{"group_id": strconv.Itoa(groupID)}, func(song *domain.Song) error {
+		tally.Add(song.Text, song.Language)
+		songs++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.GroupStats{GroupID: groupID, Songs: songs, LyricsStats: tally.Stats(top)}
+	if songs > 0 {
+		stats.AverageWords = float64(stats.Words) / float64(songs)
+	}
+	return stats, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const heyJude = "Hey Jude, don't make it bad\nTake a sad song and make it better\n\n" +
+	"Remember to let her into your heart\nThen you can start to make it better\n\n\n" +
+	"Na na na, na-na-na na"
+
+func TestSongStats(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := NewStatsService(songs, new(MockGroupRepo))
+
+	songs.On("GetSong", ctx, 1).Return(&domain.Song{ID: 1, Text: heyJude, Language: "en"}, nil)
+
+	stats, err := service.SongStats(ctx, 1, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, stats.Lines)
+	assert.Equal(t, 36, stats.Words)
+	assert.Equal(t, 3, stats.Verses, "verses are split like GetSongVerses does")
+	_, total := domain.PageVerses(heyJude, 1, 1)
+	assert.Equal(t, total, stats.Verses)
+	assert.Equal(t, 24, stats.UniqueWords)
+	assert.InDelta(t, 24.0/36.0, stats.UniqueWordRatio, 1e-9)
+	assert.Equal(t, []domain.WordCount{{Word: "na", Count: 7}, {Word: "make", Count: 3}, {Word: "better", Count: 2}}, stats.TopWords)
+	assert.Equal(t, 11*time.Second, stats.ReadingTime)
+	assert.Equal(t, 18*time.Second, stats.SingingTime)
+	assert.Greater(t, stats.Characters, stats.CharactersNoSpaces)
+}
+
+func TestSongStats_NotFound(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	service := NewStatsService(songs, new(MockGroupRepo))
+
+	songs.On("GetSong", ctx, 9).Return(nil, domain.ErrNotFound)
+
+	_, err := service.SongStats(ctx, 9, 10)
+	assert.ErrorIs(t, err, domain.ErrSongNotFound)
+}
+
+func TestGroupStats(t *testing.T) {
+	ctx := context.Background()
+	songs := new(MockSongRepo)
+	groups := new(MockGroupRepo)
+	service := NewStatsService(songs, groups)
+
+	groups.On("GetGroup", ctx, 4).Return(&domain.SongGroup{ID: 4}, nil)
+	songs.On("StreamSongs", ctx, map[string]string{"group_id": "4"}, mock.Anything).Return([]*domain.Song{
+		{ID: 1, Text: "Love me do\nYou know I love you", Language: "en"},
+		{ID: 2, Text: "All you need is love", Language: "en"},
+	}, nil)
+
+	stats, err := service.GroupStats(ctx, 4, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, stats.GroupID)
+	assert.Equal(t, 2, stats.Songs)
+	assert.Equal(t, 3, stats.Lines)
+	assert.Equal(t, 13, stats.Words)
+	assert.Equal(t, 2, stats.Verses)
+	assert.Equal(t, 6.5, stats.AverageWords)
+	assert.Equal(t, []domain.WordCount{{Word: "love", Count: 3}}, stats.TopWords)
+}
+
+func TestGroupStats_NotFound(t *testing.T) {
+	ctx := context.Background()
+	groups := new(MockGroupRepo)
+	service := NewStatsService(new(MockSongRepo), groups)
+
+	groups.On("GetGroup", ctx, 4).Return(nil, domain.ErrNotFound)
+
+	_, err := service.GroupStats(ctx, 4, 10)
+	assert.ErrorIs(t, err, domain.ErrGroupNotFound)
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"songs/internal/app/domain"
+)
+
+// TagService manages the free-form tags of songs
+type TagService struct {
+	repo TagRepository
+	tx   Transactor
+}
+
+// TagRepository defines the interface for tag repository operations
+type TagRepository interface {
+	ExistingSongIDs(ctx context.Context, ids []int) ([]int, error)
+	TagSongs(ctx context.Context, songIDs []int, tags []string) (int64, error)
+	UntagSongs(ctx context.Context, songIDs []int, tags []string) (int64, error)
+	SongTags(ctx context.Context, songID int) ([]string, error)
+	TagCloud(ctx context.Context, limit int) ([]domain.TagCount, error)
+}
+
+// NewTagService creates a new instance of TagService
+func NewTagService(repo TagRepository, tx Transactor) *TagService {
+	return &TagService{
+		repo: repo,
+		tx:   tx,
+	}
+}
+
+// TagSongs adds the tags to every existing song of songIDs. Songs that do
+// not exist are reported as missing.
+func (s *TagService) TagSongs(ctx context.Context, songIDs []int, tags []string) (*domain.TagChange, error) {
+	return s.change(ctx, songIDs, tags, s.repo.TagSongs)
+}
+
+// UntagSongs removes the tags from every existing song of songIDs. Songs
+// that do not exist are reported as missing.
+func (s *TagService) UntagSongs(ctx context.Context, songIDs []int, tags []string) (*domain.TagChange, error) {
+	return s.change(ctx, songIDs, tags, s.repo.UntagSongs)
+}
+
+// SongTags retrieves the tags of a song in alphabetical order
+func (s *TagService) SongTags(ctx context.Context, songID int) ([]string, error) {
+	existing, err := s.repo.ExistingSongIDs(ctx, []int{songID})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, domain.ErrSongNotFound
+	}
+	return s.repo.SongTags(ctx, songID)
+}
+
+// TagCloud retrieves the limit most used tags with their number of songs
+func (s *TagService) TagCloud(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	return s.repo.TagCloud(ctx, limit)
+}
+
+func (s *TagService) change(ctx context.Context, songIDs []int, tags []string, apply func(ctx context.Context, songIDs []int, tags []string) (int64, error)) (*domain.TagChange, error) {
+	if len(songIDs) == 0 || len(tags) == 0 {
+		return nil, domain.ErrRequired
+	}
+	if len(songIDs) > domain.MaxBatchSize || len(tags) > domain.MaxTagsPerRequest {
+		return nil, domain.ErrBatchTooLarge
+	}
+
+	tags, err := domain.NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(songIDs))
+	seen := make(map[int]bool, len(songIDs))
+	for _, id := range songIDs {
+		if id <= 0 {
+			return nil, domain.ErrInvalidID
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	change := &domain.TagChange{Tags: tags, SongIDs: []int{}, MissingIDs: []int{}}
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		existing, err := s.repo.ExistingSongIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		found := make(map[int]bool, len(existing))
+		for _, id := range existing {
+			found[id] = true
+		}
+		for _, id := range ids {
+			if found[id] {
+				change.SongIDs = append(change.SongIDs, id)
+			} else {
+				change.MissingIDs = append(change.MissingIDs, id)
+			}
+		}
+		if len(change.SongIDs) == 0 {
+			return nil
+		}
+
+		change.Changed, err = apply(ctx, change.SongIDs, tags)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTagRepo is a mock implementation of TagRepository
+type MockTagRepo struct {
+	mock.Mock
+}
+
+func (m *MockTagRepo) ExistingSongIDs(ctx context.Context, ids []int) ([]int, error) {
+	args := m.Called(ctx, ids)
+	found, _ := args.Get(0).([]int)
+	return found, args.Error(1)
+}
+
+func (m *MockTagRepo) TagSongs(ctx context.Context, songIDs []int, tags []string) (int64, error) {
+	args := m.Called(ctx, songIDs, tags)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTagRepo) UntagSongs(ctx context.Context, songIDs []int, tags []string) (int64, error) {
+	args := m.Called(ctx, songIDs, tags)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTagRepo) SongTags(ctx context.Context, songID int) ([]string, error) {
+	args := m.Called(ctx, songID)
+	tags, _ := args.Get(0).([]string)
+	return tags, args.Error(1)
+}
+
+func (m *MockTagRepo) TagCloud(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	args := m.Called(ctx, limit)
+	counts, _ := args.Get(0).([]domain.TagCount)
+	return counts, args.Error(1)
+}
+
+func TestTagSongs(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockTagRepo)
+	tx := &fakeTransactor{}
+	service := NewTagService(repo, tx)
+
+	repo.On("ExistingSongIDs", ctx, []int{3, 1, 9}).Return([]int{1, 3}, nil)
+	repo.On("TagSongs", ctx, []int{3, 1}, []string{"road trip", "90s"}).Return(int64(3), nil)
+
+	change, err := service.TagSongs(ctx, []int{3, 1, 3, 9}, []string{" Road   Trip", "90s", "road trip"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"road trip", "90s"}, change.Tags)
+	assert.Equal(t, []int{3, 1}, change.SongIDs)
+	assert.Equal(t, []int{9}, change.MissingIDs)
+	assert.Equal(t, int64(3), change.Changed)
+	assert.Equal(t, 1, tx.calls)
+	repo.AssertExpectations(t)
+}
+
+func TestUntagSongs_NoSongFound(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockTagRepo)
+	service := NewTagService(repo, &fakeTransactor{})
+
+	repo.On("ExistingSongIDs", ctx, []int{9}).Return([]int{}, nil)
+
+	change, err := service.UntagSongs(ctx, []int{9}, []string{"live"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int{9}, change.MissingIDs)
+	assert.Zero(t, change.Changed)
+	repo.AssertNotCalled(t, "UntagSongs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTagSongs_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		songIDs []int
+		tags    []string
+		wantErr error
+	}{
+		{name: "no songs", tags: []string{"live"}, wantErr: domain.ErrRequired},
+		{name: "no tags", songIDs: []int{1}, wantErr: domain.ErrRequired},
+		{name: "blank tag", songIDs: []int{1}, tags: []string{"  "}, wantErr: domain.ErrInvalidTag},
+		{name: "comma", songIDs: []int{1}, tags: []string{"rock,pop"}, wantErr: domain.ErrInvalidTag},
+		{name: "too long", songIDs: []int{1}, tags: []string{strings.Repeat("a", domain.MaxTagLength+1)}, wantErr: domain.ErrInvalidTag},
+		{name: "too many songs", songIDs: make([]int, domain.MaxBatchSize+1), tags: []string{"live"}, wantErr: domain.ErrBatchTooLarge},
+		{name: "invalid song ID", songIDs: []int{0}, tags: []string{"live"}, wantErr: domain.ErrInvalidID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTransactor{}
+			service := NewTagService(new(MockTagRepo), tx)
+
+			_, err := service.TagSongs(context.Background(), tt.songIDs, tt.tags)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 0, tx.calls)
+		})
+	}
+}
+
+func TestSongTags_UnknownSong(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockTagRepo)
+	service := NewTagService(repo, &fakeTransactor{})
+
+	repo.On("ExistingSongIDs", ctx, []int{9}).Return([]int{}, nil)
+
+	_, err := service.SongTags(ctx, 9)
+
+	assert.ErrorIs(t, err, domain.ErrSongNotFound)
+}
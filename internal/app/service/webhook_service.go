@@ -0,0 +1,280 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"songs/internal/app/domain"
+	"songs/internal/pkg/webhook"
+	"strconv"
+	"time"
+)
+
+const (
+	// webhookSecretPrefix marks the signing secrets generated for subscriptions
+	webhookSecretPrefix = "whsec_"
+	// webhookSecretBytes is the amount of randomness in a generated secret
+	webhookSecretBytes = 24
+	// webhookBatchSize is the number of deliveries claimed at a time
+	webhookBatchSize = 20
+	// webhookLease is how long a worker has to post the deliveries it
+	// claimed before another may claim them again. It must exceed the
+	// timeout of the HTTP client times the batch size.
+	webhookLease = 5 * time.Minute
+	// webhookMinBackoff and webhookMaxBackoff bound the delay before a
+	// failed delivery is tried again, doubling with every attempt
+	webhookMinBackoff = 30 * time.Second
+	webhookMaxBackoff = time.Hour
+	// webhookDeliveriesLimit is the most deliveries listed at a time
+	webhookDeliveriesLimit = 100
+)
+
+// errWebhookInactive fails the deliveries of deactivated subscriptions
+var errWebhookInactive = errors.New("subscription is inactive")
+
+// WebhookService manages the webhook subscriptions of partners and posts
+// them the song events they subscribed to. It is an outbox sink: every
+// event relayed by the outbox becomes a delivery per subscription, posted
+// by Run with an HMAC-SHA256 signature and retried with an exponential
+// backoff up to domain.MaxWebhookAttempts times. Deliveries are at least
+// once and not ordered, so receivers should ignore the Webhook-ID they
+// already handled.
+type WebhookService struct {
+	repo      WebhookRepository
+	client    *http.Client
+	retention time.Duration
+	now       func() time.Time
+}
+
+// WebhookRepository defines the interface for webhook storage
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	GetWebhook(ctx context.Context, id int) (*domain.WebhookSubscription, error)
+	ListWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error)
+	UpdateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id int) error
+	EnqueueWebhookDeliveries(ctx context.Context, event domain.OutboxEvent, payload []byte, now time.Time) (int64, error)
+	ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]domain.WebhookDelivery, error)
+	RecordWebhookAttempt(ctx context.Context, id int64, attempt domain.WebhookAttempt, status domain.WebhookDeliveryStatus, nextAttemptAt time.Time) error
+	ListWebhookDeliveries(ctx context.Context, subscriptionID int, status domain.WebhookDeliveryStatus, limit int) ([]domain.WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, subscriptionID int, id int64) (*domain.WebhookDelivery, error)
+	RedeliverWebhookDelivery(ctx context.Context, subscriptionID int, id int64, now time.Time) error
+	DeleteWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// NewWebhookService creates a new instance of WebhookService posting with
+// client. Deliveries are kept for retention once done.
+func NewWebhookService(repo WebhookRepository, client *http.Client, retention time.Duration) *WebhookService {
+	return &WebhookService{
+		repo:      repo,
+		client:    client,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// CreateWebhook subscribes a URL to song events. A secret is generated
+// when none is given; it is returned here only, with the subscription.
+func (s *WebhookService) CreateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+	if subscription.Secret == "" {
+		buf := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("generate webhook secret: %w", err)
+		}
+		subscription.Secret = webhookSecretPrefix + hex.EncodeToString(buf)
+	}
+
+	subscription.CreatedAt = s.now()
+	subscription.UpdatedAt = subscription.CreatedAt
+	return s.repo.CreateWebhook(ctx, subscription)
+}
+
+// GetWebhook returns a subscription by ID
+func (s *WebhookService) GetWebhook(ctx context.Context, id int) (*domain.WebhookSubscription, error) {
+	return s.repo.GetWebhook(ctx, id)
+}
+
+// ListWebhooks returns all subscriptions
+func (s *WebhookService) ListWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	return s.repo.ListWebhooks(ctx)
+}
+
+// UpdateWebhook replaces the URL, event types and active flag of a
+// subscription, and rotates its secret when one is given. Deactivated
+// subscriptions stop receiving events.
+func (s *WebhookService) UpdateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	subscription.UpdatedAt = s.now()
+	return s.repo.UpdateWebhook(ctx, subscription)
+}
+
+// DeleteWebhook removes a subscription and its deliveries
+func (s *WebhookService) DeleteWebhook(ctx context.Context, id int) error {
+	return s.repo.DeleteWebhook(ctx, id)
+}
+
+func (s *WebhookService) Name() string { return "webhooks" }
+
+// Deliver schedules the delivery of the message of an outbox event to the
+// subscriptions to its type. Deliveries are posted by Run, so a partner
+// failing does not hold the outbox back.
+func (s *WebhookService) Deliver(ctx context.Context, event domain.OutboxEvent, message []byte) error {
+	_, err := s.repo.EnqueueWebhookDeliveries(ctx, event, message, s.now())
+	return err
+}
+
+// Run posts the deliveries due every interval until ctx is done
+func (s *WebhookService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("webhooks: deliver: %v", err)
+			}
+		}
+	}
+}
+
+// DeliverDue posts the deliveries due until none is left and returns how
+// many succeeded. Failed deliveries are scheduled again with a backoff, or
+// given up once out of attempts.
+func (s *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	delivered := 0
+	subscriptions := map[int]*domain.WebhookSubscription{}
+	for {
+		now := s.now()
+		deliveries, err := s.repo.ClaimWebhookDeliveries(ctx, now, now.Add(webhookLease), webhookBatchSize)
+		if err != nil {
+			return delivered, err
+		}
+		if len(deliveries) == 0 {
+			return delivered, nil
+		}
+
+		for _, delivery := range deliveries {
+			subscription, ok := subscriptions[delivery.SubscriptionID]
+			if !ok {
+				subscription, err = s.repo.GetWebhook(ctx, delivery.SubscriptionID)
+				if errors.Is(err, domain.ErrWebhookNotFound) {
+					// Deleted since claimed, along with the delivery
+					continue
+				}
+				if err != nil {
+					return delivered, err
+				}
+				subscriptions[delivery.SubscriptionID] = subscription
+			}
+
+			attempt := s.post(ctx, subscription, delivery)
+			status := domain.WebhookDeliveryDelivered
+			next := attempt.At
+			if attempt.Error != "" {
+				status = domain.WebhookDeliveryPending
+				next = s.now().Add(webhookBackoff(delivery.Attempts))
+				if !subscription.Active || delivery.Attempts >= domain.MaxWebhookAttempts {
+					status = domain.WebhookDeliveryFailed
+				}
+			}
+			if err := s.repo.RecordWebhookAttempt(ctx, delivery.ID, attempt, status, next); err != nil {
+				return delivered, err
+			}
+			if status == domain.WebhookDeliveryDelivered {
+				delivered++
+			}
+		}
+	}
+}
+
+// post sends a delivery to the URL of its subscription. The body is the
+// outbox message of the event, signed with the secret of the subscription;
+// the Webhook-ID and Webhook-Event headers carry the event ID and type.
+// Any status other than 2xx fails the attempt.
+func (s *WebhookService) post(ctx context.Context, subscription *domain.WebhookSubscription, delivery domain.WebhookDelivery) domain.WebhookAttempt {
+	attempt := domain.WebhookAttempt{At: s.now()}
+	if !subscription.Active {
+		attempt.Error = errWebhookInactive.Error()
+		return attempt
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Webhook-ID", strconv.FormatInt(delivery.EventID, 10))
+	req.Header.Set("Webhook-Event", string(delivery.EventType))
+	webhook.SignRequest(req, subscription.Secret, delivery.Payload, attempt.At)
+
+	resp, err := s.client.Do(req)
+	attempt.Duration = s.now().Sub(attempt.At)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	attempt.ResponseCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		attempt.Error = fmt.Sprintf("responded %d", resp.StatusCode)
+	}
+	return attempt
+}
+
+// webhookBackoff is the delay before the next attempt after the given
+// number of failed ones
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookMinBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, webhookMaxBackoff)
+}
+
+// ListDeliveries returns the latest deliveries of a subscription, newest
+// first, only those with the given status unless empty
+func (s *WebhookService) ListDeliveries(ctx context.Context, subscriptionID int, status domain.WebhookDeliveryStatus) ([]domain.WebhookDelivery, error) {
+	switch status {
+	case "", domain.WebhookDeliveryPending, domain.WebhookDeliveryDelivered, domain.WebhookDeliveryFailed:
+	default:
+		return nil, fmt.Errorf("%w: status must be pending, delivered or failed", domain.ErrInvalidData)
+	}
+	if _, err := s.repo.GetWebhook(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListWebhookDeliveries(ctx, subscriptionID, status, webhookDeliveriesLimit)
+}
+
+// GetDelivery returns a delivery of a subscription with the log of its attempts
+func (s *WebhookService) GetDelivery(ctx context.Context, subscriptionID int, id int64) (*domain.WebhookDelivery, error) {
+	return s.repo.GetWebhookDelivery(ctx, subscriptionID, id)
+}
+
+// Redeliver posts a delivery again as soon as possible, with a fresh count
+// of attempts, whether it failed or was delivered already
+func (s *WebhookService) Redeliver(ctx context.Context, subscriptionID int, id int64) error {
+	return s.repo.RedeliverWebhookDelivery(ctx, subscriptionID, id, s.now())
+}
+
+// PurgeDeliveries removes the deliveries done, delivered or failed, created
+// longer than the retention ago and returns how many were removed
+func (s *WebhookService) PurgeDeliveries(ctx context.Context) (int64, error) {
+	return s.repo.DeleteWebhookDeliveriesBefore(ctx, s.now().Add(-s.retention))
+}
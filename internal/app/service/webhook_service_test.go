@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+	"songs/internal/pkg/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWebhookRepo keeps subscriptions and deliveries in memory
+type fakeWebhookRepo struct {
+	subscriptions map[int]*domain.WebhookSubscription
+	deliveries    []*domain.WebhookDelivery
+}
+
+func newFakeWebhookRepo() *fakeWebhookRepo {
+	return &fakeWebhookRepo{subscriptions: map[int]*domain.WebhookSubscription{}}
+}
+
+func (r *fakeWebhookRepo) CreateWebhook(_ context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	subscription.ID = len(r.subscriptions) + 1
+	r.subscriptions[subscription.ID] = &subscription
+	return &subscription, nil
+}
+
+func (r *fakeWebhookRepo) GetWebhook(_ context.Context, id int) (*domain.WebhookSubscription, error) {
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return subscription, nil
+}
+
+func (r *fakeWebhookRepo) ListWebhooks(context.Context) ([]*domain.WebhookSubscription, error) {
+	return nil, nil
+}
+
+func (r *fakeWebhookRepo) UpdateWebhook(_ context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	r.subscriptions[subscription.ID] = &subscription
+	return &subscription, nil
+}
+
+func (r *fakeWebhookRepo) DeleteWebhook(_ context.Context, id int) error {
+	delete(r.subscriptions, id)
+	return nil
+}
+
+func (r *fakeWebhookRepo) EnqueueWebhookDeliveries(_ context.Context, event domain.OutboxEvent, payload []byte, now time.Time) (int64, error) {
+	var count int64
+	for id := 1; id <= len(r.subscriptions); id++ {
+		subscription, ok := r.subscriptions[id]
+		if !ok || !subscription.Wants(event.Type) {
+			continue
+		}
+		r.deliveries = append(r.deliveries, &domain.WebhookDelivery{
+			ID:             int64(len(r.deliveries) + 1),
+			SubscriptionID: id,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		})
+		count++
+	}
+	return count, nil
+}
+
+func (r *fakeWebhookRepo) ClaimWebhookDeliveries(_ context.Context, now, leaseUntil time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var claimed []domain.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == domain.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) && len(claimed) < limit {
+			delivery.Attempts++
+			delivery.NextAttemptAt = leaseUntil
+			claimed = append(claimed, *delivery)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *fakeWebhookRepo) RecordWebhookAttempt(_ context.Context, id int64, attempt domain.WebhookAttempt, status domain.WebhookDeliveryStatus, nextAttemptAt time.Time) error {
+	delivery := r.deliveries[id-1]
+	delivery.Log = append(delivery.Log, attempt)
+	delivery.Status = status
+	delivery.NextAttemptAt = nextAttemptAt
+	delivery.ResponseCode = attempt.ResponseCode
+	delivery.LastError = attempt.Error
+	return nil
+}
+
+func (r *fakeWebhookRepo) ListWebhookDeliveries(context.Context, int, domain.WebhookDeliveryStatus, int) ([]domain.WebhookDelivery, error) {
+	return nil, nil
+}
+
+func (r *fakeWebhookRepo) GetWebhookDelivery(_ context.Context, subscriptionID int, id int64) (*domain.WebhookDelivery, error) {
+	if id < 1 || int(id) > len(r.deliveries) || r.deliveries[id-1].SubscriptionID != subscriptionID {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	return r.deliveries[id-1], nil
+}
+
+func (r *fakeWebhookRepo) RedeliverWebhookDelivery(ctx context.Context, subscriptionID int, id int64, now time.Time) error {
+	delivery, err := r.GetWebhookDelivery(ctx, subscriptionID, id)
+	if err != nil {
+		return err
+	}
+	delivery.Status = domain.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	return nil
+}
+
+func (r *fakeWebhookRepo) DeleteWebhookDeliveriesBefore(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+// webhookReceiver is a partner endpoint checking the signature of the
+// requests it receives
+type webhookReceiver struct {
+	*httptest.Server
+	status   int
+	requests []*http.Request
+	bodies   []string
+	errs     []error
+}
+
+func newWebhookReceiver(t *testing.T, secret string, now func() time.Time) *webhookReceiver {
+	receiver := &webhookReceiver{status: http.StatusOK}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.requests = append(receiver.requests, r)
+		receiver.bodies = append(receiver.bodies, string(body))
+		receiver.errs = append(receiver.errs, webhook.Verify(secret, r.Header, body, now()))
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func newTestWebhookService(repo *fakeWebhookRepo, client *http.Client) (*WebhookService, *time.Time) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	service := NewWebhookService(repo, client, 24*time.Hour)
+	service.now = func() time.Time { return now }
+	return service, &now
+}
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	ctx := context.Background()
+	service, _ := newTestWebhookService(newFakeWebhookRepo(), http.DefaultClient)
+
+	subscription, err := service.CreateWebhook(ctx, domain.WebhookSubscription{
+		URL:        "https://partner.example.com/hook",
+		EventTypes: []domain.SongEventType{domain.SongEventCreated},
+		Active:     true,
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(subscription.Secret, webhookSecretPrefix))
+	assert.Len(t, subscription.Secret, len(webhookSecretPrefix)+2*webhookSecretBytes)
+
+	subscription, err = service.CreateWebhook(ctx, domain.WebhookSubscription{
+		URL:        "https://partner.example.com/hook",
+		EventTypes: []domain.SongEventType{domain.SongEventDeleted},
+		Secret:     "mine",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "mine", subscription.Secret)
+
+	invalid := []domain.WebhookSubscription{
+		{URL: "ftp://partner.example.com", EventTypes: []domain.SongEventType{domain.SongEventCreated}},
+		{URL: "/hook", EventTypes: []domain.SongEventType{domain.SongEventCreated}},
+		{URL: "https://partner.example.com/hook"},
+		{URL: "https://partner.example.com/hook", EventTypes: []domain.SongEventType{domain.SongEventReset}},
+	}
+	for _, subscription := range invalid {
+		_, err := service.CreateWebhook(ctx, subscription)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebhook, subscription)
+	}
+}
+
+func TestWebhookService_DeliverDue(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeWebhookRepo()
+	service, now := newTestWebhookService(repo, nil)
+	receiver := newWebhookReceiver(t, "s3cret", func() time.Time { return *now })
+	service.client = receiver.Client()
+
+	repo.subscriptions[1] = &domain.WebhookSubscription{ID: 1, URL: receiver.URL, Secret: "s3cret", Active: true,
+		EventTypes: []domain.SongEventType{domain.SongEventCreated, domain.SongEventUpdated}}
+	repo.subscriptions[2] = &domain.WebhookSubscription{ID: 2, URL: receiver.URL, Secret: "other", Active: true,
+		EventTypes: []domain.SongEventType{domain.SongEventDeleted}}
+
+	require.NoError(t, service.Deliver(ctx, domain.OutboxEvent{ID: 12, Type: domain.SongEventUpdated, SongID: 7}, []byte(`{"id":12}`)))
+	require.Len(t, repo.deliveries, 1, "only the subscription to updated events is notified")
+
+	delivered, err := service.DeliverDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	require.Len(t, receiver.requests, 1)
+	assert.NoError(t, receiver.errs[0])
+	assert.Equal(t, `{"id":12}`, receiver.bodies[0])
+	assert.Equal(t, "12", receiver.requests[0].Header.Get("Webhook-ID"))
+	assert.Equal(t, "updated", receiver.requests[0].Header.Get("Webhook-Event"))
+
+	delivery := repo.deliveries[0]
+	assert.Equal(t, domain.WebhookDeliveryDelivered, delivery.Status)
+	assert.Equal(t, http.StatusOK, delivery.ResponseCode)
+	require.Len(t, delivery.Log, 1)
+	assert.Equal(t, http.StatusOK, delivery.Log[0].ResponseCode)
+}
+
+func TestWebhookService_DeliverDue_Retries(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeWebhookRepo()
+	service, now := newTestWebhookService(repo, nil)
+	receiver := newWebhookReceiver(t, "s3cret", func() time.Time { return *now })
+	receiver.status = http.StatusServiceUnavailable
+	service.client = receiver.Client()
+
+	repo.subscriptions[1] = &domain.WebhookSubscription{ID: 1, URL: receiver.URL, Secret: "s3cret", Active: true,
+		EventTypes: []domain.SongEventType{domain.SongEventCreated}}
+	require.NoError(t, service.Deliver(ctx, domain.OutboxEvent{ID: 3, Type: domain.SongEventCreated}, []byte(`{"id":3}`)))
+	delivery := repo.deliveries[0]
+
+	delivered, err := service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, domain.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.ResponseCode)
+	assert.Equal(t, "responded 503", delivery.LastError)
+	assert.Equal(t, now.Add(webhookMinBackoff), delivery.NextAttemptAt)
+
+	// Not due before the end of the backoff
+	_, err = service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Len(t, receiver.requests, 1)
+
+	for i := 1; i < domain.MaxWebhookAttempts; i++ {
+		*now = delivery.NextAttemptAt
+		_, err = service.DeliverDue(ctx)
+		require.NoError(t, err)
+	}
+	assert.Len(t, receiver.requests, domain.MaxWebhookAttempts)
+	assert.Equal(t, domain.WebhookDeliveryFailed, delivery.Status, "given up once out of attempts")
+	for _, err := range receiver.errs {
+		assert.NoError(t, err)
+	}
+
+	receiver.status = http.StatusAccepted
+	require.NoError(t, service.Redeliver(ctx, 1, delivery.ID))
+	delivered, err = service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, domain.WebhookDeliveryDelivered, delivery.Status)
+	assert.Len(t, delivery.Log, domain.MaxWebhookAttempts+1)
+
+	assert.ErrorIs(t, service.Redeliver(ctx, 2, delivery.ID), domain.ErrWebhookDeliveryNotFound)
+}
+
+func TestWebhookService_DeliverDue_Inactive(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeWebhookRepo()
+	service, _ := newTestWebhookService(repo, http.DefaultClient)
+
+	repo.subscriptions[1] = &domain.WebhookSubscription{ID: 1, URL: "http://127.0.0.1:1", Active: true,
+		EventTypes: []domain.SongEventType{domain.SongEventCreated}}
+	require.NoError(t, service.Deliver(ctx, domain.OutboxEvent{ID: 3, Type: domain.SongEventCreated}, []byte(`{"id":3}`)))
+	repo.subscriptions[1].Active = false
+
+	_, err := service.DeliverDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.WebhookDeliveryFailed, repo.deliveries[0].Status)
+	assert.Equal(t, errWebhookInactive.Error(), repo.deliveries[0].LastError)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, webhookBackoff(1))
+	assert.Equal(t, time.Minute, webhookBackoff(2))
+	assert.Equal(t, 8*time.Minute, webhookBackoff(5))
+	assert.Equal(t, time.Hour, webhookBackoff(20))
+}
@@ -5,6 +5,7 @@ import (
 	_ "encoding/json"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"io"
 	"net/http"
 	"songs/internal/app/common"
 )
@@ -33,6 +34,14 @@ func (g *ginRequestReader) DecodeBody(v interface{}) error {
 	return g.c.ShouldBindJSON(v)
 }
 
+func (g *ginRequestReader) Body() io.Reader {
+	return g.c.Request.Body
+}
+
+func (g *ginRequestReader) Header(name string) string {
+	return g.c.GetHeader(name)
+}
+
 func (g *ginRequestReader) Context() context.Context {
 	return g.c.Request.Context()
 }
@@ -0,0 +1,255 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type AlbumHandler struct {
+	albumService AlbumService
+}
+
+func NewAlbumHandler(albumService AlbumService) *AlbumHandler {
+	return &AlbumHandler{
+		albumService: albumService,
+	}
+}
+
+// ListAlbums godoc
+// @Summary List albums
+// @Description Get albums ordered by release date, with pagination
+// @Tags albums
+// @Produce json
+// @Param group_id query int false "Restrict to a group"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} AlbumsResponse
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/albums [get]
+func (h *AlbumHandler) ListAlbums(r common.RequestReader, w http.ResponseWriter) error {
+	groupID := 0
+	if groupIDStr := r.QueryParam("group_id"); groupIDStr != "" {
+		var err error
+		groupID, err = strconv.Atoi(groupIDStr)
+		if err != nil || groupID <= 0 {
+			server.BadRequest("invalid-group-id", domain.ErrInvalidID, w)
+			return nil
+		}
+	}
+
+	page, err := strconv.Atoi(r.DefaultQueryParam("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(r.DefaultQueryParam("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+
+	albums, total, err := h.albumService.ListAlbums(r.Context(), groupID, page, pageSize)
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	response := AlbumsResponse{
+		Albums: make([]AlbumResponse, len(albums)),
+		Total:  total,
+		Page:   page,
+		Pages:  (int(total) + pageSize - 1) / pageSize,
+	}
+	for i, album := range albums {
+		response.Albums[i] = ToAlbumResponse(album)
+	}
+	server.RespondOK(response, w)
+	return nil
+}
+
+// CreateAlbum godoc
+// @Summary Create an album
+// @Description Create an album with its track listing. Songs of tracks with inherit_release_date take the release date of the album.
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param album body AlbumRequest true "Album object"
+// @Success 200 {object} AlbumResponse
+// @Failure 400,404,409,500 {object} map[string]string
+// @Router /api/v1/albums [post]
+func (h *AlbumHandler) CreateAlbum(r common.RequestReader, w http.ResponseWriter) error {
+	album, ok := decodeAlbum(r, w)
+	if !ok {
+		return nil
+	}
+
+	created, err := h.albumService.CreateAlbum(r.Context(), album)
+	if err != nil {
+		respondAlbumError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToAlbumResponse(created), w)
+	return nil
+}
+
+// GetAlbum godoc
+// @Summary Get an album
+// @Description Get an album with its tracks
+// @Tags albums
+// @Produce json
+// @Param id path int true "Album ID"
+// @Success 200 {object} AlbumResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/albums/{id} [get]
+func (h *AlbumHandler) GetAlbum(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := albumID(r, w)
+	if !ok {
+		return nil
+	}
+
+	album, err := h.albumService.GetAlbum(r.Context(), id)
+	if err != nil {
+		respondAlbumError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToAlbumResponse(album), w)
+	return nil
+}
+
+// UpdateAlbum godoc
+// @Summary Update an album
+// @Description Replace an album. The track listing is replaced when tracks is given and kept otherwise; songs inheriting the release date follow it.
+// @Tags albums
+// @Accept json
+// @Produce json
+// @Param id path int true "Album ID"
+// @Param album body AlbumRequest true "Album object"
+// @Success 200 {object} AlbumResponse
+// @Failure 400,404,409,500 {object} map[string]string
+// @Router /api/v1/albums/{id} [put]
+func (h *AlbumHandler) UpdateAlbum(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := albumID(r, w)
+	if !ok {
+		return nil
+	}
+
+	album, ok := decodeAlbum(r, w)
+	if !ok {
+		return nil
+	}
+
+	updated, err := h.albumService.UpdateAlbum(r.Context(), id, album)
+	if err != nil {
+		respondAlbumError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToAlbumResponse(updated), w)
+	return nil
+}
+
+// DeleteAlbum godoc
+// @Summary Delete an album
+// @Description Delete an album and its track listing; the songs are kept
+// @Tags albums
+// @Produce json
+// @Param id path int true "Album ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/albums/{id} [delete]
+func (h *AlbumHandler) DeleteAlbum(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := albumID(r, w)
+	if !ok {
+		return nil
+	}
+
+	if err := h.albumService.DeleteAlbum(r.Context(), id); err != nil {
+		respondAlbumError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Deleted album", w)
+	return nil
+}
+
+// GetAlbumTracks godoc
+// @Summary List the tracks of an album
+// @Description Get the songs of an album in disc and track order
+// @Tags albums
+// @Produce json
+// @Param id path int true "Album ID"
+// @Success 200 {array} AlbumTrackResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/albums/{id}/tracks [get]
+func (h *AlbumHandler) GetAlbumTracks(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := albumID(r, w)
+	if !ok {
+		return nil
+	}
+
+	tracks, err := h.albumService.GetAlbumTracks(r.Context(), id)
+	if err != nil {
+		respondAlbumError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToAlbumTrackResponses(tracks), w)
+	return nil
+}
+
+// decodeAlbum reads an album from the request body, answering 400 when it is invalid
+func decodeAlbum(r common.RequestReader, w http.ResponseWriter) (*domain.Album, bool) {
+	var req AlbumRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil, false
+	}
+
+	album, err := ToAlbumDomain(req)
+	if err != nil {
+		server.BadRequest("validation-failed", errors.New("invalid release_date format, expected RFC3339"), w)
+		return nil, false
+	}
+	return album, true
+}
+
+// albumID parses the album ID path parameter, answering 400 when it is invalid
+func albumID(r common.RequestReader, w http.ResponseWriter) (int, bool) {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-album-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-album-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+	return id, true
+}
+
+// respondAlbumError answers with the status matching an album service error
+func respondAlbumError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		server.NotFound("album-not-found", err, w)
+	case errors.Is(err, domain.ErrGroupNotFound), errors.Is(err, domain.ErrSongNotFound):
+		server.NotFound(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrReleaseDateInherited):
+		server.Conflict(domain.ErrReleaseDateInherited.Slug(), err, w)
+	case errors.Is(err, domain.ErrInvalidAlbumType), errors.Is(err, domain.ErrInvalidTrack):
+		server.BadRequest(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrRequired):
+		server.BadRequest("validation-failed", err, w)
+	case errors.Is(err, domain.ErrInvalidID):
+		server.BadRequest("invalid-album-id", err, w)
+	default:
+		server.RespondWithError(err, w)
+	}
+}
@@ -0,0 +1,163 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock album service
+type MockAlbumService struct {
+	mock.Mock
+}
+
+func (m *MockAlbumService) CreateAlbum(ctx context.Context, album *domain.Album) (*domain.Album, error) {
+	args := m.Called(ctx, album)
+	created, _ := args.Get(0).(*domain.Album)
+	return created, args.Error(1)
+}
+
+func (m *MockAlbumService) GetAlbum(ctx context.Context, id int) (*domain.Album, error) {
+	args := m.Called(ctx, id)
+	album, _ := args.Get(0).(*domain.Album)
+	return album, args.Error(1)
+}
+
+func (m *MockAlbumService) ListAlbums(ctx context.Context, groupID, page, pageSize int) ([]*domain.Album, int64, error) {
+	args := m.Called(ctx, groupID, page, pageSize)
+	albums, _ := args.Get(0).([]*domain.Album)
+	return albums, args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockAlbumService) UpdateAlbum(ctx context.Context, id int, album *domain.Album) (*domain.Album, error) {
+	args := m.Called(ctx, id, album)
+	updated, _ := args.Get(0).(*domain.Album)
+	return updated, args.Error(1)
+}
+
+func (m *MockAlbumService) DeleteAlbum(ctx context.Context, id int) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockAlbumService) GetAlbumTracks(ctx context.Context, id int) ([]domain.AlbumTrack, error) {
+	args := m.Called(ctx, id)
+	tracks, _ := args.Get(0).([]domain.AlbumTrack)
+	return tracks, args.Error(1)
+}
+
+func setupAlbumTestRouter(mockService *MockAlbumService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewAlbumHandler(mockService)
+	router.GET("/api/v1/albums", adapter.ToGinHandler(handler.ListAlbums))
+	router.POST("/api/v1/albums", adapter.ToGinHandler(handler.CreateAlbum))
+	router.GET("/api/v1/albums/:id", adapter.ToGinHandler(handler.GetAlbum))
+	router.PUT("/api/v1/albums/:id", adapter.ToGinHandler(handler.UpdateAlbum))
+
+	return router
+}
+
+func TestAlbumHandler_CreateAlbum(t *testing.T) {
+	mockService := new(MockAlbumService)
+	router := setupAlbumTestRouter(mockService)
+
+	released := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("CreateAlbum", mock.Anything, mock.MatchedBy(func(a *domain.Album) bool {
+		return a.Title == "Debut" && len(a.Tracks) == 1 && a.Tracks[0].SongID == 7 && a.Tracks[0].InheritReleaseDate
+	})).Return(&domain.Album{
+		ID:          3,
+		GroupID:     1,
+		Title:       "Debut",
+		ReleaseDate: released,
+		Type:        domain.AlbumTypeAlbum,
+		Tracks: []domain.AlbumTrack{
+			{DiscNumber: 1, TrackNumber: 1, InheritReleaseDate: true, SongID: 7, Song: &domain.Song{ID: 7, Title: "Opener"}},
+		},
+	}, nil)
+
+	body := `{"group_id":1,"title":"Debut","release_date":"2020-05-01T00:00:00Z","tracks":[{"song_id":7,"track_number":1,"inherit_release_date":true}]}`
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/albums", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response AlbumResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 3, response.ID)
+	assert.Equal(t, "album", response.Type)
+	assert.Len(t, response.Tracks, 1)
+	assert.Equal(t, "Opener", response.Tracks[0].Song.Title)
+}
+
+func TestAlbumHandler_UpdateAlbum_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantSlug   string
+	}{
+		{name: "unknown album", err: domain.ErrNotFound, wantStatus: http.StatusNotFound, wantSlug: "album-not-found"},
+		{name: "unknown song", err: domain.ErrSongNotFound, wantStatus: http.StatusNotFound, wantSlug: domain.ErrSongNotFound.Slug()},
+		{name: "inherited elsewhere", err: domain.ErrReleaseDateInherited, wantStatus: http.StatusConflict, wantSlug: domain.ErrReleaseDateInherited.Slug()},
+		{name: "invalid track", err: domain.ErrInvalidTrack, wantStatus: http.StatusBadRequest, wantSlug: domain.ErrInvalidTrack.Slug()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockAlbumService)
+			router := setupAlbumTestRouter(mockService)
+			mockService.On("UpdateAlbum", mock.Anything, 3, mock.Anything).Return(nil, tt.err)
+
+			body := `{"group_id":1,"title":"Debut","release_date":"2020-05-01T00:00:00Z"}`
+			req, _ := http.NewRequest(http.MethodPut, "/api/v1/albums/3", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantSlug)
+		})
+	}
+}
+
+func TestAlbumHandler_UpdateAlbum_KeepsTracksWhenOmitted(t *testing.T) {
+	mockService := new(MockAlbumService)
+	router := setupAlbumTestRouter(mockService)
+
+	mockService.On("UpdateAlbum", mock.Anything, 3, mock.MatchedBy(func(a *domain.Album) bool {
+		return a.Tracks == nil
+	})).Return(&domain.Album{ID: 3, Title: "Debut"}, nil)
+
+	body := `{"group_id":1,"title":"Debut","release_date":"2020-05-01T00:00:00Z"}`
+	req, _ := http.NewRequest(http.MethodPut, "/api/v1/albums/3", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestAlbumHandler_ListAlbums_InvalidGroup(t *testing.T) {
+	mockService := new(MockAlbumService)
+	router := setupAlbumTestRouter(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/albums?group_id=abc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ListAlbums", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,115 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type APIKeyHandler struct {
+	apiKeyService APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// ListAPIKeys godoc
+// @Summary List API keys
+// @Description List all API keys, revoked ones included. Secrets are never returned.
+// @Tags admin
+// @Produce json
+// @Success 200 {array} APIKeyResponse
+// @Failure 401,403,500 {object} map[string]string
+// @Router /api/v1/admin/api-keys [get]
+func (h *APIKeyHandler) ListAPIKeys(r common.RequestReader, w http.ResponseWriter) error {
+	keys, err := h.apiKeyService.ListKeys(r.Context())
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	response := make([]APIKeyResponse, len(keys))
+	for i, key := range keys {
+		response[i] = ToAPIKeyResponse(key)
+	}
+	server.RespondOK(response, w)
+	return nil
+}
+
+// CreateAPIKey godoc
+// @Summary Issue an API key
+// @Description Issue a key with the reader, editor or admin role. The secret is only returned in this response.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param key body CreateAPIKeyRequest true "Key name and role"
+// @Success 200 {object} CreatedAPIKeyResponse
+// @Failure 400,401,403,500 {object} map[string]string
+// @Router /api/v1/admin/api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(r common.RequestReader, w http.ResponseWriter) error {
+	var req CreateAPIKeyRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	key, secret, err := h.apiKeyService.IssueKey(r.Context(), req.Name, domain.Role(req.Role))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRequired):
+			server.BadRequest("name-required", err, w)
+		case errors.Is(err, domain.ErrInvalidRole):
+			server.BadRequest(domain.ErrInvalidRole.Slug(), err, w)
+		default:
+			server.RespondWithError(err, w)
+		}
+		return nil
+	}
+
+	server.RespondOK(CreatedAPIKeyResponse{APIKeyResponse: ToAPIKeyResponse(key), Key: secret}, w)
+	return nil
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Revoke a key; requests using it are rejected from now on
+// @Tags admin
+// @Produce json
+// @Param id path int true "API key ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/api-keys/{id} [delete]
+func (h *APIKeyHandler) RevokeAPIKey(r common.RequestReader, w http.ResponseWriter) error {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-key-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-key-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	if err := h.apiKeyService.RevokeKey(r.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			server.NotFound("api-key-not-found", err, w)
+		case errors.Is(err, domain.ErrInvalidID):
+			server.BadRequest("invalid-key-id", err, w)
+		default:
+			server.RespondWithError(err, w)
+		}
+		return nil
+	}
+
+	server.RespondOK("Revoked API key", w)
+	return nil
+}
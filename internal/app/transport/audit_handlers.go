@@ -0,0 +1,112 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+	"time"
+)
+
+type AuditHandler struct {
+	auditService AuditService
+}
+
+func NewAuditHandler(auditService AuditService) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+	}
+}
+
+// GetAuditLog godoc
+// @Summary Search the audit log
+// @Description List the changes made to songs and groups, newest first, with the caller, request ID and transport they were made through and the record before and after. Entries are kept for AUDIT_RETENTION.
+// @Tags admin
+// @Produce json
+// @Param resource query string false "song or group"
+// @Param resource_id query int false "ID of the song or group"
+// @Param actor query string false "Subject of the caller, e.g. apikey:12"
+// @Param action query string false "create, update, patch or delete"
+// @Param transport query string false "http or grpc"
+// @Param request_id query string false "Request ID"
+// @Param since query string false "Changes from this time on, RFC3339"
+// @Param until query string false "Changes before this time, RFC3339"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, at most 200" default(50)
+// @Success 200 {object} AuditLogResponse
+// @Failure 400,401,403,500 {object} map[string]string
+// @Router /api/v1/audit [get]
+func (h *AuditHandler) GetAuditLog(r common.RequestReader, w http.ResponseWriter) error {
+	filter, err := auditFilter(r)
+	if err != nil {
+		server.BadRequest("invalid-filter", err, w)
+		return nil
+	}
+
+	page, err := strconv.Atoi(r.DefaultQueryParam("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(r.DefaultQueryParam("page_size", "50"))
+	if err != nil || pageSize < 1 {
+		pageSize = 50
+	}
+
+	entries, total, err := h.auditService.List(r.Context(), filter, page, pageSize)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidData) {
+			server.BadRequest("invalid-filter", err, w)
+			return nil
+		}
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	response := AuditLogResponse{
+		Entries: make([]AuditEntryResponse, len(entries)),
+		Total:   total,
+		Page:    page,
+		Pages:   (int(total) + pageSize - 1) / pageSize,
+	}
+	for i, entry := range entries {
+		response.Entries[i] = ToAuditEntryResponse(entry)
+	}
+	server.RespondOK(response, w)
+	return nil
+}
+
+// auditFilter reads the audit log filter from the query parameters
+func auditFilter(r common.RequestReader) (domain.AuditFilter, error) {
+	filter := domain.AuditFilter{
+		Resource:  domain.AuditResource(r.QueryParam("resource")),
+		Actor:     r.QueryParam("actor"),
+		Action:    domain.AuditAction(r.QueryParam("action")),
+		Transport: domain.Transport(r.QueryParam("transport")),
+		RequestID: r.QueryParam("request_id"),
+	}
+
+	if value := r.QueryParam("resource_id"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil || id <= 0 {
+			return filter, errors.New("resource_id must be a positive integer")
+		}
+		filter.ResourceID = id
+	}
+	if value := r.QueryParam("since"); value != "" {
+		since, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return filter, errors.New("invalid since format, expected RFC3339")
+		}
+		filter.Since = since
+	}
+	if value := r.QueryParam("until"); value != "" {
+		until, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return filter, errors.New("invalid until format, expected RFC3339")
+		}
+		filter.Until = until
+	}
+	return filter, nil
+}
@@ -0,0 +1,87 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock audit service
+type MockAuditService struct {
+	mock.Mock
+}
+
+func (m *MockAuditService) List(ctx context.Context, filter domain.AuditFilter, page, pageSize int) ([]domain.AuditEntry, int64, error) {
+	args := m.Called(ctx, filter, page, pageSize)
+	entries, _ := args.Get(0).([]domain.AuditEntry)
+	return entries, args.Get(1).(int64), args.Error(2)
+}
+
+func setupAuditTestRouter(auditService *MockAuditService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	auditHandler := NewAuditHandler(auditService)
+	router.GET("/api/v1/audit", adapter.ToGinHandler(auditHandler.GetAuditLog))
+
+	return router
+}
+
+func TestAuditHandler_GetAuditLog(t *testing.T) {
+	auditService := new(MockAuditService)
+	router := setupAuditTestRouter(auditService)
+
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	filter := domain.AuditFilter{
+		Resource:   domain.AuditSong,
+		ResourceID: 123,
+		Action:     domain.AuditDelete,
+		Since:      at.Add(-time.Hour),
+	}
+	auditService.On("List", mock.Anything, filter, 1, 20).Return([]domain.AuditEntry{
+		{ID: 9, Time: at, Actor: "apikey:2", ActorName: "ci", RequestID: "req-1", Transport: domain.TransportHTTP,
+			Resource: domain.AuditSong, ResourceID: 123, Action: domain.AuditDelete, Before: []byte(`{"id":123,"title":"Hysteria"}`)},
+	}, int64(21), nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/audit?resource=song&resource_id=123&action=delete&since=2024-05-01T11:00:00Z&page_size=20", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response AuditLogResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(21), response.Total)
+	assert.Equal(t, 2, response.Pages)
+	assert.Len(t, response.Entries, 1)
+	assert.Equal(t, "apikey:2", response.Entries[0].Actor)
+	assert.Equal(t, "2024-05-01T12:00:00Z", response.Entries[0].Time)
+	assert.JSONEq(t, `{"id":123,"title":"Hysteria"}`, string(response.Entries[0].Before))
+	assert.Nil(t, response.Entries[0].After)
+	auditService.AssertExpectations(t)
+}
+
+func TestAuditHandler_GetAuditLog_InvalidFilter(t *testing.T) {
+	auditService := new(MockAuditService)
+	router := setupAuditTestRouter(auditService)
+
+	auditService.On("List", mock.Anything, domain.AuditFilter{Resource: "album"}, 1, 50).
+		Return(nil, int64(0), domain.ErrInvalidData)
+
+	for _, query := range []string{"resource_id=abc", "resource_id=-1", "since=yesterday", "until=2024-05-01", "resource=album"} {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/audit?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+	auditService.AssertNumberOfCalls(t, "List", 1)
+}
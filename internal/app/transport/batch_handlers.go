@@ -0,0 +1,149 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+)
+
+type BatchHandler struct {
+	batchService BatchService
+}
+
+func NewBatchHandler(batchService BatchService) *BatchHandler {
+	return &BatchHandler{
+		batchService: batchService,
+	}
+}
+
+// BatchCreateSongs godoc
+// @Summary Create songs in batch
+// @Description Create many songs at once, either all-or-nothing (mode=atomic, default) or independently (mode=best_effort)
+// @Tags songs
+// @Accept json
+// @Produce json
+// @Param batch body BatchCreateRequest true "Songs to create"
+// @Success 200 {object} BatchResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/songs:batchCreate [post]
+func (h *BatchHandler) BatchCreateSongs(r common.RequestReader, w http.ResponseWriter) error {
+	var req BatchCreateRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	mode, ok := batchMode(req.Mode)
+	if !ok {
+		server.BadRequest("invalid-batch-mode", domain.ErrInvalidData, w)
+		return nil
+	}
+
+	items := make([]domain.BatchItem, len(req.Songs))
+	for i, song := range req.Songs {
+		items[i] = ToBatchItem(0, song)
+	}
+
+	report, err := h.batchService.CreateSongs(r.Context(), items, mode)
+	respondBatch(report, err, w)
+	return nil
+}
+
+// BatchUpdateSongs godoc
+// @Summary Update songs in batch
+// @Description Replace many songs at once, either all-or-nothing (mode=atomic, default) or independently (mode=best_effort)
+// @Tags songs
+// @Accept json
+// @Produce json
+// @Param batch body BatchUpdateRequest true "Songs to update"
+// @Success 200 {object} BatchResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/songs:batchUpdate [post]
+func (h *BatchHandler) BatchUpdateSongs(r common.RequestReader, w http.ResponseWriter) error {
+	var req BatchUpdateRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	mode, ok := batchMode(req.Mode)
+	if !ok {
+		server.BadRequest("invalid-batch-mode", domain.ErrInvalidData, w)
+		return nil
+	}
+
+	items := make([]domain.BatchItem, len(req.Songs))
+	for i, song := range req.Songs {
+		items[i] = ToBatchItem(song.ID, song.SongRequest)
+		if song.ID <= 0 {
+			items[i] = domain.BatchItem{ID: song.ID, Err: domain.ErrInvalidID}
+		}
+	}
+
+	report, err := h.batchService.UpdateSongs(r.Context(), items, mode)
+	respondBatch(report, err, w)
+	return nil
+}
+
+// BatchDeleteSongs godoc
+// @Summary Delete songs in batch
+// @Description Delete many songs at once, either all-or-nothing (mode=atomic, default) or independently (mode=best_effort)
+// @Tags songs
+// @Accept json
+// @Produce json
+// @Param batch body BatchDeleteRequest true "IDs of songs to delete"
+// @Success 200 {object} BatchResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/songs:batchDelete [post]
+func (h *BatchHandler) BatchDeleteSongs(r common.RequestReader, w http.ResponseWriter) error {
+	var req BatchDeleteRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	mode, ok := batchMode(req.Mode)
+	if !ok {
+		server.BadRequest("invalid-batch-mode", domain.ErrInvalidData, w)
+		return nil
+	}
+
+	items := make([]domain.BatchItem, len(req.IDs))
+	for i, id := range req.IDs {
+		items[i] = domain.BatchItem{ID: id}
+		if id <= 0 {
+			items[i].Err = domain.ErrInvalidID
+		}
+	}
+
+	report, err := h.batchService.DeleteSongs(r.Context(), items, mode)
+	respondBatch(report, err, w)
+	return nil
+}
+
+func respondBatch(report *domain.BatchReport, err error, w http.ResponseWriter) {
+	if err != nil {
+		if errors.Is(err, domain.ErrBatchTooLarge) || errors.Is(err, domain.ErrRequired) {
+			server.BadRequest(ErrorSlug(err), err, w)
+			return
+		}
+		server.RespondWithError(err, w)
+		return
+	}
+
+	server.RespondOK(ToBatchResponse(report), w)
+}
+
+// batchMode parses the batch mode, defaulting to atomic
+func batchMode(mode string) (domain.BatchMode, bool) {
+	switch mode {
+	case "", string(domain.BatchModeAtomic):
+		return domain.BatchModeAtomic, true
+	case string(domain.BatchModeBestEffort), "best-effort":
+		return domain.BatchModeBestEffort, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,147 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock batch service
+type MockBatchService struct {
+	mock.Mock
+}
+
+func (m *MockBatchService) CreateSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error) {
+	args := m.Called(ctx, items, mode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BatchReport), args.Error(1)
+}
+
+func (m *MockBatchService) UpdateSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error) {
+	args := m.Called(ctx, items, mode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BatchReport), args.Error(1)
+}
+
+func (m *MockBatchService) DeleteSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error) {
+	args := m.Called(ctx, items, mode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BatchReport), args.Error(1)
+}
+
+func setupBatchTestRouter(mockService *MockBatchService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewBatchHandler(mockService)
+	router.POST("/api/v1/songs:method", adapter.ToGinHandler(customMethods(map[string]handlerFunc{
+		"batchCreate": handler.BatchCreateSongs,
+		"batchUpdate": handler.BatchUpdateSongs,
+		"batchDelete": handler.BatchDeleteSongs,
+	})))
+
+	return router
+}
+
+func TestBatchHandler_BatchCreateSongs(t *testing.T) {
+	mockService := new(MockBatchService)
+	router := setupBatchTestRouter(mockService)
+
+	valid := SongRequest{GroupID: 1, Title: "Valid", ReleaseDate: time.Now().Format(time.RFC3339), Text: "lyrics"}
+	invalid := SongRequest{GroupID: 1, Title: "No text", ReleaseDate: time.Now().Format(time.RFC3339)}
+
+	report := &domain.BatchReport{
+		Mode:   domain.BatchModeBestEffort,
+		Failed: 1,
+		Results: []domain.BatchResult{
+			{Index: 0, ID: 5, Song: &domain.Song{ID: 5, Title: "Valid"}},
+			{Index: 1, Err: domain.ErrValidation},
+		},
+		Succeeded: 1,
+		Committed: true,
+	}
+	mockService.On("CreateSongs", mock.Anything, mock.MatchedBy(func(items []domain.BatchItem) bool {
+		return len(items) == 2 && items[0].Err == nil && items[0].Song.Title == "Valid" &&
+			assert.ErrorIs(t, items[1].Err, domain.ErrValidation)
+	}), domain.BatchModeBestEffort).Return(report, nil)
+
+	body, _ := json.Marshal(BatchCreateRequest{Mode: "best_effort", Songs: []SongRequest{valid, invalid}})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:batchCreate", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response BatchResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "best_effort", response.Mode)
+	assert.Equal(t, "ok", response.Results[0].Status)
+	assert.Equal(t, "Valid", response.Results[0].Song.Title)
+	assert.Equal(t, "failed", response.Results[1].Status)
+	assert.Equal(t, "validation-error", response.Results[1].Slug)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_BatchDeleteSongs_Aborted(t *testing.T) {
+	mockService := new(MockBatchService)
+	router := setupBatchTestRouter(mockService)
+
+	report := &domain.BatchReport{
+		Mode:   domain.BatchModeAtomic,
+		Failed: 2,
+		Results: []domain.BatchResult{
+			{Index: 0, ID: 1, Err: domain.ErrBatchAborted},
+			{Index: 1, ID: 2, Err: domain.ErrNotFound},
+		},
+	}
+	mockService.On("DeleteSongs", mock.Anything, []domain.BatchItem{{ID: 1}, {ID: 2}}, domain.BatchModeAtomic).Return(report, nil)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:batchDelete", bytes.NewBufferString(`{"ids":[1,2]}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response BatchResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.False(t, response.Committed)
+	assert.Equal(t, "aborted", response.Results[0].Status)
+	assert.Equal(t, "not-found", response.Results[1].Slug)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_InvalidMode(t *testing.T) {
+	router := setupBatchTestRouter(new(MockBatchService))
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:batchDelete", bytes.NewBufferString(`{"mode":"yolo","ids":[1]}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
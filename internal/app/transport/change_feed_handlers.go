@@ -0,0 +1,114 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+	"time"
+)
+
+// eventsHeartbeatInterval is how often an idle event stream sends a comment,
+// keeping proxies from closing it
+const eventsHeartbeatInterval = 15 * time.Second
+
+type ChangeFeedHandler struct {
+	changeFeed ChangeFeedService
+}
+
+func NewChangeFeedHandler(changeFeed ChangeFeedService) *ChangeFeedHandler {
+	return &ChangeFeedHandler{
+		changeFeed: changeFeed,
+	}
+}
+
+// StreamEvents godoc
+// @Summary Stream song changes
+// @Description Stream an event for every song created, updated or deleted as Server-Sent Events. The id of each event is its sequence number; reconnecting with it in Last-Event-ID, or in the after parameter, resumes after it. Without either, only new events are sent. A reset event tells clients resuming from an event no longer kept to reload the songs they hold.
+// @Tags songs
+// @Produce text/event-stream
+// @Param Last-Event-ID header int false "Sequence number of the last event received"
+// @Param after query int false "Sequence number to resume after, when Last-Event-ID is not set"
+// @Success 200 {object} SongEventResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/events [get]
+func (h *ChangeFeedHandler) StreamEvents(r common.RequestReader, w http.ResponseWriter) error {
+	afterSeq := int64(-1)
+	resume := r.Header("Last-Event-ID")
+	if resume == "" {
+		resume = r.QueryParam("after")
+	}
+	if resume != "" {
+		seq, err := strconv.ParseInt(resume, 10, 64)
+		if err != nil || seq < 0 {
+			server.BadRequest("invalid-last-event-id", domain.ErrInvalidData, w)
+			return nil
+		}
+		afterSeq = seq
+	}
+
+	// Events are written from this goroutine only, between heartbeats
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	events := make(chan domain.SongEvent)
+	done := make(chan error, 1)
+	go func() {
+		done <- h.changeFeed.Watch(ctx, afterSeq, func(event domain.SongEvent) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flushEvents(w)
+
+	heartbeat := time.NewTicker(eventsHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case event := <-events:
+			err = writeSongEvent(w, event)
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		case err := <-done:
+			if err != nil && ctx.Err() == nil {
+				// Headers are already sent, the client reconnects and resumes
+				log.Printf("event stream aborted: %v", err)
+			}
+			return nil
+		}
+		if err != nil {
+			return nil
+		}
+		flushEvents(w)
+	}
+}
+
+// writeSongEvent writes an event in the Server-Sent Events format
+func writeSongEvent(w http.ResponseWriter, event domain.SongEvent) error {
+	data, err := json.Marshal(ToSongEventResponse(event))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq, event.Type, data)
+	return err
+}
+
+func flushEvents(w http.ResponseWriter) {
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
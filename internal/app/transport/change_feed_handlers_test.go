@@ -0,0 +1,95 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock change feed service, replaying its events then returning
+type MockChangeFeedService struct {
+	mock.Mock
+}
+
+func (m *MockChangeFeedService) Watch(ctx context.Context, afterSeq int64, fn func(domain.SongEvent) error) error {
+	args := m.Called(ctx, afterSeq)
+	events, _ := args.Get(0).([]domain.SongEvent)
+	for _, event := range events {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
+func setupChangeFeedTestRouter(changeFeed *MockChangeFeedService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	changeFeedHandler := NewChangeFeedHandler(changeFeed)
+	router.GET("/api/v1/events", adapter.ToGinHandler(changeFeedHandler.StreamEvents))
+
+	return router
+}
+
+func TestChangeFeedHandler_StreamEvents(t *testing.T) {
+	changeFeed := new(MockChangeFeedService)
+	router := setupChangeFeedTestRouter(changeFeed)
+
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	changeFeed.On("Watch", mock.Anything, int64(41)).Return([]domain.SongEvent{
+		{Seq: 42, Type: domain.SongEventCreated, SongID: 7, Song: &domain.Song{ID: 7, Title: "Yesterday"}, Time: at},
+		{Seq: 44, Type: domain.SongEventDeleted, SongID: 7, Time: at},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/events", nil)
+	req.Header.Set("Last-Event-ID", "41")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	body := w.Body.String()
+	assert.Contains(t, body, "id: 42\nevent: created\ndata: {\"seq\":42,\"type\":\"created\",\"song_id\":7,\"song\":{")
+	assert.Contains(t, body, "id: 44\nevent: deleted\ndata: {\"seq\":44,\"type\":\"deleted\",\"song_id\":7,\"time\":\"2024-05-01T12:00:00Z\"}\n\n")
+	changeFeed.AssertExpectations(t)
+}
+
+func TestChangeFeedHandler_StreamEvents_Resume(t *testing.T) {
+	changeFeed := new(MockChangeFeedService)
+	router := setupChangeFeedTestRouter(changeFeed)
+
+	changeFeed.On("Watch", mock.Anything, int64(-1)).Return(nil, nil).Once()
+	changeFeed.On("Watch", mock.Anything, int64(5)).Return(nil, errors.New("connection reset")).Once()
+
+	for _, url := range []string{"/api/v1/events", "/api/v1/events?after=5"} {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code, url)
+	}
+	changeFeed.AssertExpectations(t)
+}
+
+func TestChangeFeedHandler_StreamEvents_InvalidLastEventID(t *testing.T) {
+	changeFeed := new(MockChangeFeedService)
+	router := setupChangeFeedTestRouter(changeFeed)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/events", nil)
+	req.Header.Set("Last-Event-ID", "abc")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid-last-event-id")
+	changeFeed.AssertNotCalled(t, "Watch", mock.Anything, mock.Anything)
+}
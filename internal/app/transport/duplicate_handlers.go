@@ -0,0 +1,99 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type DuplicateHandler struct {
+	duplicateService DuplicateService
+}
+
+func NewDuplicateHandler(duplicateService DuplicateService) *DuplicateHandler {
+	return &DuplicateHandler{
+		duplicateService: duplicateService,
+	}
+}
+
+// GetDuplicates godoc
+// @Summary List duplicate songs
+// @Description List sets of songs of the same group whose titles only differ in case, whitespace or punctuation
+// @Tags songs
+// @Produce json
+// @Param group_id query int false "Restrict to a group"
+// @Success 200 {object} DuplicatesResponse
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/songs/duplicates [get]
+func (h *DuplicateHandler) GetDuplicates(r common.RequestReader, w http.ResponseWriter) error {
+	groupID := 0
+	if groupIDStr := r.QueryParam("group_id"); groupIDStr != "" {
+		var err error
+		groupID, err = strconv.Atoi(groupIDStr)
+		if err != nil || groupID <= 0 {
+			server.BadRequest("invalid-group-id", domain.ErrInvalidID, w)
+			return nil
+		}
+	}
+
+	sets, err := h.duplicateService.FindDuplicates(r.Context(), groupID)
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToDuplicatesResponse(sets), w)
+	return nil
+}
+
+// MergeSongs godoc
+// @Summary Merge duplicate songs
+// @Description Merge duplicates into the song: missing text and link are taken from the sources, the earliest release date is kept and the sources are deleted
+// @Tags songs
+// @Accept json
+// @Produce json
+// @Param id path int true "ID of the song to keep"
+// @Param merge body MergeSongsRequest true "Songs to merge into it"
+// @Success 200 {object} SongResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/merge [post]
+func (h *DuplicateHandler) MergeSongs(r common.RequestReader, w http.ResponseWriter) error {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	var req MergeSongsRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	merged, err := h.duplicateService.MergeSongs(r.Context(), id, req.SourceIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			server.NotFound("song-not-found", err, w)
+		case errors.Is(err, domain.ErrMergeMismatch):
+			server.BadRequest(domain.ErrMergeMismatch.Slug(), err, w)
+		case errors.Is(err, domain.ErrRequired), errors.Is(err, domain.ErrInvalidData):
+			server.BadRequest("invalid-source-ids", err, w)
+		default:
+			server.RespondWithError(err, w)
+		}
+		return nil
+	}
+
+	server.RespondOK(ToSongResponse(merged), w)
+	return nil
+}
@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock duplicate service
+type MockDuplicateService struct {
+	mock.Mock
+}
+
+func (m *MockDuplicateService) FindDuplicates(ctx context.Context, groupID int) ([]domain.DuplicateSet, error) {
+	args := m.Called(ctx, groupID)
+	sets, _ := args.Get(0).([]domain.DuplicateSet)
+	return sets, args.Error(1)
+}
+
+func (m *MockDuplicateService) MergeSongs(ctx context.Context, targetID int, sourceIDs []int) (*domain.Song, error) {
+	args := m.Called(ctx, targetID, sourceIDs)
+	song, _ := args.Get(0).(*domain.Song)
+	return song, args.Error(1)
+}
+
+func setupDuplicateTestRouter(mockService *MockDuplicateService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewDuplicateHandler(mockService)
+	router.GET("/api/v1/songs/duplicates", adapter.ToGinHandler(handler.GetDuplicates))
+	router.POST("/api/v1/songs/:id/merge", adapter.ToGinHandler(handler.MergeSongs))
+
+	return router
+}
+
+func TestDuplicateHandler_GetDuplicates(t *testing.T) {
+	mockService := new(MockDuplicateService)
+	router := setupDuplicateTestRouter(mockService)
+
+	mockService.On("FindDuplicates", mock.Anything, 3).Return([]domain.DuplicateSet{{
+		GroupID:  3,
+		TitleKey: "hey jude",
+		Songs:    []*domain.Song{{ID: 1, GroupID: 3, Title: "Hey Jude"}, {ID: 5, GroupID: 3, Title: "hey, jude"}},
+	}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/duplicates?group_id=3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response DuplicatesResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Total)
+	assert.Equal(t, "hey jude", response.Duplicates[0].TitleKey)
+	assert.Len(t, response.Duplicates[0].Songs, 2)
+	mockService.AssertExpectations(t)
+}
+
+func TestDuplicateHandler_MergeSongs(t *testing.T) {
+	mockService := new(MockDuplicateService)
+	router := setupDuplicateTestRouter(mockService)
+
+	mockService.On("MergeSongs", mock.Anything, 1, []int{5, 6}).Return(&domain.Song{ID: 1, Title: "Hey Jude"}, nil)
+	mockService.On("MergeSongs", mock.Anything, 2, []int{3}).Return(nil, domain.ErrMergeMismatch)
+
+	body, _ := json.Marshal(MergeSongsRequest{SourceIDs: []int{5, 6}})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs/1/merge", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response SongResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.ID)
+
+	body, _ = json.Marshal(MergeSongsRequest{SourceIDs: []int{3}})
+	req, _ = http.NewRequest(http.MethodPost, "/api/v1/songs/2/merge", bytes.NewBuffer(body))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "merge-mismatch")
+}
@@ -0,0 +1,142 @@
+package transport
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// exportFlushEvery is the number of rows written between flushes to the client
+const exportFlushEvery = 100
+
+// songEncoder writes a stream of songs in one export format
+type songEncoder interface {
+	Begin() error
+	Encode(song SongResponse) error
+	End() error
+}
+
+type exportFormat struct {
+	contentType string
+	extension   string
+	newEncoder  func(w io.Writer) songEncoder
+}
+
+var exportFormats = map[string]exportFormat{
+	"ndjson": {
+		contentType: "application/x-ndjson",
+		extension:   "ndjson",
+		newEncoder:  func(w io.Writer) songEncoder { return &ndjsonEncoder{enc: json.NewEncoder(w)} },
+	},
+	"csv": {
+		contentType: "text/csv; charset=utf-8",
+		extension:   "csv",
+		newEncoder:  func(w io.Writer) songEncoder { return &csvEncoder{w: csv.NewWriter(w)} },
+	},
+	"json": {
+		contentType: "application/json; charset=utf-8",
+		extension:   "json",
+		newEncoder:  func(w io.Writer) songEncoder { return &jsonArrayEncoder{w: w} },
+	},
+}
+
+// ndjsonEncoder writes one JSON object per line
+type ndjsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonEncoder) Begin() error { return nil }
+
+func (e *ndjsonEncoder) Encode(song SongResponse) error { return e.enc.Encode(song) }
+
+func (e *ndjsonEncoder) End() error { return nil }
+
+// csvEncoder writes a header row followed by one row per song
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) Begin() error {
+	return e.w.Write([]string{"id", "group_id", "title", "release_date", "text", "link"})
+}
+
+func (e *csvEncoder) Encode(song SongResponse) error {
+	return e.w.Write([]string{
+		strconv.Itoa(song.ID),
+		strconv.Itoa(song.GroupID),
+		song.Title,
+		song.ReleaseDate,
+		song.Text,
+		song.Link,
+	})
+}
+
+func (e *csvEncoder) End() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonArrayEncoder writes a single JSON array without holding it in memory
+type jsonArrayEncoder struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonArrayEncoder) Begin() error {
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonArrayEncoder) Encode(song SongResponse) error {
+	data, err := json.Marshal(song)
+	if err != nil {
+		return err
+	}
+	if e.count > 0 {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+	e.count++
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *jsonArrayEncoder) End() error {
+	_, err := io.WriteString(e.w, "]\n")
+	return err
+}
+
+// exportWriter buffers the response body and periodically flushes it to the client
+type exportWriter struct {
+	*bufio.Writer
+	w    http.ResponseWriter
+	rows int
+}
+
+func newExportWriter(w http.ResponseWriter) *exportWriter {
+	return &exportWriter{Writer: bufio.NewWriter(w), w: w}
+}
+
+// RowWritten flushes buffered rows every exportFlushEvery rows
+func (e *exportWriter) RowWritten() error {
+	e.rows++
+	if e.rows%exportFlushEvery != 0 {
+		return nil
+	}
+	return e.FlushAll()
+}
+
+// FlushAll writes buffered data and pushes it to the client
+func (e *exportWriter) FlushAll() error {
+	if err := e.Flush(); err != nil {
+		return err
+	}
+	if f, ok := e.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
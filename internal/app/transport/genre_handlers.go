@@ -0,0 +1,175 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type GenreHandler struct {
+	genreService GenreService
+}
+
+func NewGenreHandler(genreService GenreService) *GenreHandler {
+	return &GenreHandler{
+		genreService: genreService,
+	}
+}
+
+// ListGenres godoc
+// @Summary List genres
+// @Description Get the whole genre tree ordered by path, each sub-genre following its parent
+// @Tags genres
+// @Produce json
+// @Success 200 {array} GenreResponse
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/genres [get]
+func (h *GenreHandler) ListGenres(r common.RequestReader, w http.ResponseWriter) error {
+	genres, err := h.genreService.ListGenres(r.Context())
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToGenreResponses(genres), w)
+	return nil
+}
+
+// CreateGenre godoc
+// @Summary Create a genre
+// @Description Create a top-level genre, or a sub-genre when parent_id is given. Names are unique among siblings, ignoring case.
+// @Tags genres
+// @Accept json
+// @Produce json
+// @Param genre body GenreRequest true "Genre"
+// @Success 200 {object} GenreResponse
+// @Failure 400,404,409,500 {object} map[string]string
+// @Router /api/v1/genres [post]
+func (h *GenreHandler) CreateGenre(r common.RequestReader, w http.ResponseWriter) error {
+	var req GenreRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	genre, err := h.genreService.CreateGenre(r.Context(), req.Name, req.ParentID)
+	if err != nil {
+		respondGenreError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToGenreResponse(*genre), w)
+	return nil
+}
+
+// DeleteGenre godoc
+// @Summary Delete a genre
+// @Description Delete a genre together with its sub-genres; their songs are kept
+// @Tags genres
+// @Produce json
+// @Param id path int true "Genre ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/genres/{id} [delete]
+func (h *GenreHandler) DeleteGenre(r common.RequestReader, w http.ResponseWriter) error {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-genre-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-genre-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	if err := h.genreService.DeleteGenre(r.Context(), id); err != nil {
+		respondGenreError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Deleted genre", w)
+	return nil
+}
+
+// GetSongGenres godoc
+// @Summary List the genres of a song
+// @Description Get the genres of a song ordered by path
+// @Tags genres
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {array} GenreResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/genres [get]
+func (h *GenreHandler) GetSongGenres(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	genres, err := h.genreService.SongGenres(r.Context(), id)
+	if err != nil {
+		respondGenreError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToGenreResponses(genres), w)
+	return nil
+}
+
+// SetSongGenres godoc
+// @Summary Set the genres of a song
+// @Description Replace the genres of a song; an empty list removes them all
+// @Tags genres
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param genres body SongGenresRequest true "Genre IDs"
+// @Success 200 {array} GenreResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/genres [put]
+func (h *GenreHandler) SetSongGenres(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	var req SongGenresRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	genres, err := h.genreService.SetSongGenres(r.Context(), id, req.GenreIDs)
+	if err != nil {
+		respondGenreError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToGenreResponses(genres), w)
+	return nil
+}
+
+// respondGenreError answers with the status matching a genre service error
+func respondGenreError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrSongNotFound), errors.Is(err, domain.ErrGenreNotFound):
+		server.NotFound(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrNotFound):
+		server.NotFound("genre-not-found", err, w)
+	case errors.Is(err, domain.ErrDuplicate):
+		server.Conflict("duplicate-genre", err, w)
+	case errors.Is(err, domain.ErrInvalidID):
+		server.BadRequest("invalid-genre-id", err, w)
+	case errors.Is(err, domain.ErrRequired):
+		server.BadRequest("name-required", err, w)
+	case errors.Is(err, domain.ErrValidation):
+		server.BadRequest("invalid-genre-name", err, w)
+	default:
+		server.RespondWithError(err, w)
+	}
+}
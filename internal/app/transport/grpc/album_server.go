@@ -0,0 +1,202 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	pb "songs/internal/app/proto"
+	"songs/internal/app/transport"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// albumServer implements the AlbumService RPCs
+type albumServer struct {
+	pb.UnimplementedAlbumServiceServer
+	albums transport.AlbumService
+}
+
+func (s *albumServer) CreateAlbum(ctx context.Context, req *pb.CreateAlbumRequest) (*pb.Album, error) {
+	album, err := toDomainAlbum(req.Group, req.Title, req.ReleaseDate, req.Type, req.CoverUrl, req.Tracks)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.albums.CreateAlbum(ctx, album)
+	if err != nil {
+		return nil, albumStatus(err, "failed to create album")
+	}
+	return toPBAlbum(created), nil
+}
+
+func (s *albumServer) GetAlbum(ctx context.Context, req *pb.GetAlbumRequest) (*pb.Album, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid album ID format")
+	}
+
+	album, err := s.albums.GetAlbum(ctx, id)
+	if err != nil {
+		return nil, albumStatus(err, "failed to get album")
+	}
+	return toPBAlbum(album), nil
+}
+
+func (s *albumServer) ListAlbums(ctx context.Context, req *pb.ListAlbumsRequest) (*pb.ListAlbumsResponse, error) {
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	groupID := 0
+	if req.Group != "" {
+		var err error
+		if groupID, err = strconv.Atoi(req.Group); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid group ID format")
+		}
+	}
+
+	albums, total, err := s.albums.ListAlbums(ctx, groupID, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list albums")
+	}
+
+	response := &pb.ListAlbumsResponse{
+		Total: total,
+		Page:  req.Page,
+		Pages: int32((int(total) + int(req.PageSize) - 1) / int(req.PageSize)),
+	}
+	for _, album := range albums {
+		response.Albums = append(response.Albums, toPBAlbum(album))
+	}
+	return response, nil
+}
+
+func (s *albumServer) UpdateAlbum(ctx context.Context, req *pb.UpdateAlbumRequest) (*pb.Album, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid album ID format")
+	}
+
+	album, err := toDomainAlbum(req.Group, req.Title, req.ReleaseDate, req.Type, req.CoverUrl, req.Tracks)
+	if err != nil {
+		return nil, err
+	}
+	if !req.ReplaceTracks {
+		album.Tracks = nil
+	}
+
+	updated, err := s.albums.UpdateAlbum(ctx, id, album)
+	if err != nil {
+		return nil, albumStatus(err, "failed to update album")
+	}
+	return toPBAlbum(updated), nil
+}
+
+func (s *albumServer) DeleteAlbum(ctx context.Context, req *pb.DeleteAlbumRequest) (*pb.DeleteAlbumResponse, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid album ID format")
+	}
+
+	if err := s.albums.DeleteAlbum(ctx, id); err != nil {
+		return nil, albumStatus(err, "failed to delete album")
+	}
+	return &pb.DeleteAlbumResponse{Success: true}, nil
+}
+
+func (s *albumServer) ListAlbumTracks(ctx context.Context, req *pb.ListAlbumTracksRequest) (*pb.ListAlbumTracksResponse, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid album ID format")
+	}
+
+	tracks, err := s.albums.GetAlbumTracks(ctx, id)
+	if err != nil {
+		return nil, albumStatus(err, "failed to list tracks")
+	}
+	return &pb.ListAlbumTracksResponse{Tracks: toPBAlbumTracks(tracks)}, nil
+}
+
+// albumStatus converts an album service error into a gRPC status
+func albumStatus(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "album not found")
+	case errors.Is(err, domain.ErrGroupNotFound), errors.Is(err, domain.ErrSongNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrReleaseDateInherited):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrInvalidID), errors.Is(err, domain.ErrRequired),
+		errors.Is(err, domain.ErrInvalidAlbumType), errors.Is(err, domain.ErrInvalidTrack):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, internalMessage)
+	}
+}
+
+// toDomainAlbum converts gRPC album fields into an album
+func toDomainAlbum(group, title, releaseDate, albumType, coverURL string, tracks []*pb.AlbumTrack) (*domain.Album, error) {
+	groupID, err := strconv.Atoi(group)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid group ID format")
+	}
+
+	date, err := time.Parse("2006-01-02", releaseDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid release date format")
+	}
+
+	album := &domain.Album{
+		GroupID:     groupID,
+		Title:       title,
+		ReleaseDate: date,
+		Type:        domain.AlbumType(albumType),
+		CoverURL:    coverURL,
+		Tracks:      make([]domain.AlbumTrack, len(tracks)),
+	}
+	for i, track := range tracks {
+		songID, err := strconv.Atoi(track.SongId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid song ID format")
+		}
+		album.Tracks[i] = domain.AlbumTrack{
+			DiscNumber:         int(track.DiscNumber),
+			TrackNumber:        int(track.TrackNumber),
+			InheritReleaseDate: track.InheritReleaseDate,
+			SongID:             songID,
+		}
+	}
+	return album, nil
+}
+
+func toPBAlbum(album *domain.Album) *pb.Album {
+	return &pb.Album{
+		Id:          strconv.Itoa(album.ID),
+		Group:       strconv.Itoa(album.GroupID),
+		Title:       album.Title,
+		ReleaseDate: album.ReleaseDate.Format("2006-01-02"),
+		Type:        string(album.Type),
+		CoverUrl:    album.CoverURL,
+		Tracks:      toPBAlbumTracks(album.Tracks),
+	}
+}
+
+func toPBAlbumTracks(tracks []domain.AlbumTrack) []*pb.AlbumTrack {
+	out := make([]*pb.AlbumTrack, len(tracks))
+	for i, track := range tracks {
+		out[i] = &pb.AlbumTrack{
+			DiscNumber:         int32(track.DiscNumber),
+			TrackNumber:        int32(track.TrackNumber),
+			InheritReleaseDate: track.InheritReleaseDate,
+			SongId:             strconv.Itoa(track.SongID),
+			Song:               toPBSong(track.Song),
+		}
+	}
+	return out
+}
@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/middleware"
+	"strings"
+
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Prefixes of the method names of the API services
+const (
+	songServicePrefix     = "/song.v1.SongService/"
+	playlistServicePrefix = "/song.v1.PlaylistService/"
+	albumServicePrefix    = "/song.v1.AlbumService/"
+)
+
+var apiServicePrefixes = []string{songServicePrefix, playlistServicePrefix, albumServicePrefix}
+
+// isAPIMethod tells methods of the API services apart from other services,
+// such as reflection
+func isAPIMethod(method string) bool {
+	for _, prefix := range apiServicePrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// methodRoles lists the role each API method requires. Methods missing here
+// require the admin role, so new RPCs are closed by default.
+var methodRoles = map[string]domain.Role{
+	songServicePrefix + "GetSong":          domain.RoleReader,
+	songServicePrefix + "ListSongs":        domain.RoleReader,
+	songServicePrefix + "ExportSongs":      domain.RoleReader,
+	songServicePrefix + "CreateSong":       domain.RoleEditor,
+	songServicePrefix + "UpdateSong":       domain.RoleEditor,
+	songServicePrefix + "DeleteSong":       domain.RoleEditor,
+	songServicePrefix + "BatchCreateSongs": domain.RoleEditor,
+	songServicePrefix + "BatchUpdateSongs": domain.RoleEditor,
+	songServicePrefix + "BatchDeleteSongs": domain.RoleEditor,
+	songServicePrefix + "SimilarSongs":     domain.RoleReader,
+	songServicePrefix + "WatchSongs":       domain.RoleReader,
+
+	playlistServicePrefix + "GetPlaylist":        domain.RoleReader,
+	playlistServicePrefix + "ListPlaylists":      domain.RoleReader,
+	playlistServicePrefix + "ListSongPlaylists":  domain.RoleReader,
+	playlistServicePrefix + "CreatePlaylist":     domain.RoleEditor,
+	playlistServicePrefix + "RenamePlaylist":     domain.RoleEditor,
+	playlistServicePrefix + "DeletePlaylist":     domain.RoleEditor,
+	playlistServicePrefix + "AddPlaylistSong":    domain.RoleEditor,
+	playlistServicePrefix + "RemovePlaylistSong": domain.RoleEditor,
+	playlistServicePrefix + "MovePlaylistSong":   domain.RoleEditor,
+	playlistServicePrefix + "DuplicatePlaylist":  domain.RoleEditor,
+
+	albumServicePrefix + "GetAlbum":        domain.RoleReader,
+	albumServicePrefix + "ListAlbums":      domain.RoleReader,
+	albumServicePrefix + "ListAlbumTracks": domain.RoleReader,
+	albumServicePrefix + "CreateAlbum":     domain.RoleEditor,
+	albumServicePrefix + "UpdateAlbum":     domain.RoleEditor,
+	albumServicePrefix + "DeleteAlbum":     domain.RoleEditor,
+}
+
+// requiredRole returns the role needed to call a method; methods of other
+// services are public
+func requiredRole(method string) (domain.Role, bool) {
+	if !isAPIMethod(method) {
+		return "", false
+	}
+	if role, ok := methodRoles[method]; ok {
+		return role, true
+	}
+	return domain.RoleAdmin, true
+}
+
+// authorize authenticates the caller from the "authorization" or "x-api-key"
+// metadata and checks it may call the method. It returns the context carrying
+// the caller. With a throttle, peers that presented too many invalid
+// credentials are refused before their credential is looked up.
+func authorize(ctx context.Context, auth middleware.Authenticator, throttle *middleware.AuthThrottle, method string) (context.Context, error) {
+	role, ok := requiredRole(method)
+	if !ok {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	credential := middleware.Credential(first(md.Get("authorization")), first(md.Get("x-api-key")))
+	if credential == "" {
+		return nil, status.Error(codes.Unauthenticated, domain.ErrUnauthenticated.Error())
+	}
+
+	if throttle != nil && throttle.Blocked(peerIP(ctx)) > 0 {
+		return nil, status.Error(codes.ResourceExhausted, domain.ErrRateLimited.Error())
+	}
+
+	principal, err := auth.Authenticate(ctx, credential)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthenticated) {
+			if throttle != nil {
+				throttle.Failed(ctx, peerIP(ctx))
+			}
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to authenticate")
+	}
+	if !principal.Role.Allows(role) {
+		return nil, status.Error(codes.PermissionDenied, domain.ErrForbidden.Error())
+	}
+
+	return domain.WithPrincipal(ctx, principal), nil
+}
+
+func authUnaryInterceptor(auth middleware.Authenticator, throttle *middleware.AuthThrottle) googlegrpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, auth, throttle, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func authStreamInterceptor(auth middleware.Authenticator, throttle *middleware.AuthThrottle) googlegrpc.StreamServerInterceptor {
+	return func(srv any, ss googlegrpc.ServerStream, info *googlegrpc.StreamServerInfo, handler googlegrpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), auth, throttle, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream overrides the context of a server stream
+type contextStream struct {
+	googlegrpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"songs/internal/app/domain"
+	pb "songs/internal/app/proto"
+	"songs/internal/app/transport/middleware"
+
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotencyKeyMetadata is the metadata key carrying the idempotency key
+const idempotencyKeyMetadata = "idempotency-key"
+
+// idempotentMethods maps the write methods honouring idempotency keys to
+// constructors of their response messages, used to decode stored responses
+var idempotentMethods = map[string]func() proto.Message{
+	"/song.v1.SongService/CreateSong":       func() proto.Message { return &pb.CreateSongResponse{} },
+	"/song.v1.SongService/UpdateSong":       func() proto.Message { return &pb.UpdateSongResponse{} },
+	"/song.v1.SongService/DeleteSong":       func() proto.Message { return &pb.DeleteSongResponse{} },
+	"/song.v1.SongService/BatchCreateSongs": func() proto.Message { return &pb.BatchSongsResponse{} },
+	"/song.v1.SongService/BatchUpdateSongs": func() proto.Message { return &pb.BatchSongsResponse{} },
+	"/song.v1.SongService/BatchDeleteSongs": func() proto.Message { return &pb.BatchSongsResponse{} },
+
+	"/song.v1.PlaylistService/CreatePlaylist":     func() proto.Message { return &pb.Playlist{} },
+	"/song.v1.PlaylistService/RenamePlaylist":     func() proto.Message { return &pb.Playlist{} },
+	"/song.v1.PlaylistService/DeletePlaylist":     func() proto.Message { return &pb.DeletePlaylistResponse{} },
+	"/song.v1.PlaylistService/AddPlaylistSong":    func() proto.Message { return &pb.Playlist{} },
+	"/song.v1.PlaylistService/RemovePlaylistSong": func() proto.Message { return &pb.Playlist{} },
+	"/song.v1.PlaylistService/MovePlaylistSong":   func() proto.Message { return &pb.Playlist{} },
+	"/song.v1.PlaylistService/DuplicatePlaylist":  func() proto.Message { return &pb.Playlist{} },
+
+	"/song.v1.AlbumService/CreateAlbum": func() proto.Message { return &pb.Album{} },
+	"/song.v1.AlbumService/UpdateAlbum": func() proto.Message { return &pb.Album{} },
+	"/song.v1.AlbumService/DeleteAlbum": func() proto.Message { return &pb.DeleteAlbumResponse{} },
+}
+
+// idempotencyInterceptor is the gRPC counterpart of middleware.Idempotency.
+// Only successful responses are stored; failed calls release the key.
+func idempotencyInterceptor(svc middleware.IdempotencyService) googlegrpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (any, error) {
+		newResponse, ok := idempotentMethods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(idempotencyKeyMetadata)
+		if len(keys) == 0 || keys[0] == "" {
+			return handler(ctx, req)
+		}
+		key := keys[0]
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid request")
+		}
+
+		stored, err := svc.Begin(ctx, key, middleware.Fingerprint([]byte(middleware.CallerSubject(ctx)), []byte(info.FullMethod), body))
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrIdempotencyKeyReused):
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			case errors.Is(err, domain.ErrIdempotencyInProgress):
+				return nil, status.Error(codes.Aborted, err.Error())
+			case errors.Is(err, domain.ErrInvalidData):
+				return nil, status.Error(codes.InvalidArgument, "invalid idempotency key")
+			default:
+				return nil, status.Error(codes.Internal, "failed to check idempotency key")
+			}
+		}
+
+		if stored != nil {
+			resp := newResponse()
+			if err := proto.Unmarshal(stored.Response, resp); err != nil {
+				return nil, status.Error(codes.Internal, "failed to decode stored response")
+			}
+			_ = googlegrpc.SetHeader(ctx, metadata.Pairs("idempotent-replayed", "true"))
+			return resp, nil
+		}
+
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if err := svc.Release(context.WithoutCancel(ctx), key); err != nil {
+				log.Printf("idempotency: release key: %v", err)
+			}
+		}()
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		out, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+		data, err := proto.Marshal(out)
+		if err != nil {
+			return resp, nil
+		}
+		if err := svc.Complete(context.WithoutCancel(ctx), key, int(codes.OK), "application/x-protobuf", data); err != nil {
+			log.Printf("idempotency: store response: %v", err)
+			return resp, nil
+		}
+		completed = true
+		return resp, nil
+	}
+}
@@ -0,0 +1,200 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/domain"
+	pb "songs/internal/app/proto"
+	"songs/internal/app/transport"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// playlistServer implements the PlaylistService RPCs
+type playlistServer struct {
+	pb.UnimplementedPlaylistServiceServer
+	playlists transport.PlaylistService
+}
+
+func (s *playlistServer) CreatePlaylist(ctx context.Context, req *pb.CreatePlaylistRequest) (*pb.Playlist, error) {
+	playlist, err := s.playlists.CreatePlaylist(ctx, req.Name)
+	if err != nil {
+		return nil, playlistStatus(err, "failed to create playlist")
+	}
+	return toPBPlaylist(playlist), nil
+}
+
+func (s *playlistServer) GetPlaylist(ctx context.Context, req *pb.GetPlaylistRequest) (*pb.Playlist, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist ID format")
+	}
+
+	playlist, err := s.playlists.GetPlaylist(ctx, id)
+	if err != nil {
+		return nil, playlistStatus(err, "failed to get playlist")
+	}
+	return toPBPlaylist(playlist), nil
+}
+
+func (s *playlistServer) ListPlaylists(ctx context.Context, req *pb.ListPlaylistsRequest) (*pb.ListPlaylistsResponse, error) {
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	playlists, total, err := s.playlists.ListPlaylists(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list playlists")
+	}
+
+	return &pb.ListPlaylistsResponse{
+		Playlists: toPBPlaylists(playlists),
+		Total:     total,
+		Page:      req.Page,
+		Pages:     int32((int(total) + int(req.PageSize) - 1) / int(req.PageSize)),
+	}, nil
+}
+
+func (s *playlistServer) RenamePlaylist(ctx context.Context, req *pb.RenamePlaylistRequest) (*pb.Playlist, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist ID format")
+	}
+
+	playlist, err := s.playlists.RenamePlaylist(ctx, id, req.Name)
+	if err != nil {
+		return nil, playlistStatus(err, "failed to rename playlist")
+	}
+	return toPBPlaylist(playlist), nil
+}
+
+func (s *playlistServer) DeletePlaylist(ctx context.Context, req *pb.DeletePlaylistRequest) (*pb.DeletePlaylistResponse, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist ID format")
+	}
+
+	if err := s.playlists.DeletePlaylist(ctx, id); err != nil {
+		return nil, playlistStatus(err, "failed to delete playlist")
+	}
+	return &pb.DeletePlaylistResponse{Success: true}, nil
+}
+
+func (s *playlistServer) AddPlaylistSong(ctx context.Context, req *pb.AddPlaylistSongRequest) (*pb.Playlist, error) {
+	id, err := strconv.Atoi(req.PlaylistId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist ID format")
+	}
+	songID, err := strconv.Atoi(req.SongId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid song ID format")
+	}
+
+	playlist, err := s.playlists.AddSong(ctx, id, songID, int(req.Position))
+	if err != nil {
+		return nil, playlistStatus(err, "failed to add song")
+	}
+	return toPBPlaylist(playlist), nil
+}
+
+func (s *playlistServer) RemovePlaylistSong(ctx context.Context, req *pb.RemovePlaylistSongRequest) (*pb.Playlist, error) {
+	id, err := strconv.Atoi(req.PlaylistId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist ID format")
+	}
+
+	playlist, err := s.playlists.RemoveSong(ctx, id, int(req.Position))
+	if err != nil {
+		return nil, playlistStatus(err, "failed to remove song")
+	}
+	return toPBPlaylist(playlist), nil
+}
+
+func (s *playlistServer) MovePlaylistSong(ctx context.Context, req *pb.MovePlaylistSongRequest) (*pb.Playlist, error) {
+	id, err := strconv.Atoi(req.PlaylistId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist ID format")
+	}
+
+	playlist, err := s.playlists.MoveSong(ctx, id, int(req.From), int(req.To))
+	if err != nil {
+		return nil, playlistStatus(err, "failed to move song")
+	}
+	return toPBPlaylist(playlist), nil
+}
+
+func (s *playlistServer) DuplicatePlaylist(ctx context.Context, req *pb.DuplicatePlaylistRequest) (*pb.Playlist, error) {
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid playlist ID format")
+	}
+
+	playlist, err := s.playlists.DuplicatePlaylist(ctx, id, req.Name)
+	if err != nil {
+		return nil, playlistStatus(err, "failed to duplicate playlist")
+	}
+	return toPBPlaylist(playlist), nil
+}
+
+func (s *playlistServer) ListSongPlaylists(ctx context.Context, req *pb.ListSongPlaylistsRequest) (*pb.ListPlaylistsResponse, error) {
+	songID, err := strconv.Atoi(req.SongId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid song ID format")
+	}
+
+	playlists, err := s.playlists.PlaylistsWithSong(ctx, songID)
+	if err != nil {
+		return nil, playlistStatus(err, "failed to list playlists")
+	}
+	return &pb.ListPlaylistsResponse{
+		Playlists: toPBPlaylists(playlists),
+		Total:     int64(len(playlists)),
+	}, nil
+}
+
+// playlistStatus converts a playlist service error into a gRPC status
+func playlistStatus(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, domain.ErrSongNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, "playlist not found")
+	case errors.Is(err, domain.ErrInvalidID), errors.Is(err, domain.ErrInvalidPosition),
+		errors.Is(err, domain.ErrRequired), errors.Is(err, domain.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, internalMessage)
+	}
+}
+
+func toPBPlaylist(playlist *domain.Playlist) *pb.Playlist {
+	out := &pb.Playlist{
+		Id:        strconv.Itoa(playlist.ID),
+		Name:      playlist.Name,
+		SongCount: int32(playlist.SongCount),
+		CreatedAt: playlist.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: playlist.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, entry := range playlist.Entries {
+		out.Entries = append(out.Entries, &pb.PlaylistEntry{
+			Position: int32(entry.Position),
+			AddedAt:  entry.AddedAt.Format(time.RFC3339),
+			Song:     toPBSong(entry.Song),
+		})
+	}
+	return out
+}
+
+func toPBPlaylists(playlists []*domain.Playlist) []*pb.Playlist {
+	out := make([]*pb.Playlist, len(playlists))
+	for i, playlist := range playlists {
+		out[i] = toPBPlaylist(playlist)
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/middleware"
+
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// methodCosts lists the rate limit cost of the API methods costing more
+// than one token, mirroring the HTTP routes
+var methodCosts = map[string]int{
+	songServicePrefix + "ListSongs":        3,
+	songServicePrefix + "ExportSongs":      10,
+	songServicePrefix + "BatchCreateSongs": 5,
+	songServicePrefix + "BatchUpdateSongs": 5,
+	songServicePrefix + "BatchDeleteSongs": 5,
+	songServicePrefix + "SimilarSongs":     3,
+
+	playlistServicePrefix + "ListPlaylists":     3,
+	playlistServicePrefix + "DuplicatePlaylist": 5,
+
+	albumServicePrefix + "ListAlbums": 3,
+}
+
+// rateLimit charges a call to the caller's bucket. The RateLimit-* values
+// are sent as header metadata; denied calls fail with ResourceExhausted.
+func rateLimit(ctx context.Context, limiter middleware.RateLimiter, method string) error {
+	if !isAPIMethod(method) {
+		return nil
+	}
+	cost, ok := methodCosts[method]
+	if !ok {
+		cost = 1
+	}
+
+	decision := limiter.Allow(ctx, middleware.RateLimitKey(ctx, peerIP(ctx)), cost)
+	md := metadata.MD{}
+	for name, value := range middleware.RateLimitHeaders(decision) {
+		md.Set(name, value)
+	}
+	_ = googlegrpc.SetHeader(ctx, md)
+
+	if !decision.Allowed {
+		return status.Error(codes.ResourceExhausted, domain.ErrRateLimited.Error())
+	}
+	return nil
+}
+
+// peerIP returns the IP address of the client of a call
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func rateLimitUnaryInterceptor(limiter middleware.RateLimiter) googlegrpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (any, error) {
+		if err := rateLimit(ctx, limiter, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func rateLimitStreamInterceptor(limiter middleware.RateLimiter) googlegrpc.StreamServerInterceptor {
+	return func(srv any, ss googlegrpc.ServerStream, info *googlegrpc.StreamServerInfo, handler googlegrpc.StreamHandler) error {
+		if err := rateLimit(ss.Context(), limiter, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/middleware"
+	"strings"
+
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDMetadata carries the ID of a call, as X-Request-ID does over HTTP
+var requestIDMetadata = strings.ToLower(middleware.RequestIDHeader)
+
+// withRequestID identifies a call by its x-request-id metadata, generating
+// an ID when the client sent none, and sends it back in the header. It
+// returns the context carrying the ID and the gRPC transport.
+func withRequestID(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	id := middleware.RequestIDOrNew(first(md.Get(requestIDMetadata)))
+	_ = googlegrpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, id))
+	return domain.WithRequestInfo(ctx, domain.RequestInfo{ID: id, Transport: domain.TransportGRPC})
+}
+
+func requestIDUnaryInterceptor(ctx context.Context, req any, _ *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (any, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+func requestIDStreamInterceptor(srv any, ss googlegrpc.ServerStream, _ *googlegrpc.StreamServerInfo, handler googlegrpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	googlegrpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,21 +13,45 @@ import (
 	"net"
 	"songs/internal/app/domain"
 	pb "songs/internal/app/proto"
-	"songs/internal/app/service"
+	"songs/internal/app/transport"
+	"songs/internal/app/transport/middleware"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Server struct {
 	pb.UnimplementedSongServiceServer
-	songService *service.SongService
-	addr        string
+	songService  transport.SongService
+	batchService transport.BatchService
+	playlists    transport.PlaylistService
+	albums       transport.AlbumService
+	relations    transport.RelationService
+	lyrics       transport.LyricsService
+	similarity   transport.SimilarityService
+	changeFeed   transport.ChangeFeedService
+	idempotency  middleware.IdempotencyService
+	auth         middleware.Authenticator
+	rateLimit    middleware.RateLimiter
+	authThrottle *middleware.AuthThrottle
+	addr         string
 }
 
-func NewServer(addr string, songService *service.SongService) *Server {
+func NewServer(addr string, services transport.Services) *Server {
 	return &Server{
-		songService: songService,
-		addr:        addr,
+		songService:  services.Songs,
+		batchService: services.Batch,
+		playlists:    services.Playlists,
+		albums:       services.Albums,
+		relations:    services.Relations,
+		lyrics:       services.Lyrics,
+		similarity:   services.Similarity,
+		changeFeed:   services.ChangeFeed,
+		idempotency:  services.Idempotency,
+		auth:         services.Auth,
+		rateLimit:    services.RateLimit,
+		authThrottle: services.AuthThrottle,
+		addr:         addr,
 	}
 }
 
@@ -36,8 +61,34 @@ func (s *Server) Run() error {
 		return fmt.Errorf("failed to listen on %s: %v", s.addr, err)
 	}
 
-	grpcServer := googlegrpc.NewServer()
+	// Calls are identified first, for the audit log. Authentication runs next
+	// so anonymous calls never reach the idempotency store and rate limits
+	// apply per caller.
+	interceptors := []googlegrpc.UnaryServerInterceptor{requestIDUnaryInterceptor}
+	streamInterceptors := []googlegrpc.StreamServerInterceptor{requestIDStreamInterceptor}
+	if s.auth != nil {
+		interceptors = append(interceptors, authUnaryInterceptor(s.auth, s.authThrottle))
+		streamInterceptors = append(streamInterceptors, authStreamInterceptor(s.auth, s.authThrottle))
+	}
+	if s.rateLimit != nil {
+		interceptors = append(interceptors, rateLimitUnaryInterceptor(s.rateLimit))
+		streamInterceptors = append(streamInterceptors, rateLimitStreamInterceptor(s.rateLimit))
+	}
+	if s.idempotency != nil {
+		interceptors = append(interceptors, idempotencyInterceptor(s.idempotency))
+	}
+
+	grpcServer := googlegrpc.NewServer(
+		googlegrpc.ChainUnaryInterceptor(interceptors...),
+		googlegrpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	pb.RegisterSongServiceServer(grpcServer, s)
+	if s.playlists != nil {
+		pb.RegisterPlaylistServiceServer(grpcServer, &playlistServer{playlists: s.playlists})
+	}
+	if s.albums != nil {
+		pb.RegisterAlbumServiceServer(grpcServer, &albumServer{albums: s.albums})
+	}
 
 	reflection.Register(grpcServer)
 
@@ -68,15 +119,42 @@ func (s *Server) GetSong(ctx context.Context, req *pb.GetSongRequest) (*pb.GetSo
 		return nil, status.Error(codes.Internal, "failed to get song")
 	}
 
+	pbSong := toPBSong(song)
+	if req.Lang != "" && s.lyrics != nil {
+		lang, err := domain.NormalizeLanguage(req.Lang)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		lyrics, err := s.lyrics.NegotiateLyrics(ctx, song, []string{lang}, true)
+		if errors.Is(err, domain.ErrLyricsNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to get song lyrics")
+		}
+		pbSong.Text = lyrics.Text
+		if lyrics.Language != song.Language {
+			pbSong.Language, pbSong.LanguageConfidence, pbSong.Script = lyrics.Language, 0, ""
+		}
+	}
+	if req.IncludeRelations && s.relations != nil {
+		relations, err := s.relations.SongRelations(ctx, songID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to get song relations")
+		}
+		for _, relation := range relations {
+			pbSong.Relations = append(pbSong.Relations, &pb.SongRelation{
+				Song:          strconv.Itoa(relation.SongID),
+				SongTitle:     relation.SongTitle,
+				Original:      strconv.Itoa(relation.OriginalID),
+				OriginalTitle: relation.OriginalTitle,
+				Type:          string(relation.Type),
+			})
+		}
+	}
+
 	return &pb.GetSongResponse{
-		Song: &pb.Song{
-			Id:          strconv.Itoa(song.ID),
-			Group:       strconv.Itoa(song.GroupID),
-			Name:        song.Title,
-			ReleaseDate: song.ReleaseDate.Format("2006-01-02"),
-			Text:        song.Text,
-			Link:        song.Link,
-		},
+		Song: pbSong,
 	}, nil
 }
 
@@ -104,6 +182,26 @@ func (s *Server) ListSongs(ctx context.Context, req *pb.ListSongsRequest) (*pb.L
 	if req.Link != "" {
 		filters["link"] = req.Link
 	}
+	if req.Artist != "" {
+		if _, err := strconv.Atoi(req.Artist); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid artist ID format")
+		}
+		filters["artist_id"] = req.Artist
+	}
+	if req.Language != "" {
+		if strings.EqualFold(req.Language, "und") {
+			filters["language"] = "und"
+		} else {
+			lang, err := domain.NormalizeLanguage(req.Language)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			filters["language"] = lang
+		}
+	}
+	if req.Explicit != nil {
+		filters["explicit"] = strconv.FormatBool(*req.Explicit)
+	}
 
 	songs, total, err := s.songService.GetSongs(ctx, filters, int(req.Page), int(req.PageSize))
 	if err != nil {
@@ -112,14 +210,7 @@ func (s *Server) ListSongs(ctx context.Context, req *pb.ListSongsRequest) (*pb.L
 
 	var pbSongs []*pb.Song
 	for _, song := range songs {
-		pbSongs = append(pbSongs, &pb.Song{
-			Id:          strconv.Itoa(song.ID),
-			Group:       strconv.Itoa(song.GroupID),
-			Name:        song.Title,
-			ReleaseDate: song.ReleaseDate.Format("2006-01-02"),
-			Text:        song.Text,
-			Link:        song.Link,
-		})
+		pbSongs = append(pbSongs, toPBSong(song))
 	}
 
 	totalInt := int(total)
@@ -144,28 +235,30 @@ func (s *Server) CreateSong(ctx context.Context, req *pb.CreateSongRequest) (*pb
 		return nil, status.Error(codes.InvalidArgument, "invalid release date format")
 	}
 
+	credits, err := toDomainCredits(req.Credits)
+	if err != nil {
+		return nil, err
+	}
+
 	song := &domain.Song{
 		GroupID:     groupID,
 		Title:       req.Name,
 		ReleaseDate: releaseDate,
 		Text:        req.Text,
 		Link:        req.Link,
+		Credits:     credits,
+	}
+	if req.Explicit != nil {
+		song.Explicit, song.ExplicitManual = *req.Explicit, true
 	}
 
 	createdSong, err := s.songService.CreateSong(ctx, song)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to create song")
+		return nil, songWriteStatus(err, "failed to create song")
 	}
 
 	return &pb.CreateSongResponse{
-		Song: &pb.Song{
-			Id:          strconv.Itoa(createdSong.ID),
-			Group:       strconv.Itoa(createdSong.GroupID),
-			Name:        createdSong.Title,
-			ReleaseDate: createdSong.ReleaseDate.Format("2006-01-02"),
-			Text:        createdSong.Text,
-			Link:        createdSong.Link,
-		},
+		Song: toPBSong(createdSong),
 	}, nil
 }
 
@@ -193,21 +286,25 @@ func (s *Server) UpdateSong(ctx context.Context, req *pb.UpdateSongRequest) (*pb
 		Text:        req.Text,
 		Link:        req.Link,
 	}
+	if req.Explicit != nil {
+		song.Explicit, song.ExplicitManual = *req.Explicit, true
+	}
+	if req.ReplaceCredits {
+		if song.Credits, err = toDomainCredits(req.Credits); err != nil {
+			return nil, err
+		}
+		if song.Credits == nil {
+			song.Credits = []domain.Credit{}
+		}
+	}
 
 	updatedSong, err := s.songService.UpdateSong(ctx, songID, song)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to update song")
+		return nil, songWriteStatus(err, "failed to update song")
 	}
 
 	return &pb.UpdateSongResponse{
-		Song: &pb.Song{
-			Id:          strconv.Itoa(updatedSong.ID),
-			Group:       strconv.Itoa(updatedSong.GroupID),
-			Name:        updatedSong.Title,
-			ReleaseDate: updatedSong.ReleaseDate.Format("2006-01-02"),
-			Text:        updatedSong.Text,
-			Link:        updatedSong.Link,
-		},
+		Song: toPBSong(updatedSong),
 	}, nil
 }
 
@@ -226,3 +323,299 @@ func (s *Server) DeleteSong(ctx context.Context, req *pb.DeleteSongRequest) (*pb
 		Success: true,
 	}, nil
 }
+
+func (s *Server) SimilarSongs(ctx context.Context, req *pb.SimilarSongsRequest) (*pb.SimilarSongsResponse, error) {
+	if s.similarity == nil {
+		return nil, status.Error(codes.Unimplemented, "song recommendations are disabled")
+	}
+
+	songID, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid song ID format")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = domain.DefaultSimilarSongs
+	}
+	if limit > domain.MaxSimilarSongs {
+		limit = domain.MaxSimilarSongs
+	}
+
+	similar, err := s.similarity.SimilarSongs(ctx, songID, limit)
+	if errors.Is(err, domain.ErrSongNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find similar songs")
+	}
+
+	response := &pb.SimilarSongsResponse{Songs: make([]*pb.SimilarSong, len(similar))}
+	for i, match := range similar {
+		response.Songs[i] = &pb.SimilarSong{Song: toPBSong(match.Song), Score: match.Score}
+	}
+	return response, nil
+}
+
+func (s *Server) ExportSongs(req *pb.ExportSongsRequest, stream pb.SongService_ExportSongsServer) error {
+	filters := make(map[string]string)
+	if req.Group != "" {
+		if _, err := strconv.Atoi(req.Group); err != nil {
+			return status.Error(codes.InvalidArgument, "invalid group ID format")
+		}
+		filters["group_id"] = req.Group
+	}
+	if req.Song != "" {
+		filters["title"] = req.Song
+	}
+
+	err := s.songService.ExportSongs(stream.Context(), filters, func(song *domain.Song) error {
+		return stream.Send(toPBSong(song))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, "failed to export songs")
+	}
+
+	return nil
+}
+
+func (s *Server) WatchSongs(req *pb.WatchSongsRequest, stream pb.SongService_WatchSongsServer) error {
+	if s.changeFeed == nil {
+		return status.Error(codes.Unimplemented, "the change feed is disabled")
+	}
+
+	afterSeq := int64(-1)
+	if req.AfterSeq != nil {
+		if *req.AfterSeq < 0 {
+			return status.Error(codes.InvalidArgument, "after_seq must not be negative")
+		}
+		afterSeq = *req.AfterSeq
+	}
+
+	err := s.changeFeed.Watch(stream.Context(), afterSeq, func(event domain.SongEvent) error {
+		pbEvent := &pb.SongEvent{
+			Seq:  event.Seq,
+			Type: string(event.Type),
+			Time: event.Time.Format(time.RFC3339),
+		}
+		if event.SongID != 0 {
+			pbEvent.SongId = strconv.Itoa(event.SongID)
+		}
+		if event.Song != nil {
+			pbEvent.Song = toPBSong(event.Song)
+		}
+		return stream.Send(pbEvent)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, "failed to watch songs")
+	}
+
+	return nil
+}
+
+func toPBSong(song *domain.Song) *pb.Song {
+	pbSong := &pb.Song{
+		Id:                 strconv.Itoa(song.ID),
+		Group:              strconv.Itoa(song.GroupID),
+		Name:               song.Title,
+		ReleaseDate:        song.ReleaseDate.Format("2006-01-02"),
+		Text:               song.Text,
+		Link:               song.Link,
+		Language:           song.Language,
+		LanguageConfidence: float32(song.LanguageConfidence),
+		Script:             song.Script,
+		Explicit:           song.Explicit,
+		ExplicitManual:     song.ExplicitManual,
+	}
+	for _, credit := range song.Credits {
+		pbSong.Credits = append(pbSong.Credits, &pb.Credit{
+			Artist:     strconv.Itoa(credit.ArtistID),
+			ArtistName: credit.ArtistName,
+			Role:       string(credit.Role),
+		})
+	}
+	for _, dup := range song.NearDuplicates {
+		pbSong.NearDuplicates = append(pbSong.NearDuplicates, &pb.NearDuplicate{
+			Song:       strconv.Itoa(dup.SongID),
+			Title:      dup.Title,
+			Similarity: dup.Similarity,
+		})
+	}
+	return pbSong
+}
+
+// toDomainCredits converts gRPC credits, keeping nil for none
+func toDomainCredits(credits []*pb.Credit) ([]domain.Credit, error) {
+	if len(credits) == 0 {
+		return nil, nil
+	}
+
+	out := make([]domain.Credit, len(credits))
+	for i, credit := range credits {
+		artistID, err := strconv.Atoi(credit.Artist)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid artist ID format")
+		}
+		out[i] = domain.Credit{ArtistID: artistID, Role: domain.CreditRole(credit.Role)}
+	}
+	return out, nil
+}
+
+// nearDuplicateMessage names the closest song a rejected song duplicates
+func nearDuplicateMessage(err error) string {
+	var dupErr *domain.NearDuplicateError
+	if errors.As(err, &dupErr) && len(dupErr.Matches) > 0 {
+		closest := dupErr.Matches[0]
+		return fmt.Sprintf("%s: song %d %q (similarity %.2f)", err, closest.SongID, closest.Title, closest.Similarity)
+	}
+	return err.Error()
+}
+
+// songWriteStatus converts an error of a song write into a gRPC status
+func songWriteStatus(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, domain.ErrDuplicate):
+		return status.Error(codes.AlreadyExists, "song already exists")
+	case errors.Is(err, domain.ErrNearDuplicate):
+		return status.Error(codes.AlreadyExists, nearDuplicateMessage(err))
+	case errors.Is(err, domain.ErrInvalidCredit):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrGroupNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, internalMessage)
+	}
+}
+
+func (s *Server) BatchCreateSongs(ctx context.Context, req *pb.BatchCreateSongsRequest) (*pb.BatchSongsResponse, error) {
+	mode, err := parseBatchMode(req.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.BatchItem, len(req.Songs))
+	for i, song := range req.Songs {
+		items[i] = toBatchItem(0, song.Group, song.Name, song.ReleaseDate, song.Text, song.Link)
+	}
+
+	report, err := s.batchService.CreateSongs(ctx, items, mode)
+	return toPBBatchResponse(report, err)
+}
+
+func (s *Server) BatchUpdateSongs(ctx context.Context, req *pb.BatchUpdateSongsRequest) (*pb.BatchSongsResponse, error) {
+	mode, err := parseBatchMode(req.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.BatchItem, len(req.Songs))
+	for i, song := range req.Songs {
+		songID, err := strconv.Atoi(song.Id)
+		if err != nil || songID <= 0 {
+			items[i] = domain.BatchItem{Err: domain.ErrInvalidID}
+			continue
+		}
+		items[i] = toBatchItem(songID, song.Group, song.Name, song.ReleaseDate, song.Text, song.Link)
+	}
+
+	report, err := s.batchService.UpdateSongs(ctx, items, mode)
+	return toPBBatchResponse(report, err)
+}
+
+func (s *Server) BatchDeleteSongs(ctx context.Context, req *pb.BatchDeleteSongsRequest) (*pb.BatchSongsResponse, error) {
+	mode, err := parseBatchMode(req.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.BatchItem, len(req.Ids))
+	for i, id := range req.Ids {
+		songID, err := strconv.Atoi(id)
+		if err != nil || songID <= 0 {
+			items[i] = domain.BatchItem{Err: domain.ErrInvalidID}
+			continue
+		}
+		items[i] = domain.BatchItem{ID: songID}
+	}
+
+	report, err := s.batchService.DeleteSongs(ctx, items, mode)
+	return toPBBatchResponse(report, err)
+}
+
+func parseBatchMode(mode string) (domain.BatchMode, error) {
+	switch mode {
+	case "", string(domain.BatchModeAtomic):
+		return domain.BatchModeAtomic, nil
+	case string(domain.BatchModeBestEffort):
+		return domain.BatchModeBestEffort, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid batch mode")
+	}
+}
+
+// toBatchItem converts gRPC song fields into a batch item, keeping parse
+// errors on the item so they are reported per item
+func toBatchItem(id int, group, name, releaseDate, text, link string) domain.BatchItem {
+	groupID, err := strconv.Atoi(group)
+	if err != nil {
+		return domain.BatchItem{ID: id, Err: domain.ErrInvalidID}
+	}
+
+	date, err := time.Parse("2006-01-02", releaseDate)
+	if err != nil {
+		return domain.BatchItem{ID: id, Err: domain.ErrInvalidData}
+	}
+
+	return domain.BatchItem{
+		ID: id,
+		Song: &domain.Song{
+			ID:          id,
+			GroupID:     groupID,
+			Title:       name,
+			ReleaseDate: date,
+			Text:        text,
+			Link:        link,
+		},
+	}
+}
+
+func toPBBatchResponse(report *domain.BatchReport, err error) (*pb.BatchSongsResponse, error) {
+	if err != nil {
+		if errors.Is(err, domain.ErrBatchTooLarge) || errors.Is(err, domain.ErrRequired) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to apply batch")
+	}
+
+	results := make([]*pb.BatchItemResult, len(report.Results))
+	for i, result := range report.Results {
+		item := &pb.BatchItemResult{
+			Index:  int32(result.Index),
+			Status: transport.BatchItemStatus(result.Err),
+		}
+		if result.ID > 0 {
+			item.Id = strconv.Itoa(result.ID)
+		}
+		if result.Song != nil {
+			item.Song = toPBSong(result.Song)
+		}
+		if result.Err != nil {
+			item.Slug = transport.ErrorSlug(result.Err)
+			item.Error = result.Err.Error()
+		}
+		results[i] = item
+	}
+
+	return &pb.BatchSongsResponse{
+		Mode:      string(report.Mode),
+		Committed: report.Committed,
+		Succeeded: int32(report.Succeeded),
+		Failed:    int32(report.Failed),
+		Results:   results,
+	}, nil
+}
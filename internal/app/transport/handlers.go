@@ -2,31 +2,42 @@ package transport
 
 import (
 	"errors"
+	"fmt"
+	"log"
 	"net/http"
 	"songs/internal/app/common"
 	"songs/internal/app/common/server"
 	"songs/internal/app/domain"
 	"strconv"
+	"strings"
 )
 
 type Handler struct {
-	songService SongService
+	songService     SongService
+	relationService RelationService
+	lyricsService   LyricsService
 }
 
-func NewHandler(songService SongService) *Handler {
+func NewHandler(songService SongService, relationService RelationService, lyricsService LyricsService) *Handler {
 	return &Handler{
-		songService: songService,
+		songService:     songService,
+		relationService: relationService,
+		lyricsService:   lyricsService,
 	}
 }
 
 // GetSong godoc
 // @Summary Get a song by ID
-// @Description Get details of a specific song
+// @Description Get details of a specific song. The lyrics are in the language of lang, or the best match of Accept-Language falling back to the original.
 // @Tags songs
 // @Accept json
 // @Produce json
 // @Param id path int true "Song ID"
+// @Param include query string false "Comma-separated extras to include" Enums(relations)
+// @Param lang query string false "BCP-47 language of the lyrics; 404 when the song has no such lyrics"
+// @Param Accept-Language header string false "Preferred languages of the lyrics"
 // @Success 200 {object} SongResponse
+// @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /api/v1/songs/{id} [get]
@@ -43,6 +54,22 @@ func (h *Handler) GetSong(r common.RequestReader, w http.ResponseWriter) error {
 		return nil
 	}
 
+	withRelations := false
+	if include := r.DefaultQueryParam("include", ""); include != "" {
+		for _, extra := range strings.Split(include, ",") {
+			if strings.TrimSpace(extra) != "relations" {
+				server.BadRequest("invalid-include", fmt.Errorf("%w: unknown include %q", domain.ErrInvalidData, extra), w)
+				return nil
+			}
+			withRelations = true
+		}
+	}
+
+	preferences, strict, ok := lyricsPreferences(r, w)
+	if !ok {
+		return nil
+	}
+
 	song, err := h.songService.GetSong(r.Context(), songID)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
@@ -53,7 +80,27 @@ func (h *Handler) GetSong(r common.RequestReader, w http.ResponseWriter) error {
 		return nil
 	}
 
+	lyrics, ok := negotiateLyrics(r, w, h.lyricsService, song, preferences, strict)
+	if !ok {
+		return nil
+	}
+
 	response := ToSongResponse(song)
+	if lyrics != nil {
+		response.Text = lyrics.Text
+		if lyrics.Language != song.Language {
+			// Only the detected language comes with a confidence
+			response.Language, response.LanguageConfidence, response.Script = lyrics.Language, 0, ""
+		}
+	}
+	if withRelations {
+		relations, err := h.relationService.SongRelations(r.Context(), songID)
+		if err != nil {
+			respondRelationError(err, w)
+			return nil
+		}
+		response.Relations = ToRelationResponses(relations)
+	}
 	server.RespondOK(response, w)
 	return nil
 }
@@ -66,6 +113,12 @@ func (h *Handler) GetSong(r common.RequestReader, w http.ResponseWriter) error {
 // @Produce json
 // @Param group query string false "Filter by group name"
 // @Param title query string false "Filter by song title"
+// @Param artist_id query int false "Filter by any credited artist"
+// @Param genre_id query int false "Filter by genre, sub-genres included"
+// @Param tags query string false "Filter by comma-separated tags"
+// @Param tags_match query string false "Whether songs need any or all of the tags" Enums(any, all) default(any)
+// @Param language query string false "Filter by detected BCP-47 language, regional variants included; und for unknown"
+// @Param explicit query bool false "Filter by explicit lyrics"
 // @Param page query int false "Page number" default(1)
 // @Param pageSize query int false "Number of items per page" default(10)
 // @Success 200 {object} map[string]interface{}
@@ -84,12 +137,10 @@ func (h *Handler) GetSongs(r common.RequestReader, w http.ResponseWriter) error
 		pageSize = 10
 	}
 
-	filter := make(map[string]string)
-	if title := r.QueryParam("title"); title != "" {
-		filter["title"] = title
-	}
-	if groupID := r.QueryParam("group_id"); groupID != "" {
-		filter["group_id"] = groupID
+	filter, err := songFilter(r)
+	if err != nil {
+		server.BadRequest("invalid-filter", err, w)
+		return nil
 	}
 
 	songs, total, err := h.songService.GetSongs(r.Context(), filter, page, pageSize)
@@ -116,6 +167,7 @@ func (h *Handler) GetSongs(r common.RequestReader, w http.ResponseWriter) error
 // @Param song body SongRequest true "Song object"
 // @Success 200 {object} SongResponse
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} server.ErrorResponse
 // @Router /api/v1/songs [post]
 func (h *Handler) CreateSong(r common.RequestReader, w http.ResponseWriter) error {
 	var req SongRequest
@@ -137,6 +189,9 @@ func (h *Handler) CreateSong(r common.RequestReader, w http.ResponseWriter) erro
 
 	createdSong, err := h.songService.CreateSong(r.Context(), song)
 	if err != nil {
+		if respondDuplicate(err, w) || respondCreditError(err, w) {
+			return nil
+		}
 		server.RespondWithError(err, w)
 		return nil
 	}
@@ -156,6 +211,7 @@ func (h *Handler) CreateSong(r common.RequestReader, w http.ResponseWriter) erro
 // @Param song body SongRequest true "Updated song object"
 // @Success 200 {object} SongResponse
 // @Failure 400,404 {object} map[string]string
+// @Failure 409 {object} server.ErrorResponse
 // @Router /api/v1/songs/{id} [put]
 func (h *Handler) UpdateSong(r common.RequestReader, w http.ResponseWriter) error {
 	idStr, err := r.PathParam("id")
@@ -188,6 +244,9 @@ func (h *Handler) UpdateSong(r common.RequestReader, w http.ResponseWriter) erro
 			server.NotFound("song-not-found", err, w)
 			return nil
 		}
+		if respondDuplicate(err, w) || respondCreditError(err, w) {
+			return nil
+		}
 		server.RespondWithError(err, w)
 		return nil
 	}
@@ -207,6 +266,7 @@ func (h *Handler) UpdateSong(r common.RequestReader, w http.ResponseWriter) erro
 // @Param updates body map[string]interface{} true "Fields to update"
 // @Success 200 {object} SongResponse
 // @Failure 400,404 {object} map[string]string
+// @Failure 409 {object} server.ErrorResponse
 // @Router /api/v1/songs/{id} [patch]
 func (h *Handler) PartialUpdateSong(r common.RequestReader, w http.ResponseWriter) error {
 	idStr, err := r.PathParam("id")
@@ -233,6 +293,9 @@ func (h *Handler) PartialUpdateSong(r common.RequestReader, w http.ResponseWrite
 			server.NotFound("song-not-found", err, w)
 			return nil
 		}
+		if respondDuplicate(err, w) {
+			return nil
+		}
 		server.RespondWithError(err, w)
 		return nil
 	}
@@ -287,8 +350,11 @@ func (h *Handler) DeleteSong(r common.RequestReader, w http.ResponseWriter) erro
 // @Param id path int true "Song ID"
 // @Param page query int false "Page number" default(1)
 // @Param size query int false "Number of verses per page" default(1)
+// @Param lang query string false "BCP-47 language of the lyrics; 404 when the song has no such lyrics"
+// @Param Accept-Language header string false "Preferred languages of the lyrics"
+// @Param mask query bool false "Replace the letters of explicit words with asterisks"
 // @Success 200 {object} map[string]interface{}
-// @Failure 404 {object} map[string]string
+// @Failure 400,404 {object} map[string]string
 // @Router /api/v1/songs/{id}/verses [get]
 func (h *Handler) GetSongVerses(r common.RequestReader, w http.ResponseWriter) error {
 	songIDStr, err := r.PathParam("id")
@@ -315,6 +381,20 @@ func (h *Handler) GetSongVerses(r common.RequestReader, w http.ResponseWriter) e
 		size = 1
 	}
 
+	mask, err := strconv.ParseBool(r.DefaultQueryParam("mask", "false"))
+	if err != nil {
+		server.BadRequest("invalid-mask", fmt.Errorf("%w: mask must be true or false", domain.ErrInvalidData), w)
+		return nil
+	}
+
+	preferences, strict, ok := lyricsPreferences(r, w)
+	if !ok {
+		return nil
+	}
+	if len(preferences) > 0 || mask {
+		return h.getLyricsVerses(r, w, songID, page, size, preferences, strict, mask)
+	}
+
 	verses, total, err := h.songService.GetSongVerses(r.Context(), songID, page, size)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
@@ -333,3 +413,215 @@ func (h *Handler) GetSongVerses(r common.RequestReader, w http.ResponseWriter) e
 	}, w)
 	return nil
 }
+
+// getLyricsVerses answers GetSongVerses with the verses of the lyrics in
+// the language the request asks for, if any, explicit words masked when
+// mask is set
+func (h *Handler) getLyricsVerses(r common.RequestReader, w http.ResponseWriter, songID, page, size int, preferences []string, strict, mask bool) error {
+	song, err := h.songService.GetSong(r.Context(), songID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrInvalidID) {
+			server.NotFound("song-not-found", err, w)
+			return nil
+		}
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	lyrics, ok := negotiateLyrics(r, w, h.lyricsService, song, preferences, strict)
+	if !ok {
+		return nil
+	}
+
+	text, lang := song.Text, song.Language
+	if lyrics != nil {
+		text, lang = lyrics.Text, lyrics.Language
+	}
+	if mask {
+		text = h.songService.MaskExplicit(text, lang)
+	}
+
+	verses, total := domain.PageVerses(text, page, size)
+	response := map[string]interface{}{
+		"verses": verses,
+		"total":  total,
+		"page":   page,
+		"size":   size,
+	}
+	if lyrics != nil {
+		response["language"] = lyrics.Language
+	}
+	server.RespondOK(response, w)
+	return nil
+}
+
+// ExportSongs godoc
+// @Summary Export songs
+// @Description Stream every song matching the list filters from a consistent snapshot
+// @Tags songs
+// @Produce json
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param format query string false "Export format: ndjson, csv or json" default(ndjson)
+// @Param title query string false "Filter by song title"
+// @Param group_id query int false "Filter by group ID"
+// @Param artist_id query int false "Filter by any credited artist"
+// @Param genre_id query int false "Filter by genre, sub-genres included"
+// @Param tags query string false "Filter by comma-separated tags"
+// @Param tags_match query string false "Whether songs need any or all of the tags" Enums(any, all) default(any)
+// @Param language query string false "Filter by detected BCP-47 language, regional variants included; und for unknown"
+// @Param explicit query bool false "Filter by explicit lyrics"
+// @Success 200 {array} SongResponse
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/songs:export [get]
+func (h *Handler) ExportSongs(r common.RequestReader, w http.ResponseWriter) error {
+	formatName := r.DefaultQueryParam("format", "ndjson")
+	format, ok := exportFormats[formatName]
+	if !ok {
+		server.BadRequest("unsupported-format", domain.ErrUnsupportedFormat, w)
+		return nil
+	}
+
+	filter, err := songFilter(r)
+	if err != nil {
+		server.BadRequest("invalid-filter", err, w)
+		return nil
+	}
+
+	out := newExportWriter(w)
+	enc := format.newEncoder(out)
+	started := false
+	begin := func() error {
+		started = true
+		w.Header().Set("Content-Type", format.contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="songs.%s"`, format.extension))
+		w.WriteHeader(http.StatusOK)
+		return enc.Begin()
+	}
+
+	err = h.songService.ExportSongs(r.Context(), filter, func(song *domain.Song) error {
+		if !started {
+			if err := begin(); err != nil {
+				return err
+			}
+		}
+		if err := enc.Encode(ToSongResponse(song)); err != nil {
+			return err
+		}
+		return out.RowWritten()
+	})
+	if err != nil {
+		if !started {
+			server.RespondWithError(err, w)
+			return nil
+		}
+		// Headers are already sent, the client sees a truncated body
+		log.Printf("export aborted: %v", err)
+		return nil
+	}
+
+	if !started {
+		if err := begin(); err != nil {
+			log.Printf("export aborted: %v", err)
+			return nil
+		}
+	}
+	if err := enc.End(); err != nil {
+		log.Printf("export aborted: %v", err)
+		return nil
+	}
+	if err := out.FlushAll(); err != nil {
+		log.Printf("export aborted: %v", err)
+	}
+	return nil
+}
+
+// respondDuplicate answers 409 when err reports a song clashing with an
+// existing one, or with lyrics nearly the same as those of existing ones,
+// linking to the closest song when it is known
+func respondDuplicate(err error, w http.ResponseWriter) bool {
+	var nearErr *domain.NearDuplicateError
+	if errors.As(err, &nearErr) && len(nearErr.Matches) > 0 {
+		server.ConflictAt(domain.ErrNearDuplicate.Slug(), err, fmt.Sprintf("/api/v1/songs/%d", nearErr.Matches[0].SongID), w)
+		return true
+	}
+	var dupErr *domain.DuplicateSongError
+	if errors.As(err, &dupErr) {
+		server.ConflictAt("duplicate-song", err, fmt.Sprintf("/api/v1/songs/%d", dupErr.ExistingID), w)
+		return true
+	}
+	if errors.Is(err, domain.ErrDuplicate) {
+		server.Conflict("duplicate-song", err, w)
+		return true
+	}
+	return false
+}
+
+// respondCreditError answers when err reports invalid credits or an unknown artist
+func respondCreditError(err error, w http.ResponseWriter) bool {
+	switch {
+	case errors.Is(err, domain.ErrInvalidCredit):
+		server.BadRequest(domain.ErrInvalidCredit.Slug(), err, w)
+	case errors.Is(err, domain.ErrGroupNotFound):
+		server.NotFound(domain.ErrGroupNotFound.Slug(), err, w)
+	default:
+		return false
+	}
+	return true
+}
+
+// songFilter reads the list filters shared by listing and export endpoints
+func songFilter(r common.RequestReader) (map[string]string, error) {
+	filter := make(map[string]string)
+	if title := r.QueryParam("title"); title != "" {
+		filter["title"] = title
+	}
+	if groupID := r.QueryParam("group_id"); groupID != "" {
+		filter["group_id"] = groupID
+	}
+	if artistID := r.QueryParam("artist_id"); artistID != "" {
+		if id, err := strconv.Atoi(artistID); err != nil || id <= 0 {
+			return nil, fmt.Errorf("%w: artist_id must be a positive integer", domain.ErrInvalidData)
+		}
+		filter["artist_id"] = artistID
+	}
+	if genreID := r.QueryParam("genre_id"); genreID != "" {
+		if id, err := strconv.Atoi(genreID); err != nil || id <= 0 {
+			return nil, fmt.Errorf("%w: genre_id must be a positive integer", domain.ErrInvalidData)
+		}
+		filter["genre_id"] = genreID
+	}
+	if lang := r.QueryParam("language"); lang != "" {
+		if strings.EqualFold(lang, "und") {
+			filter["language"] = "und"
+		} else {
+			normalized, err := domain.NormalizeLanguage(lang)
+			if err != nil {
+				return nil, err
+			}
+			filter["language"] = normalized
+		}
+	}
+	if explicit := r.QueryParam("explicit"); explicit != "" {
+		flag, err := strconv.ParseBool(explicit)
+		if err != nil {
+			return nil, fmt.Errorf("%w: explicit must be true or false", domain.ErrInvalidData)
+		}
+		filter["explicit"] = strconv.FormatBool(flag)
+	}
+	if tags := r.QueryParam("tags"); tags != "" {
+		names, err := domain.NormalizeTags(strings.Split(tags, ","))
+		if err != nil {
+			return nil, err
+		}
+		filter["tags"] = strings.Join(names, ",")
+
+		switch match := domain.TagMatch(r.DefaultQueryParam("tags_match", string(domain.TagMatchAny))); match {
+		case domain.TagMatchAny, domain.TagMatchAll:
+			filter["tags_match"] = string(match)
+		default:
+			return nil, fmt.Errorf("%w: tags_match must be any or all", domain.ErrInvalidData)
+		}
+	}
+	return filter, nil
+}
@@ -3,6 +3,7 @@ package transport
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -67,22 +68,42 @@ func (m *MockSongService) GetSongVerses(ctx context.Context, id int, page, size
 	return args.Get(0).([]string), args.Get(1).(int), args.Error(2)
 }
 
+func (m *MockSongService) ExportSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error {
+	args := m.Called(ctx, filter, fn)
+	if songs, ok := args.Get(0).([]*domain.Song); ok {
+		for _, song := range songs {
+			if err := fn(song); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockSongService) MaskExplicit(text, lang string) string {
+	args := m.Called(text, lang)
+	return args.String(0)
+}
+
 func setupTestRouter(mockService *MockSongService) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
 
-	handler := NewHandler(mockService)
+	handler := NewHandler(mockService, nil, nil)
 
 	// Register routes directly instead of using RegisterRoutes
 	api := router.Group("/api/v1")
 	{
-		api.GET("/songs", handler.GetSongs)
-		api.GET("/songs/:id", handler.GetSong)
+		api.GET("/songs", adapter.ToGinHandler(handler.GetSongs))
+		api.GET("/songs/:id", adapter.ToGinHandler(handler.GetSong))
 		api.POST("/songs", adapter.ToGinHandler(handler.CreateSong))
-		api.PUT("/songs/:id", handler.UpdateSong)
-		api.PATCH("/songs/:id", handler.PartialUpdateSong)
-		api.DELETE("/songs/:id", handler.DeleteSong)
-		api.GET("/songs/:id/verses", handler.GetSongVerses)
+		api.PUT("/songs/:id", adapter.ToGinHandler(handler.UpdateSong))
+		api.PATCH("/songs/:id", adapter.ToGinHandler(handler.PartialUpdateSong))
+		api.DELETE("/songs/:id", adapter.ToGinHandler(handler.DeleteSong))
+		api.GET("/songs/:id/verses", adapter.ToGinHandler(handler.GetSongVerses))
+		api.GET("/songs:method", adapter.ToGinHandler(customMethods(map[string]handlerFunc{
+			"export": handler.ExportSongs,
+		})))
 	}
 
 	return router
@@ -145,6 +166,109 @@ func TestHandler_CreateSong(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestHandler_CreateSong_Duplicate(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("CreateSong", mock.Anything, mock.Anything).
+		Return((*domain.Song)(nil), &domain.DuplicateSongError{ExistingID: 42})
+
+	body, _ := json.Marshal(SongRequest{
+		GroupID:     1,
+		Title:       "Hey, Jude!",
+		ReleaseDate: time.Now().Format(time.RFC3339),
+		Text:        "Test lyrics",
+	})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "/api/v1/songs/42", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"location":"/api/v1/songs/42"`)
+	assert.Contains(t, w.Body.String(), `"slug":"duplicate-song"`)
+}
+
+func TestHandler_CreateSong_Credits(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("CreateSong", mock.Anything, mock.MatchedBy(func(s *domain.Song) bool {
+		return len(s.Credits) == 1 && s.Credits[0] == domain.Credit{ArtistID: 4, Role: domain.CreditFeatured}
+	})).Return(&domain.Song{
+		ID:      1,
+		GroupID: 1,
+		Title:   "Get Back",
+		Credits: []domain.Credit{
+			{ArtistID: 1, ArtistName: "The Beatles", Role: domain.CreditPrimary},
+			{ArtistID: 4, ArtistName: "Billy Preston", Role: domain.CreditFeatured},
+		},
+	}, nil)
+
+	body := `{"group_id":1,"title":"Get Back","release_date":"1969-04-11T00:00:00Z","text":"Jojo was a man","credits":[{"artist_id":4,"role":"featured"}]}`
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response SongResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []CreditResponse{
+		{ArtistID: 1, ArtistName: "The Beatles", Role: "primary"},
+		{ArtistID: 4, ArtistName: "Billy Preston", Role: "featured"},
+	}, response.Credits)
+	mockService.AssertExpectations(t)
+}
+
+func TestHandler_CreateSong_CreditErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantSlug   string
+	}{
+		{name: "invalid role", err: domain.ErrInvalidCredit, wantStatus: http.StatusBadRequest, wantSlug: "invalid-credit"},
+		{name: "unknown artist", err: domain.ErrGroupNotFound, wantStatus: http.StatusNotFound, wantSlug: "group-not-found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockSongService)
+			router := setupTestRouter(mockService)
+			mockService.On("CreateSong", mock.Anything, mock.Anything).Return((*domain.Song)(nil), tt.err)
+
+			body := `{"group_id":1,"title":"Get Back","release_date":"1969-04-11T00:00:00Z","text":"Jojo was a man","credits":[{"artist_id":4,"role":"drummer"}]}`
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantSlug)
+		})
+	}
+}
+
+func TestHandler_GetSongs_ByArtist(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("GetSongs", mock.Anything, map[string]string{"artist_id": "4"}, 1, 10).
+		Return([]*domain.Song{}, int64(0), nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?artist_id=4", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
 func TestHandler_GetSongs(t *testing.T) {
 	mockService := new(MockSongService)
 	router := setupTestRouter(mockService)
@@ -176,3 +300,228 @@ func TestHandler_GetSongs(t *testing.T) {
 
 	mockService.AssertExpectations(t)
 }
+
+func TestHandler_ExportSongs(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	releaseDate := time.Date(2003, 12, 1, 0, 0, 0, 0, time.UTC)
+	songs := []*domain.Song{
+		{ID: 1, GroupID: 3, Title: "Hysteria", ReleaseDate: releaseDate, Text: "It's bugging me,\ngrating me"},
+		{ID: 2, GroupID: 3, Title: "Time Is Running Out", ReleaseDate: releaseDate},
+	}
+
+	mockService.On("ExportSongs", mock.Anything, map[string]string{"group_id": "3"}, mock.Anything).
+		Return(songs, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs:export?format=csv&group_id=3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	assert.NoError(t, err)
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, []string{"id", "group_id", "title", "release_date", "text", "link"}, records[0])
+		assert.Equal(t, "It's bugging me,\ngrating me", records[1][4])
+		assert.Equal(t, "Time Is Running Out", records[2][2])
+	}
+
+	mockService.AssertExpectations(t)
+}
+
+func TestHandler_ExportSongs_JSON(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("ExportSongs", mock.Anything, map[string]string{}, mock.Anything).
+		Return([]*domain.Song{{ID: 1, Title: "Song 1"}, {ID: 2, Title: "Song 2"}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs:export?format=json", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []SongResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response, 2)
+}
+
+func TestHandler_ExportSongs_Error(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("ExportSongs", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, domain.ErrDatabase)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs:export", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestHandler_GetSongs_ByTags(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("GetSongs", mock.Anything, map[string]string{"tags": "road trip,90s", "tags_match": "all"}, 1, 10).
+		Return([]*domain.Song{}, int64(0), nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?tags=Road%20Trip,90s&tags_match=all", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestHandler_GetSongs_InvalidTagsMatch(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?tags=live&tags_match=some", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetSongs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_GetSongs_ByLanguage(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("GetSongs", mock.Anything, map[string]string{"language": "pt-BR"}, 1, 10).
+		Return([]*domain.Song{}, int64(0), nil)
+	mockService.On("GetSongs", mock.Anything, map[string]string{"language": "und"}, 1, 10).
+		Return([]*domain.Song{}, int64(0), nil)
+
+	for _, query := range []string{"language=pt-br", "language=UND"} {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, query)
+	}
+	mockService.AssertExpectations(t)
+}
+
+func TestHandler_GetSongs_InvalidLanguage(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?language=12345", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetSongs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_GetSongs_InvalidArtist(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	for _, query := range []string{"artist_id=abc", "artist_id=0"} {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+	mockService.AssertNotCalled(t, "GetSongs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_GetSongs_InvalidGenre(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	for _, query := range []string{"genre_id=rock", "genre_id=-1"} {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+	mockService.AssertNotCalled(t, "GetSongs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandler_GetSongs_NotExplicit(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("GetSongs", mock.Anything, map[string]string{"explicit": "false"}, 1, 10).
+		Return([]*domain.Song{}, int64(0), nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs?explicit=0", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+
+	req, _ = http.NewRequest(http.MethodGet, "/api/v1/songs?explicit=maybe", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_CreateSong_Explicit(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("CreateSong", mock.Anything, mock.MatchedBy(func(song *domain.Song) bool {
+		return !song.Explicit && song.ExplicitManual
+	})).Return(&domain.Song{ID: 1, ExplicitManual: true}, nil)
+
+	body := `{"group_id":1,"title":"Clean","release_date":"2020-01-01T00:00:00Z","text":"Clean","explicit":false}`
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"explicit_manual":true`)
+	mockService.AssertExpectations(t)
+}
+
+func TestHandler_GetSongVerses_Mask(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	song := &domain.Song{ID: 1, Text: "Oh shit\n\nClean verse", Language: "en"}
+	mockService.On("GetSong", mock.Anything, 1).Return(song, nil)
+	mockService.On("MaskExplicit", song.Text, "en").Return("Oh ****\n\nClean verse")
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/verses?size=5&mask=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response struct {
+		Verses []string `json:"verses"`
+		Total  int      `json:"total"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []string{"Oh ****", "Clean verse"}, response.Verses)
+	assert.Equal(t, 2, response.Total)
+	mockService.AssertExpectations(t)
+}
+
+func TestHandler_GetSongVerses_InvalidMask(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/verses?mask=please", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetSong", mock.Anything, mock.Anything)
+}
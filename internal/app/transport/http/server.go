@@ -8,17 +8,17 @@ import (
 )
 
 type Server struct {
-	httpServer  *http.Server
-	songService transport.SongService
+	httpServer *http.Server
+	services   transport.Services
 }
 
-func NewServer(addr string, songService transport.SongService) *Server {
+func NewServer(addr string, services transport.Services) *Server {
 	server := &Server{
-		songService: songService,
+		services: services,
 	}
 
-	// Initialize router with service
-	router := transport.SetupRouter(songService)
+	// Initialize router with services
+	router := transport.SetupRouter(services)
 
 	// Setup http server
 	server.httpServer = &http.Server{
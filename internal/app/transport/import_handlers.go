@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+	"strings"
+)
+
+type ImportHandler struct {
+	importService ImportService
+}
+
+func NewImportHandler(importService ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// ImportSongs godoc
+// @Summary Bulk import songs
+// @Description Create songs from a CSV (header: group,title,release_date,text,link) or NDJSON payload and report the outcome of every row
+// @Tags songs
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Produce json
+// @Param format query string false "Payload format (csv or ndjson), detected from Content-Type when omitted"
+// @Param dry_run query bool false "Validate without writing" default(false)
+// @Success 200 {object} ImportReportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/songs:import [post]
+func (h *ImportHandler) ImportSongs(r common.RequestReader, w http.ResponseWriter) error {
+	format, ok := importFormat(r.QueryParam("format"), r.Header("Content-Type"))
+	if !ok {
+		server.BadRequest("unsupported-format", domain.ErrUnsupportedFormat, w)
+		return nil
+	}
+
+	dryRun, err := strconv.ParseBool(r.DefaultQueryParam("dry_run", "false"))
+	if err != nil {
+		server.BadRequest("invalid-dry-run", err, w)
+		return nil
+	}
+
+	report, err := h.importService.Import(r.Context(), r.Body(), format, dryRun)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidData) || errors.Is(err, domain.ErrUnsupportedFormat) {
+			server.BadRequest("invalid-import-payload", err, w)
+			return nil
+		}
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToImportReportResponse(report), w)
+	return nil
+}
+
+// importFormat picks the payload format from the query parameter or the Content-Type header
+func importFormat(param, contentType string) (domain.ImportFormat, bool) {
+	switch strings.ToLower(param) {
+	case "csv":
+		return domain.ImportFormatCSV, true
+	case "ndjson", "jsonl":
+		return domain.ImportFormatNDJSON, true
+	case "":
+	default:
+		return "", false
+	}
+
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch mediaType {
+	case "text/csv", "application/csv":
+		return domain.ImportFormatCSV, true
+	case "application/x-ndjson", "application/ndjson", "application/jsonl", "application/x-jsonlines":
+		return domain.ImportFormatNDJSON, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,96 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock import service
+type MockImportService struct {
+	mock.Mock
+}
+
+func (m *MockImportService) Import(ctx context.Context, r io.Reader, format domain.ImportFormat, dryRun bool) (*domain.ImportReport, error) {
+	args := m.Called(ctx, r, format, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ImportReport), args.Error(1)
+}
+
+func setupImportTestRouter(mockService *MockImportService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewImportHandler(mockService)
+	router.POST("/api/v1/songs:method", adapter.ToGinHandler(customMethods(map[string]handlerFunc{
+		"import": handler.ImportSongs,
+	})))
+
+	return router
+}
+
+func TestImportHandler_ImportSongs(t *testing.T) {
+	mockService := new(MockImportService)
+	router := setupImportTestRouter(mockService)
+
+	report := &domain.ImportReport{DryRun: true}
+	report.Add(domain.ImportRowResult{Row: 1, Status: domain.ImportRowCreated})
+	report.Add(domain.ImportRowResult{Row: 2, Status: domain.ImportRowFailed, Slug: "validation-error", Message: "title is required"})
+
+	mockService.On("Import", mock.Anything, mock.Anything, domain.ImportFormatCSV, true).Return(report, nil)
+
+	body := "group,title,release_date\nMuse,Hysteria,2003-12-01\nMuse,,2003-12-01\n"
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:import?dry_run=true", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response ImportReportResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.DryRun)
+	assert.Equal(t, 1, response.Created)
+	assert.Equal(t, 1, response.Failed)
+	assert.Equal(t, "title is required", response.Rows[1].Message)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestImportHandler_UnsupportedFormat(t *testing.T) {
+	mockService := new(MockImportService)
+	router := setupImportTestRouter(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:import", strings.NewReader("<songs/>"))
+	req.Header.Set("Content-Type", "application/xml")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "Import", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCustomMethods_Unknown(t *testing.T) {
+	router := setupImportTestRouter(new(MockImportService))
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:explode", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
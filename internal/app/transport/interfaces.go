@@ -2,6 +2,7 @@ package transport
 
 import (
 	"context"
+	"io"
 	"songs/internal/app/domain"
 )
 
@@ -27,4 +28,238 @@ type SongService interface {
 
 	// GetSongVerses retrieves verses of a song with pagination
 	GetSongVerses(ctx context.Context, id int, page, size int) ([]string, int, error)
+
+	// ExportSongs streams every song matching the filter to fn from a consistent snapshot
+	ExportSongs(ctx context.Context, filter map[string]string, fn func(*domain.Song) error) error
+
+	// MaskExplicit replaces the explicit words of lyrics in a language
+	MaskExplicit(text, lang string) string
+}
+
+// ImportService defines the interface for bulk song imports
+type ImportService interface {
+	// Import creates songs from a CSV or NDJSON payload and reports the outcome of every row
+	Import(ctx context.Context, r io.Reader, format domain.ImportFormat, dryRun bool) (*domain.ImportReport, error)
+}
+
+// BatchService defines the interface for bulk song writes
+type BatchService interface {
+	// CreateSongs creates every song of the batch
+	CreateSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error)
+
+	// UpdateSongs replaces every song of the batch, identified by item ID
+	UpdateSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error)
+
+	// DeleteSongs deletes every song of the batch, identified by item ID
+	DeleteSongs(ctx context.Context, items []domain.BatchItem, mode domain.BatchMode) (*domain.BatchReport, error)
+}
+
+// DuplicateService defines the interface for finding and merging duplicate songs
+type DuplicateService interface {
+	// FindDuplicates lists the sets of songs sharing a group and normalized title
+	FindDuplicates(ctx context.Context, groupID int) ([]domain.DuplicateSet, error)
+
+	// MergeSongs folds the source songs into the target and deletes them
+	MergeSongs(ctx context.Context, targetID int, sourceIDs []int) (*domain.Song, error)
+}
+
+// APIKeyService defines the interface for API key management
+type APIKeyService interface {
+	// IssueKey creates a key with the given role and returns it with its secret
+	IssueKey(ctx context.Context, name string, role domain.Role) (*domain.APIKey, string, error)
+
+	// ListKeys returns all keys, revoked ones included
+	ListKeys(ctx context.Context) ([]*domain.APIKey, error)
+
+	// RevokeKey disables a key
+	RevokeKey(ctx context.Context, id int) error
+}
+
+// PlaylistService defines the interface for playlist operations
+type PlaylistService interface {
+	// CreatePlaylist creates an empty playlist
+	CreatePlaylist(ctx context.Context, name string) (*domain.Playlist, error)
+
+	// GetPlaylist retrieves a playlist with its songs in order
+	GetPlaylist(ctx context.Context, id int) (*domain.Playlist, error)
+
+	// ListPlaylists retrieves playlists with pagination, without their songs
+	ListPlaylists(ctx context.Context, page, pageSize int) ([]*domain.Playlist, int64, error)
+
+	// RenamePlaylist changes the name of a playlist
+	RenamePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error)
+
+	// DeletePlaylist deletes a playlist
+	DeletePlaylist(ctx context.Context, id int) error
+
+	// AddSong inserts a song at a position of a playlist, 0 meaning at the end
+	AddSong(ctx context.Context, playlistID, songID, position int) (*domain.Playlist, error)
+
+	// RemoveSong removes the song at a position of a playlist
+	RemoveSong(ctx context.Context, playlistID, position int) (*domain.Playlist, error)
+
+	// MoveSong moves the song at position from to position to
+	MoveSong(ctx context.Context, playlistID, from, to int) (*domain.Playlist, error)
+
+	// DuplicatePlaylist copies a playlist and its songs under a new name
+	DuplicatePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error)
+
+	// PlaylistsWithSong lists the playlists containing a song
+	PlaylistsWithSong(ctx context.Context, songID int) ([]*domain.Playlist, error)
+}
+
+// AlbumService defines the interface for album operations
+type AlbumService interface {
+	// CreateAlbum creates an album with its track listing
+	CreateAlbum(ctx context.Context, album *domain.Album) (*domain.Album, error)
+
+	// GetAlbum retrieves an album with its tracks
+	GetAlbum(ctx context.Context, id int) (*domain.Album, error)
+
+	// ListAlbums retrieves albums, optionally of one group, with pagination
+	ListAlbums(ctx context.Context, groupID, page, pageSize int) ([]*domain.Album, int64, error)
+
+	// UpdateAlbum replaces an album, and its track listing when album.Tracks is not nil
+	UpdateAlbum(ctx context.Context, id int, album *domain.Album) (*domain.Album, error)
+
+	// DeleteAlbum deletes an album, keeping its songs
+	DeleteAlbum(ctx context.Context, id int) error
+
+	// GetAlbumTracks retrieves the tracks of an album in disc and track order
+	GetAlbumTracks(ctx context.Context, id int) ([]domain.AlbumTrack, error)
+}
+
+// TagService defines the interface for song tag operations
+type TagService interface {
+	// TagSongs adds tags to songs in bulk, reporting the songs that do not exist
+	TagSongs(ctx context.Context, songIDs []int, tags []string) (*domain.TagChange, error)
+
+	// UntagSongs removes tags from songs in bulk, reporting the songs that do not exist
+	UntagSongs(ctx context.Context, songIDs []int, tags []string) (*domain.TagChange, error)
+
+	// SongTags retrieves the tags of a song
+	SongTags(ctx context.Context, songID int) ([]string, error)
+
+	// TagCloud retrieves the most used tags with their number of songs
+	TagCloud(ctx context.Context, limit int) ([]domain.TagCount, error)
+}
+
+// GenreService defines the interface for genre operations
+type GenreService interface {
+	// CreateGenre creates a genre under a parent, or at the top level for parent 0
+	CreateGenre(ctx context.Context, name string, parentID int) (*domain.Genre, error)
+
+	// ListGenres retrieves the whole genre tree
+	ListGenres(ctx context.Context) ([]domain.Genre, error)
+
+	// DeleteGenre deletes a genre with its sub-genres
+	DeleteGenre(ctx context.Context, id int) error
+
+	// SongGenres retrieves the genres of a song
+	SongGenres(ctx context.Context, songID int) ([]domain.Genre, error)
+
+	// SetSongGenres replaces the genres of a song
+	SetSongGenres(ctx context.Context, songID int, genreIDs []int) ([]domain.Genre, error)
+}
+
+// RelationService defines the interface for covers, remixes and other versions of songs
+type RelationService interface {
+	// LinkSongs records that a song is a version of an original, refusing cycles
+	LinkSongs(ctx context.Context, songID, originalID int, relationType domain.RelationType) (*domain.SongRelation, error)
+
+	// UnlinkSongs removes the relation between a song and its original
+	UnlinkSongs(ctx context.Context, songID, originalID int) error
+
+	// SongRelations retrieves the originals of a song and its versions
+	SongRelations(ctx context.Context, songID int) ([]domain.SongRelation, error)
+
+	// SongFamily retrieves every song connected to a song through relations
+	SongFamily(ctx context.Context, songID int) (*domain.VersionFamily, error)
+}
+
+// LyricsService defines the interface for the lyrics of songs in several languages
+type LyricsService interface {
+	// ListLyrics retrieves the lyrics of a song in every language, the original first
+	ListLyrics(ctx context.Context, songID int) ([]domain.Lyrics, error)
+
+	// PutLyrics creates or replaces the lyrics of a song in a language
+	PutLyrics(ctx context.Context, lyrics domain.Lyrics) (*domain.Lyrics, error)
+
+	// DeleteLyrics removes a translation of the lyrics of a song
+	DeleteLyrics(ctx context.Context, songID int, lang string) error
+
+	// NegotiateLyrics picks the lyrics of a song best matching the preferred languages
+	NegotiateLyrics(ctx context.Context, song *domain.Song, preferences []string, strict bool) (*domain.Lyrics, error)
+
+	// AlignLyrics lays a translation side by side with the original lyrics
+	AlignLyrics(ctx context.Context, songID int, lang string) (*domain.AlignedLyrics, error)
+}
+
+// StatsService defines the interface for statistics on lyrics
+type StatsService interface {
+	// SongStats describes the lyrics of a song with its top most frequent words
+	SongStats(ctx context.Context, songID, top int) (*domain.LyricsStats, error)
+
+	// GroupStats describes the lyrics of every song of a group taken together
+	GroupStats(ctx context.Context, groupID, top int) (*domain.GroupStats, error)
+}
+
+// SimilarityService defines the interface for song recommendations
+type SimilarityService interface {
+	// SimilarSongs ranks the songs with lyrics and titles most like those of a song
+	SimilarSongs(ctx context.Context, songID, limit int) ([]domain.SimilarSong, error)
+}
+
+// NearDuplicateService defines the interface for finding songs with nearly the same lyrics
+type NearDuplicateService interface {
+	// NearDuplicateClusters lists the sets of songs linked by lyrics at least threshold similar
+	NearDuplicateClusters(ctx context.Context, threshold float64) ([]domain.NearDuplicateCluster, error)
+}
+
+// ChangeFeedService defines the interface for the change feed of songs
+type ChangeFeedService interface {
+	// Watch calls fn with the events numbered above afterSeq, then with new events, until ctx is done
+	Watch(ctx context.Context, afterSeq int64, fn func(domain.SongEvent) error) error
+}
+
+// OutboxService defines the interface for watching over the outbox of song events
+type OutboxService interface {
+	// Status counts the pending events and lists those stuck
+	Status(ctx context.Context) (*domain.OutboxStatus, error)
+
+	// Retry makes a pending event due now
+	Retry(ctx context.Context, id int64) error
+}
+
+// WebhookService defines the interface for webhook subscriptions and their deliveries
+type WebhookService interface {
+	// CreateWebhook subscribes a URL to song events, generating a secret when none is given
+	CreateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+
+	// GetWebhook returns a subscription by ID
+	GetWebhook(ctx context.Context, id int) (*domain.WebhookSubscription, error)
+
+	// ListWebhooks returns all subscriptions
+	ListWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error)
+
+	// UpdateWebhook replaces a subscription, rotating its secret when one is given
+	UpdateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+
+	// DeleteWebhook removes a subscription and its deliveries
+	DeleteWebhook(ctx context.Context, id int) error
+
+	// ListDeliveries returns the latest deliveries of a subscription, optionally of a status
+	ListDeliveries(ctx context.Context, subscriptionID int, status domain.WebhookDeliveryStatus) ([]domain.WebhookDelivery, error)
+
+	// GetDelivery returns a delivery of a subscription with the log of its attempts
+	GetDelivery(ctx context.Context, subscriptionID int, id int64) (*domain.WebhookDelivery, error)
+
+	// Redeliver posts a delivery again as soon as possible
+	Redeliver(ctx context.Context, subscriptionID int, id int64) error
+}
+
+// AuditService defines the interface for reading the audit log
+type AuditService interface {
+	// List returns a page of the entries matching filter, newest first, and the number matching
+	List(ctx context.Context, filter domain.AuditFilter, page, pageSize int) ([]domain.AuditEntry, int64, error)
 }
@@ -0,0 +1,206 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+)
+
+type LyricsHandler struct {
+	lyricsService LyricsService
+}
+
+func NewLyricsHandler(lyricsService LyricsService) *LyricsHandler {
+	return &LyricsHandler{
+		lyricsService: lyricsService,
+	}
+}
+
+// ListSongLyrics godoc
+// @Summary List the lyrics of a song
+// @Description Get the lyrics of a song in every language, the original first
+// @Tags lyrics
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {array} LyricsResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/lyrics [get]
+func (h *LyricsHandler) ListSongLyrics(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	lyrics, err := h.lyricsService.ListLyrics(r.Context(), id)
+	if err != nil {
+		respondLyricsError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToLyricsResponses(lyrics), w)
+	return nil
+}
+
+// PutSongLyrics godoc
+// @Summary Set the lyrics of a song in a language
+// @Description Create or replace the lyrics of a song in a BCP-47 language. Original lyrics become the text of the song and demote the previous original to a translation.
+// @Tags lyrics
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param lang path string true "BCP-47 language tag"
+// @Param lyrics body LyricsRequest true "Lyrics"
+// @Success 200 {object} LyricsResponse
+// @Failure 400,404,409,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/lyrics/{lang} [put]
+func (h *LyricsHandler) PutSongLyrics(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+	lang, err := r.PathParam("lang")
+	if err != nil {
+		server.BadRequest(domain.ErrInvalidLanguage.Slug(), domain.ErrInvalidLanguage, w)
+		return nil
+	}
+
+	var req LyricsRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	lyrics, err := h.lyricsService.PutLyrics(r.Context(), domain.Lyrics{
+		SongID:     id,
+		Language:   lang,
+		Text:       req.Text,
+		Original:   req.Original,
+		Translator: req.Translator,
+		Source:     req.Source,
+	})
+	if err != nil {
+		respondLyricsError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToLyricsResponse(*lyrics), w)
+	return nil
+}
+
+// DeleteSongLyrics godoc
+// @Summary Delete a translation
+// @Description Delete the lyrics of a song in a language; the original lyrics cannot be deleted
+// @Tags lyrics
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param lang path string true "BCP-47 language tag"
+// @Success 200 {object} map[string]string
+// @Failure 400,404,409,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/lyrics/{lang} [delete]
+func (h *LyricsHandler) DeleteSongLyrics(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+	lang, err := r.PathParam("lang")
+	if err != nil {
+		server.BadRequest(domain.ErrInvalidLanguage.Slug(), domain.ErrInvalidLanguage, w)
+		return nil
+	}
+
+	if err := h.lyricsService.DeleteLyrics(r.Context(), id, lang); err != nil {
+		respondLyricsError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Deleted lyrics", w)
+	return nil
+}
+
+// GetAlignedLyrics godoc
+// @Summary Compare a translation with the original
+// @Description Get the lines of a translation side by side with the lines of the original lyrics, verse by verse
+// @Tags lyrics
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param lang path string true "BCP-47 language tag of the translation"
+// @Success 200 {object} AlignedLyricsResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/lyrics/{lang}/aligned [get]
+func (h *LyricsHandler) GetAlignedLyrics(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+	lang, err := r.PathParam("lang")
+	if err != nil {
+		server.BadRequest(domain.ErrInvalidLanguage.Slug(), domain.ErrInvalidLanguage, w)
+		return nil
+	}
+
+	aligned, err := h.lyricsService.AlignLyrics(r.Context(), id, lang)
+	if err != nil {
+		respondLyricsError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToAlignedLyricsResponse(aligned), w)
+	return nil
+}
+
+// lyricsPreferences returns the languages asked for by the lang query
+// parameter, which must then be matched, or else by the Accept-Language
+// header. It answers 400 when lang is not a valid language tag.
+func lyricsPreferences(r common.RequestReader, w http.ResponseWriter) (preferences []string, strict bool, ok bool) {
+	if lang := r.QueryParam("lang"); lang != "" {
+		normalized, err := domain.NormalizeLanguage(lang)
+		if err != nil {
+			server.BadRequest(domain.ErrInvalidLanguage.Slug(), err, w)
+			return nil, false, false
+		}
+		return []string{normalized}, true, true
+	}
+	return domain.ParseAcceptLanguage(r.Header("Accept-Language")), false, true
+}
+
+// negotiateLyrics picks the lyrics of a song in the preferred languages,
+// setting Content-Language. It returns nil lyrics without preferences, and
+// false once it has answered with an error.
+func negotiateLyrics(r common.RequestReader, w http.ResponseWriter, lyricsService LyricsService, song *domain.Song, preferences []string, strict bool) (*domain.Lyrics, bool) {
+	w.Header().Add("Vary", "Accept-Language")
+	if len(preferences) == 0 {
+		return nil, true
+	}
+
+	lyrics, err := lyricsService.NegotiateLyrics(r.Context(), song, preferences, strict)
+	if err != nil {
+		respondLyricsError(err, w)
+		return nil, false
+	}
+	if lyrics.Language != "" {
+		w.Header().Set("Content-Language", lyrics.Language)
+	}
+	return lyrics, true
+}
+
+// respondLyricsError answers with the status matching a lyrics service error
+func respondLyricsError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrSongNotFound), errors.Is(err, domain.ErrLyricsNotFound):
+		server.NotFound(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrInvalidLanguage):
+		server.BadRequest(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrOriginalLyrics):
+		server.Conflict(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrRequired):
+		server.BadRequest("text-required", err, w)
+	case errors.Is(err, domain.ErrValidation):
+		server.BadRequest("invalid-lyrics-credit", err, w)
+	default:
+		if !respondDuplicate(err, w) {
+			server.RespondWithError(err, w)
+		}
+	}
+}
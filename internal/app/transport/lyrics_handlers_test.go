@@ -0,0 +1,227 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock lyrics service
+type MockLyricsService struct {
+	mock.Mock
+}
+
+func (m *MockLyricsService) ListLyrics(ctx context.Context, songID int) ([]domain.Lyrics, error) {
+	args := m.Called(ctx, songID)
+	lyrics, _ := args.Get(0).([]domain.Lyrics)
+	return lyrics, args.Error(1)
+}
+
+func (m *MockLyricsService) PutLyrics(ctx context.Context, lyrics domain.Lyrics) (*domain.Lyrics, error) {
+	args := m.Called(ctx, lyrics)
+	saved, _ := args.Get(0).(*domain.Lyrics)
+	return saved, args.Error(1)
+}
+
+func (m *MockLyricsService) DeleteLyrics(ctx context.Context, songID int, lang string) error {
+	return m.Called(ctx, songID, lang).Error(0)
+}
+
+func (m *MockLyricsService) NegotiateLyrics(ctx context.Context, song *domain.Song, preferences []string, strict bool) (*domain.Lyrics, error) {
+	args := m.Called(ctx, song, preferences, strict)
+	lyrics, _ := args.Get(0).(*domain.Lyrics)
+	return lyrics, args.Error(1)
+}
+
+func (m *MockLyricsService) AlignLyrics(ctx context.Context, songID int, lang string) (*domain.AlignedLyrics, error) {
+	args := m.Called(ctx, songID, lang)
+	aligned, _ := args.Get(0).(*domain.AlignedLyrics)
+	return aligned, args.Error(1)
+}
+
+func setupLyricsTestRouter(songService *MockSongService, lyricsService *MockLyricsService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewHandler(songService, nil, lyricsService)
+	lyricsHandler := NewLyricsHandler(lyricsService)
+	router.GET("/api/v1/songs/:id", adapter.ToGinHandler(handler.GetSong))
+	router.GET("/api/v1/songs/:id/verses", adapter.ToGinHandler(handler.GetSongVerses))
+	router.PUT("/api/v1/songs/:id/lyrics/:lang", adapter.ToGinHandler(lyricsHandler.PutSongLyrics))
+	router.DELETE("/api/v1/songs/:id/lyrics/:lang", adapter.ToGinHandler(lyricsHandler.DeleteSongLyrics))
+	router.GET("/api/v1/songs/:id/lyrics/:lang/aligned", adapter.ToGinHandler(lyricsHandler.GetAlignedLyrics))
+
+	return router
+}
+
+var seaSong = &domain.Song{ID: 1, Title: "La Mer", Text: "La mer\n\nQu'on voit danser", Language: "fr", LanguageConfidence: 0.7, Script: "Latn"}
+
+func TestHandler_GetSong_AcceptLanguage(t *testing.T) {
+	songService := new(MockSongService)
+	lyricsService := new(MockLyricsService)
+	router := setupLyricsTestRouter(songService, lyricsService)
+
+	songService.On("GetSong", mock.Anything, 1).Return(seaSong, nil)
+	lyricsService.On("NegotiateLyrics", mock.Anything, seaSong, []string{"en-GB", "fr"}, false).
+		Return(&domain.Lyrics{SongID: 1, Language: "en", Text: "The sea"}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1", nil)
+	req.Header.Set("Accept-Language", "fr;q=0.5, en-GB")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "en", w.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+
+	var response SongResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "The sea", response.Text)
+	assert.Equal(t, "en", response.Language)
+	assert.Zero(t, response.LanguageConfidence)
+	assert.Empty(t, response.Script)
+}
+
+func TestHandler_GetSong_DetectedLanguage(t *testing.T) {
+	songService := new(MockSongService)
+	lyricsService := new(MockLyricsService)
+	router := setupLyricsTestRouter(songService, lyricsService)
+
+	songService.On("GetSong", mock.Anything, 1).Return(seaSong, nil)
+	lyricsService.On("NegotiateLyrics", mock.Anything, seaSong, []string{"fr"}, false).
+		Return(&domain.Lyrics{SongID: 1, Language: "fr", Text: seaSong.Text, Original: true}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1", nil)
+	req.Header.Set("Accept-Language", "fr")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response SongResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "fr", response.Language)
+	assert.Equal(t, 0.7, response.LanguageConfidence)
+	assert.Equal(t, "Latn", response.Script)
+}
+
+func TestHandler_GetSong_LangNotAvailable(t *testing.T) {
+	songService := new(MockSongService)
+	lyricsService := new(MockLyricsService)
+	router := setupLyricsTestRouter(songService, lyricsService)
+
+	songService.On("GetSong", mock.Anything, 1).Return(seaSong, nil)
+	lyricsService.On("NegotiateLyrics", mock.Anything, seaSong, []string{"ja"}, true).
+		Return(nil, domain.ErrLyricsNotFound)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1?lang=JA", nil)
+	req.Header.Set("Accept-Language", "fr")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "lyrics-not-found")
+}
+
+func TestHandler_GetSong_InvalidLang(t *testing.T) {
+	router := setupLyricsTestRouter(new(MockSongService), new(MockLyricsService))
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1?lang=12345", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid-language")
+}
+
+func TestHandler_GetSongVerses_Lang(t *testing.T) {
+	songService := new(MockSongService)
+	lyricsService := new(MockLyricsService)
+	router := setupLyricsTestRouter(songService, lyricsService)
+
+	songService.On("GetSong", mock.Anything, 1).Return(seaSong, nil)
+	lyricsService.On("NegotiateLyrics", mock.Anything, seaSong, []string{"en"}, true).
+		Return(&domain.Lyrics{SongID: 1, Language: "en", Text: "The sea\n\nWe see dancing"}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/verses?lang=en&page=2", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []interface{}{"We see dancing"}, response["verses"])
+	assert.Equal(t, float64(2), response["total"])
+	assert.Equal(t, "en", response["language"])
+	songService.AssertNotCalled(t, "GetSongVerses", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLyricsHandler_PutSongLyrics(t *testing.T) {
+	lyricsService := new(MockLyricsService)
+	router := setupLyricsTestRouter(new(MockSongService), lyricsService)
+
+	lyricsService.On("PutLyrics", mock.Anything, domain.Lyrics{SongID: 1, Language: "en", Text: "The sea", Translator: "J. Doe"}).
+		Return(&domain.Lyrics{SongID: 1, Language: "en", Text: "The sea", Translator: "J. Doe"}, nil)
+
+	body, _ := json.Marshal(LyricsRequest{Text: "The sea", Translator: "J. Doe"})
+	req, _ := http.NewRequest(http.MethodPut, "/api/v1/songs/1/lyrics/en", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response LyricsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "en", response.Language)
+	assert.Equal(t, "J. Doe", response.Translator)
+}
+
+func TestLyricsHandler_DeleteSongLyrics_Original(t *testing.T) {
+	lyricsService := new(MockLyricsService)
+	router := setupLyricsTestRouter(new(MockSongService), lyricsService)
+
+	lyricsService.On("DeleteLyrics", mock.Anything, 1, "fr").Return(domain.ErrOriginalLyrics)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/songs/1/lyrics/fr", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "original-lyrics")
+}
+
+func TestLyricsHandler_GetAlignedLyrics(t *testing.T) {
+	lyricsService := new(MockLyricsService)
+	router := setupLyricsTestRouter(new(MockSongService), lyricsService)
+
+	lyricsService.On("AlignLyrics", mock.Anything, 1, "en").Return(domain.AlignLyrics(
+		domain.Lyrics{SongID: 1, Language: "fr", Text: seaSong.Text, Original: true},
+		domain.Lyrics{SongID: 1, Language: "en", Text: "The sea"},
+	), nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/lyrics/en/aligned", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response AlignedLyricsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "fr", response.OriginalLanguage)
+	assert.Equal(t, []AlignedLineResponse{
+		{Verse: 1, Line: 1, Original: "La mer", Translation: "The sea"},
+		{Verse: 2, Line: 1, Original: "Qu'on voit danser"},
+	}, response.Lines)
+}
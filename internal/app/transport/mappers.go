@@ -1,6 +1,9 @@
 package transport
 
 import (
+	"errors"
+	"fmt"
+	"songs/internal/app/common/slugerrors"
 	"songs/internal/app/domain"
 	"time"
 )
@@ -11,22 +14,541 @@ func ToSongDomain(req SongRequest) (*domain.Song, error) {
 		return nil, err
 	}
 
-	return &domain.Song{
+	song := &domain.Song{
 		GroupID:     req.GroupID,
 		Title:       req.Title,
 		ReleaseDate: releaseDate,
 		Text:        req.Text,
 		Link:        req.Link,
-	}, nil
+	}
+	if req.Explicit != nil {
+		song.Explicit, song.ExplicitManual = *req.Explicit, true
+	}
+	if req.Credits != nil {
+		song.Credits = make([]domain.Credit, len(req.Credits))
+		for i, credit := range req.Credits {
+			song.Credits[i] = domain.Credit{ArtistID: credit.ArtistID, Role: domain.CreditRole(credit.Role)}
+		}
+	}
+	return song, nil
 }
 
 func ToSongResponse(song *domain.Song) SongResponse {
 	return SongResponse{
-		ID:          song.ID,
-		GroupID:     song.GroupID,
-		Title:       song.Title,
-		ReleaseDate: song.ReleaseDate.Format(time.RFC3339),
-		Text:        song.Text,
-		Link:        song.Link,
+		ID:                 song.ID,
+		GroupID:            song.GroupID,
+		Title:              song.Title,
+		ReleaseDate:        song.ReleaseDate.Format(time.RFC3339),
+		Text:               song.Text,
+		Link:               song.Link,
+		Credits:            ToCreditResponses(song.Credits),
+		Language:           song.Language,
+		LanguageConfidence: song.LanguageConfidence,
+		Script:             song.Script,
+		Explicit:           song.Explicit,
+		ExplicitManual:     song.ExplicitManual,
+		NearDuplicates:     ToNearDuplicateResponses(song.NearDuplicates),
+	}
+}
+
+func ToNearDuplicateResponses(dups []domain.NearDuplicate) []NearDuplicateResponse {
+	if len(dups) == 0 {
+		return nil
+	}
+	responses := make([]NearDuplicateResponse, len(dups))
+	for i, dup := range dups {
+		responses[i] = NearDuplicateResponse{
+			SongID:     dup.SongID,
+			Title:      dup.Title,
+			Similarity: dup.Similarity,
+		}
+	}
+	return responses
+}
+
+func ToCreditResponses(credits []domain.Credit) []CreditResponse {
+	if credits == nil {
+		return nil
+	}
+
+	responses := make([]CreditResponse, len(credits))
+	for i, credit := range credits {
+		responses[i] = CreditResponse{
+			ArtistID:   credit.ArtistID,
+			ArtistName: credit.ArtistName,
+			Role:       string(credit.Role),
+		}
+	}
+	return responses
+}
+
+func ToImportReportResponse(report *domain.ImportReport) ImportReportResponse {
+	rows := make([]ImportRowResponse, len(report.Rows))
+	for i, row := range report.Rows {
+		rows[i] = ImportRowResponse{
+			Row:     row.Row,
+			Status:  string(row.Status),
+			SongID:  row.SongID,
+			Slug:    row.Slug,
+			Message: row.Message,
+		}
+	}
+
+	return ImportReportResponse{
+		DryRun:  report.DryRun,
+		Created: report.Created,
+		Skipped: report.Skipped,
+		Failed:  report.Failed,
+		Rows:    rows,
+	}
+}
+
+// ToBatchItem converts a song request into a batch item, keeping validation
+// errors on the item so they are reported per item
+func ToBatchItem(id int, req SongRequest) domain.BatchItem {
+	if err := req.Validate(); err != nil {
+		return domain.BatchItem{ID: id, Err: fmt.Errorf("%w: %s", domain.ErrValidation, err)}
+	}
+
+	song, err := ToSongDomain(req)
+	if err != nil {
+		return domain.BatchItem{ID: id, Err: domain.ErrInvalidData}
+	}
+	song.ID = id
+
+	return domain.BatchItem{ID: id, Song: song}
+}
+
+func ToBatchResponse(report *domain.BatchReport) BatchResponse {
+	results := make([]BatchItemResponse, len(report.Results))
+	for i, result := range report.Results {
+		item := BatchItemResponse{
+			Index:  result.Index,
+			ID:     result.ID,
+			Status: BatchItemStatus(result.Err),
+		}
+		if result.Song != nil {
+			song := ToSongResponse(result.Song)
+			item.Song = &song
+		}
+		if result.Err != nil {
+			item.Slug = ErrorSlug(result.Err)
+			item.Error = result.Err.Error()
+		}
+		results[i] = item
+	}
+
+	return BatchResponse{
+		Mode:      string(report.Mode),
+		Committed: report.Committed,
+		Succeeded: report.Succeeded,
+		Failed:    report.Failed,
+		Results:   results,
+	}
+}
+
+// BatchItemStatus describes the outcome of a batch item: ok, failed or aborted
+func BatchItemStatus(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, domain.ErrBatchAborted):
+		return "aborted"
+	default:
+		return "failed"
+	}
+}
+
+// ErrorSlug returns the slug of a domain error, or the internal error slug
+func ErrorSlug(err error) string {
+	var slugErr slugerrors.SlugError
+	if errors.As(err, &slugErr) {
+		return slugErr.Slug()
+	}
+	return domain.ErrInternal.Slug()
+}
+
+func ToDuplicatesResponse(sets []domain.DuplicateSet) DuplicatesResponse {
+	response := DuplicatesResponse{
+		Total:      len(sets),
+		Duplicates: make([]DuplicateSetResponse, len(sets)),
+	}
+	for i, set := range sets {
+		songs := make([]SongResponse, len(set.Songs))
+		for j, song := range set.Songs {
+			songs[j] = ToSongResponse(song)
+		}
+		response.Duplicates[i] = DuplicateSetResponse{
+			GroupID:  set.GroupID,
+			TitleKey: set.TitleKey,
+			Songs:    songs,
+		}
+	}
+	return response
+}
+
+func ToNearDuplicatesResponse(clusters []domain.NearDuplicateCluster) NearDuplicatesResponse {
+	response := NearDuplicatesResponse{
+		Total:    len(clusters),
+		Clusters: make([]NearDuplicateClusterResponse, len(clusters)),
+	}
+	for i, cluster := range clusters {
+		songs := make([]SongResponse, len(cluster.Songs))
+		for j, song := range cluster.Songs {
+			songs[j] = ToSongResponse(song)
+		}
+		pairs := make([]NearDuplicatePairResponse, len(cluster.Pairs))
+		for j, pair := range cluster.Pairs {
+			pairs[j] = NearDuplicatePairResponse{
+				SongID:     pair.SongID,
+				OtherID:    pair.OtherID,
+				Similarity: pair.Similarity,
+			}
+		}
+		response.Clusters[i] = NearDuplicateClusterResponse{Songs: songs, Pairs: pairs}
+	}
+	return response
+}
+
+func ToAPIKeyResponse(key *domain.APIKey) APIKeyResponse {
+	response := APIKeyResponse{
+		ID:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Role:      string(key.Role),
+		CreatedAt: key.CreatedAt.Format(time.RFC3339),
+	}
+	if key.RevokedAt != nil {
+		response.RevokedAt = key.RevokedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func ToPlaylistResponse(playlist *domain.Playlist) PlaylistResponse {
+	return PlaylistResponse{
+		ID:        playlist.ID,
+		Name:      playlist.Name,
+		SongCount: playlist.SongCount,
+		CreatedAt: playlist.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: playlist.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToPlaylistDetailResponse(playlist *domain.Playlist) PlaylistDetailResponse {
+	response := PlaylistDetailResponse{
+		PlaylistResponse: ToPlaylistResponse(playlist),
+		Songs:            make([]PlaylistEntryResponse, len(playlist.Entries)),
+	}
+	for i, entry := range playlist.Entries {
+		response.Songs[i] = PlaylistEntryResponse{
+			Position: entry.Position,
+			AddedAt:  entry.AddedAt.Format(time.RFC3339),
+			Song:     ToSongResponse(entry.Song),
+		}
+	}
+	return response
+}
+
+func ToPlaylistsResponse(playlists []*domain.Playlist) []PlaylistResponse {
+	response := make([]PlaylistResponse, len(playlists))
+	for i, playlist := range playlists {
+		response[i] = ToPlaylistResponse(playlist)
+	}
+	return response
+}
+
+func ToAlbumDomain(req AlbumRequest) (*domain.Album, error) {
+	releaseDate, err := time.Parse(time.RFC3339, req.ReleaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	album := &domain.Album{
+		GroupID:     req.GroupID,
+		Title:       req.Title,
+		ReleaseDate: releaseDate,
+		Type:        domain.AlbumType(req.Type),
+		CoverURL:    req.CoverURL,
+	}
+	if req.Tracks != nil {
+		album.Tracks = make([]domain.AlbumTrack, len(req.Tracks))
+		for i, track := range req.Tracks {
+			album.Tracks[i] = domain.AlbumTrack{
+				DiscNumber:         track.DiscNumber,
+				TrackNumber:        track.TrackNumber,
+				InheritReleaseDate: track.InheritReleaseDate,
+				SongID:             track.SongID,
+			}
+		}
+	}
+	return album, nil
+}
+
+func ToAlbumResponse(album *domain.Album) AlbumResponse {
+	return AlbumResponse{
+		ID:          album.ID,
+		GroupID:     album.GroupID,
+		Title:       album.Title,
+		ReleaseDate: album.ReleaseDate.Format(time.RFC3339),
+		Type:        string(album.Type),
+		CoverURL:    album.CoverURL,
+		Tracks:      ToAlbumTrackResponses(album.Tracks),
+	}
+}
+
+func ToAlbumTrackResponses(tracks []domain.AlbumTrack) []AlbumTrackResponse {
+	if tracks == nil {
+		return nil
+	}
+	response := make([]AlbumTrackResponse, len(tracks))
+	for i, track := range tracks {
+		response[i] = AlbumTrackResponse{
+			DiscNumber:         track.DiscNumber,
+			TrackNumber:        track.TrackNumber,
+			InheritReleaseDate: track.InheritReleaseDate,
+			Song:               ToSongResponse(track.Song),
+		}
+	}
+	return response
+}
+
+func ToTagChangeResponse(change *domain.TagChange) TagChangeResponse {
+	return TagChangeResponse{
+		Tags:       change.Tags,
+		SongIDs:    change.SongIDs,
+		MissingIDs: change.MissingIDs,
+		Changed:    change.Changed,
+	}
+}
+
+func ToTagCountResponses(counts []domain.TagCount) []TagCountResponse {
+	responses := make([]TagCountResponse, len(counts))
+	for i, count := range counts {
+		responses[i] = TagCountResponse{Name: count.Name, Count: count.Count}
+	}
+	return responses
+}
+
+func ToGenreResponse(genre domain.Genre) GenreResponse {
+	return GenreResponse{
+		ID:        genre.ID,
+		ParentID:  genre.ParentID,
+		Name:      genre.Name,
+		Path:      genre.Path,
+		SongCount: genre.SongCount,
+	}
+}
+
+func ToGenreResponses(genres []domain.Genre) []GenreResponse {
+	responses := make([]GenreResponse, len(genres))
+	for i, genre := range genres {
+		responses[i] = ToGenreResponse(genre)
+	}
+	return responses
+}
+
+func ToRelationResponse(relation domain.SongRelation) RelationResponse {
+	return RelationResponse{
+		SongID:        relation.SongID,
+		SongTitle:     relation.SongTitle,
+		OriginalID:    relation.OriginalID,
+		OriginalTitle: relation.OriginalTitle,
+		Type:          string(relation.Type),
+		CreatedAt:     relation.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToRelationResponses(relations []domain.SongRelation) []RelationResponse {
+	responses := make([]RelationResponse, len(relations))
+	for i, relation := range relations {
+		responses[i] = ToRelationResponse(relation)
+	}
+	return responses
+}
+
+func ToVersionFamilyResponse(family *domain.VersionFamily) VersionFamilyResponse {
+	songs := make([]FamilySongResponse, len(family.Songs))
+	for i, song := range family.Songs {
+		songs[i] = FamilySongResponse{
+			ID:       song.ID,
+			Title:    song.Title,
+			Original: song.Original,
+		}
+	}
+	return VersionFamilyResponse{
+		Songs:     songs,
+		Relations: ToRelationResponses(family.Relations),
+	}
+}
+
+func ToLyricsResponse(lyrics domain.Lyrics) LyricsResponse {
+	return LyricsResponse{
+		SongID:     lyrics.SongID,
+		Language:   lyrics.Language,
+		Text:       lyrics.Text,
+		Original:   lyrics.Original,
+		Translator: lyrics.Translator,
+		Source:     lyrics.Source,
+		CreatedAt:  lyrics.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  lyrics.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToLyricsResponses(lyrics []domain.Lyrics) []LyricsResponse {
+	responses := make([]LyricsResponse, len(lyrics))
+	for i, variant := range lyrics {
+		responses[i] = ToLyricsResponse(variant)
+	}
+	return responses
+}
+
+func ToAlignedLyricsResponse(aligned *domain.AlignedLyrics) AlignedLyricsResponse {
+	lines := make([]AlignedLineResponse, len(aligned.Lines))
+	for i, line := range aligned.Lines {
+		lines[i] = AlignedLineResponse{
+			Verse:       line.Verse,
+			Line:        line.Line,
+			Original:    line.Original,
+			Translation: line.Translation,
+		}
+	}
+	return AlignedLyricsResponse{
+		SongID:           aligned.Translation.SongID,
+		OriginalLanguage: aligned.Original.Language,
+		Language:         aligned.Translation.Language,
+		Translator:       aligned.Translation.Translator,
+		Source:           aligned.Translation.Source,
+		Lines:            lines,
+	}
+}
+
+func ToLyricsStatsResponse(stats domain.LyricsStats) LyricsStatsResponse {
+	topWords := make([]WordCountResponse, len(stats.TopWords))
+	for i, word := range stats.TopWords {
+		topWords[i] = WordCountResponse{Word: word.Word, Count: word.Count}
+	}
+	return LyricsStatsResponse{
+		Lines:              stats.Lines,
+		Words:              stats.Words,
+		Characters:         stats.Characters,
+		CharactersNoSpaces: stats.CharactersNoSpaces,
+		Verses:             stats.Verses,
+		UniqueWords:        stats.UniqueWords,
+		UniqueWordRatio:    stats.UniqueWordRatio,
+		TopWords:           topWords,
+		ReadingSeconds:     int(stats.ReadingTime / time.Second),
+		SingingSeconds:     int(stats.SingingTime / time.Second),
+	}
+}
+
+func ToGroupStatsResponse(stats *domain.GroupStats) GroupStatsResponse {
+	return GroupStatsResponse{
+		GroupID:             stats.GroupID,
+		Songs:               stats.Songs,
+		LyricsStatsResponse: ToLyricsStatsResponse(stats.LyricsStats),
+		AverageWords:        stats.AverageWords,
+	}
+}
+
+func ToSimilarSongResponses(similar []domain.SimilarSong) []SimilarSongResponse {
+	responses := make([]SimilarSongResponse, len(similar))
+	for i, match := range similar {
+		responses[i] = SimilarSongResponse{Song: ToSongResponse(match.Song), Score: match.Score}
+	}
+	return responses
+}
+
+func ToSongEventResponse(event domain.SongEvent) SongEventResponse {
+	response := SongEventResponse{
+		Seq:    event.Seq,
+		Type:   string(event.Type),
+		SongID: event.SongID,
+		Time:   event.Time.Format(time.RFC3339),
+	}
+	if event.Song != nil {
+		song := ToSongResponse(event.Song)
+		response.Song = &song
+	}
+	return response
+}
+
+func ToOutboxStatusResponse(status *domain.OutboxStatus) OutboxStatusResponse {
+	response := OutboxStatusResponse{
+		Pending: status.Pending,
+		Stuck:   make([]OutboxEventResponse, len(status.Stuck)),
+	}
+	if status.OldestPending != nil {
+		response.OldestPending = status.OldestPending.Format(time.RFC3339)
+	}
+	for i, event := range status.Stuck {
+		response.Stuck[i] = OutboxEventResponse{
+			ID:            event.ID,
+			Type:          string(event.Type),
+			SongID:        event.SongID,
+			Attempts:      event.Attempts,
+			LastError:     event.LastError,
+			CreatedAt:     event.CreatedAt.Format(time.RFC3339),
+			NextAttemptAt: event.NextAttemptAt.Format(time.RFC3339),
+		}
+	}
+	return response
+}
+
+func ToWebhookResponse(subscription *domain.WebhookSubscription) WebhookResponse {
+	response := WebhookResponse{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: make([]string, len(subscription.EventTypes)),
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  subscription.UpdatedAt.Format(time.RFC3339),
+	}
+	for i, eventType := range subscription.EventTypes {
+		response.EventTypes[i] = string(eventType)
+	}
+	return response
+}
+
+func ToWebhookDeliveryResponse(delivery *domain.WebhookDelivery) WebhookDeliveryResponse {
+	response := WebhookDeliveryResponse{
+		ID:           delivery.ID,
+		EventID:      delivery.EventID,
+		EventType:    string(delivery.EventType),
+		Status:       string(delivery.Status),
+		Attempts:     delivery.Attempts,
+		ResponseCode: delivery.ResponseCode,
+		LastError:    delivery.LastError,
+		CreatedAt:    delivery.CreatedAt.Format(time.RFC3339),
+	}
+	if delivery.Status == domain.WebhookDeliveryPending {
+		response.NextAttemptAt = delivery.NextAttemptAt.Format(time.RFC3339)
+	}
+	if delivery.DeliveredAt != nil {
+		response.DeliveredAt = delivery.DeliveredAt.Format(time.RFC3339)
+	}
+	for _, attempt := range delivery.Log {
+		response.Log = append(response.Log, WebhookAttemptResponse{
+			At:           attempt.At.Format(time.RFC3339),
+			ResponseCode: attempt.ResponseCode,
+			Error:        attempt.Error,
+			DurationMs:   attempt.Duration.Milliseconds(),
+		})
+	}
+	return response
+}
+
+func ToAuditEntryResponse(entry domain.AuditEntry) AuditEntryResponse {
+	return AuditEntryResponse{
+		ID:         entry.ID,
+		Time:       entry.Time.Format(time.RFC3339),
+		Actor:      entry.Actor,
+		ActorName:  entry.ActorName,
+		RequestID:  entry.RequestID,
+		Transport:  string(entry.Transport),
+		Resource:   string(entry.Resource),
+		ResourceID: entry.ResourceID,
+		Action:     string(entry.Action),
+		Before:     entry.Before,
+		After:      entry.After,
 	}
 }
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHeader is an alternative to the Authorization header for API keys
+const APIKeyHeader = "X-API-Key"
+
+// Authenticator resolves the caller presenting a credential
+type Authenticator interface {
+	Authenticate(ctx context.Context, credential string) (*domain.Principal, error)
+}
+
+// Credential extracts the credential from an Authorization header value
+// ("Bearer <credential>") or, failing that, from an API key header value
+func Credential(authorization, apiKey string) string {
+	if scheme, credential, ok := strings.Cut(strings.TrimSpace(authorization), " "); ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(credential)
+	}
+	return strings.TrimSpace(apiKey)
+}
+
+// Authenticate resolves the caller of a request and stores it in the request
+// context. Requests without credentials continue anonymously, so public routes
+// keep working; Require rejects them where a role is needed. Invalid
+// credentials are always rejected.
+func Authenticate(auth Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		credential := Credential(c.GetHeader("Authorization"), c.GetHeader(APIKeyHeader))
+		if credential == "" {
+			c.Next()
+			return
+		}
+
+		principal, err := auth.Authenticate(c.Request.Context(), credential)
+		if err != nil {
+			if errors.Is(err, domain.ErrUnauthenticated) {
+				server.Unauthorized(domain.ErrUnauthenticated.Slug(), err, c.Writer)
+			} else {
+				server.RespondWithError(err, c.Writer)
+			}
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(domain.WithPrincipal(c.Request.Context(), principal))
+		c.Next()
+	}
+}
+
+// Require lets the request through only if its caller has at least the given role
+func Require(role domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal := domain.PrincipalFromContext(c.Request.Context())
+		if principal == nil {
+			server.Unauthorized(domain.ErrUnauthenticated.Slug(), domain.ErrUnauthenticated, c.Writer)
+			c.Abort()
+			return
+		}
+		if !principal.Role.Allows(role) {
+			server.Forbidden(domain.ErrForbidden.Slug(), domain.ErrForbidden, c.Writer)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
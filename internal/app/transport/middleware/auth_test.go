@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// staticAuthenticator accepts a fixed set of credentials
+type staticAuthenticator map[string]domain.Role
+
+func (a staticAuthenticator) Authenticate(_ context.Context, credential string) (*domain.Principal, error) {
+	role, ok := a[credential]
+	if !ok {
+		return nil, domain.ErrUnauthenticated
+	}
+	return &domain.Principal{Subject: "test:" + credential, Role: role}, nil
+}
+
+func setupAuthRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Authenticate(staticAuthenticator{"reader-key": domain.RoleReader, "editor-key": domain.RoleEditor}))
+	r.GET("/public", func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.DELETE("/songs/1", Require(domain.RoleEditor), func(c *gin.Context) {
+		c.String(http.StatusOK, domain.PrincipalFromContext(c.Request.Context()).Subject)
+	})
+	return r
+}
+
+func TestAuth(t *testing.T) {
+	r := setupAuthRouter()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		header string
+		value  string
+		want   int
+	}{
+		{"public without credentials", http.MethodGet, "/public", "", "", http.StatusOK},
+		{"missing credentials", http.MethodDelete, "/songs/1", "", "", http.StatusUnauthorized},
+		{"invalid credentials", http.MethodGet, "/public", "Authorization", "Bearer nope", http.StatusUnauthorized},
+		{"insufficient role", http.MethodDelete, "/songs/1", "Authorization", "Bearer reader-key", http.StatusForbidden},
+		{"bearer token", http.MethodDelete, "/songs/1", "Authorization", "Bearer editor-key", http.StatusOK},
+		{"api key header", http.MethodDelete, "/songs/1", APIKeyHeader, "editor-key", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+			if tt.want == http.StatusUnauthorized {
+				assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+			}
+			if tt.want == http.StatusOK && tt.method == http.MethodDelete {
+				assert.Equal(t, "test:editor-key", w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuthThrottle refuses, before their credential is looked up, the client IPs
+// that presented too many invalid credentials, so a flood of bogus keys can
+// neither be brute forced nor exhaust the database. Only failures are
+// charged to the limiter; the IPs it denies are then blocked in memory, so
+// valid credentials never cost a trip to the bucket store.
+type AuthThrottle struct {
+	limiter RateLimiter
+	now     func() time.Time
+
+	mu      sync.Mutex
+	blocked map[string]time.Time
+}
+
+// NewAuthThrottle creates a throttle charging failed authentications to
+// limiter, whose buckets should be much smaller than those of requests
+func NewAuthThrottle(limiter RateLimiter) *AuthThrottle {
+	return &AuthThrottle{
+		limiter: limiter,
+		now:     time.Now,
+		blocked: make(map[string]time.Time),
+	}
+}
+
+// Blocked returns how long a client IP must wait before presenting a
+// credential again, zero when it may
+func (t *AuthThrottle) Blocked(clientIP string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	until, ok := t.blocked[clientIP]
+	if !ok {
+		return 0
+	}
+	wait := until.Sub(t.now())
+	if wait <= 0 {
+		delete(t.blocked, clientIP)
+		return 0
+	}
+	return wait
+}
+
+// Failed charges an invalid credential to the bucket of its client IP,
+// blocking the IP until the bucket refills once it is empty
+func (t *AuthThrottle) Failed(ctx context.Context, clientIP string) {
+	decision := t.limiter.Allow(ctx, "auth:"+clientIP, 1)
+	if decision.Allowed {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	for ip, until := range t.blocked {
+		if !until.After(now) {
+			delete(t.blocked, ip)
+		}
+	}
+	t.blocked[clientIP] = now.Add(max(decision.RetryAfter, time.Second))
+}
+
+// ThrottleAuth rejects with 429 the credentials of client IPs blocked by
+// throttle, and charges the requests Authenticate rejects with 401. It must
+// run right before Authenticate; requests without credentials pass through.
+func ThrottleAuth(throttle *AuthThrottle) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if Credential(c.GetHeader("Authorization"), c.GetHeader(APIKeyHeader)) == "" {
+			c.Next()
+			return
+		}
+
+		clientIP := c.ClientIP()
+		if wait := throttle.Blocked(clientIP); wait > 0 {
+			c.Header("Retry-After", strconv.Itoa(max(seconds(wait), 1)))
+			server.TooManyRequests(domain.ErrRateLimited.Slug(), domain.ErrRateLimited, c.Writer)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+		if c.Writer.Status() == http.StatusUnauthorized {
+			throttle.Failed(c.Request.Context(), clientIP)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingAuthenticator counts the credentials it looks up
+type countingAuthenticator struct {
+	staticAuthenticator
+	lookups int
+}
+
+func (a *countingAuthenticator) Authenticate(ctx context.Context, credential string) (*domain.Principal, error) {
+	a.lookups++
+	return a.staticAuthenticator.Authenticate(ctx, credential)
+}
+
+func TestThrottleAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := &recordingLimiter{budget: 2, spent: map[string]int{}}
+	throttle := NewAuthThrottle(limiter)
+	now := time.Now()
+	throttle.now = func() time.Time { return now }
+	auth := &countingAuthenticator{staticAuthenticator: staticAuthenticator{"reader-key": domain.RoleReader}}
+	r := gin.New()
+	r.Use(ThrottleAuth(throttle), Authenticate(auth))
+	r.GET("/songs", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	get := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/songs", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		if key != "" {
+			req.Header.Set(APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	// Valid credentials and anonymous calls never reach the limiter
+	assert.Equal(t, http.StatusOK, get("reader-key").Code)
+	assert.Equal(t, http.StatusOK, get("").Code)
+	assert.Empty(t, limiter.spent)
+
+	assert.Equal(t, http.StatusUnauthorized, get("bogus-1").Code)
+	assert.Equal(t, http.StatusUnauthorized, get("bogus-2").Code)
+	// The limiter denies the third failure, which blocks the IP
+	assert.Equal(t, http.StatusUnauthorized, get("bogus-3").Code)
+	assert.Equal(t, 4, auth.lookups)
+	assert.Equal(t, map[string]int{"auth:10.0.0.1": 2}, limiter.spent)
+
+	// Blocked IPs are refused without a lookup
+	w := get("reader-key")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusTooManyRequests, get("bogus-4").Code)
+	assert.Equal(t, 4, auth.lookups)
+	assert.Equal(t, http.StatusOK, get("").Code)
+
+	// Until the wait is over
+	now = now.Add(2 * time.Second)
+	assert.Equal(t, http.StatusOK, get("reader-key").Code)
+	assert.Empty(t, throttle.blocked)
+}
@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the idempotency key
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks responses replayed from storage
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotentBodySize bounds the request body hashed into the fingerprint
+	maxIdempotentBodySize = 8 << 20
+)
+
+// IdempotencyService defines the interface for idempotency key handling
+type IdempotencyService interface {
+	Begin(ctx context.Context, key, fingerprint string) (*domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, statusCode int, contentType string, response []byte) error
+	Release(ctx context.Context, key string) error
+}
+
+// responseRecorder copies everything written to the client
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
+
+// Idempotency makes write requests carrying an Idempotency-Key header safe to
+// retry. The first request with a key is processed and its response stored;
+// retries with the same key and request get the stored response back, while
+// reusing the key for a different request is rejected. Requests without the
+// header are passed through untouched.
+func Idempotency(svc IdempotencyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxIdempotentBodySize))
+		if err != nil {
+			server.BadRequest("invalid-request-body", err, c.Writer)
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		fingerprint := Fingerprint(
+			[]byte(CallerSubject(c.Request.Context())),
+			[]byte(c.Request.Method),
+			[]byte(c.Request.URL.Path),
+			[]byte(c.Request.URL.RawQuery),
+			body,
+		)
+
+		ctx := c.Request.Context()
+		stored, err := svc.Begin(ctx, key, fingerprint)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrIdempotencyKeyReused):
+				server.UnprocessableEntity(domain.ErrIdempotencyKeyReused.Slug(), err, c.Writer)
+			case errors.Is(err, domain.ErrIdempotencyInProgress):
+				server.Conflict(domain.ErrIdempotencyInProgress.Slug(), err, c.Writer)
+			case errors.Is(err, domain.ErrInvalidData):
+				server.BadRequest("invalid-idempotency-key", err, c.Writer)
+			default:
+				server.RespondWithError(err, c.Writer)
+			}
+			c.Abort()
+			return
+		}
+
+		if stored != nil {
+			if stored.ContentType != "" {
+				c.Header("Content-Type", stored.ContentType)
+			}
+			c.Header(IdempotentReplayedHeader, "true")
+			c.Status(stored.StatusCode)
+			_, _ = c.Writer.Write(stored.Response)
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			// Server errors and panics free the key so the client can retry
+			if err := svc.Release(context.WithoutCancel(ctx), key); err != nil {
+				log.Printf("idempotency: release key: %v", err)
+			}
+		}()
+
+		c.Next()
+
+		if recorder.Status() >= http.StatusInternalServerError {
+			return
+		}
+		if err := svc.Complete(context.WithoutCancel(ctx), key, recorder.Status(), recorder.Header().Get("Content-Type"), recorder.body.Bytes()); err != nil {
+			log.Printf("idempotency: store response: %v", err)
+			return
+		}
+		completed = true
+	}
+}
+
+// CallerSubject identifies the authenticated caller, so that a key reused by
+// another caller never replays someone else's response
+func CallerSubject(ctx context.Context) string {
+	if principal := domain.PrincipalFromContext(ctx); principal != nil {
+		return principal.Subject
+	}
+	return ""
+}
+
+// Fingerprint hashes the parts identifying a request
+func Fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		// Length prefix keeps ("ab","c") and ("a","bc") apart
+		var size [8]byte
+		binary.LittleEndian.PutUint64(size[:], uint64(len(part)))
+		h.Write(size[:])
+		h.Write(part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryIdempotency keeps idempotency records in memory
+type memoryIdempotency struct {
+	mu      sync.Mutex
+	records map[string]*domain.IdempotencyRecord
+}
+
+func newMemoryIdempotency() *memoryIdempotency {
+	return &memoryIdempotency{records: make(map[string]*domain.IdempotencyRecord)}
+}
+
+func (m *memoryIdempotency) Begin(_ context.Context, key, fingerprint string) (*domain.IdempotencyRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	existing, ok := m.records[key]
+	if !ok {
+		m.records[key] = &domain.IdempotencyRecord{Key: key, Fingerprint: fingerprint}
+		return nil, nil
+	}
+	if existing.Fingerprint != fingerprint {
+		return nil, domain.ErrIdempotencyKeyReused
+	}
+	if !existing.Completed {
+		return nil, domain.ErrIdempotencyInProgress
+	}
+	return existing, nil
+}
+
+func (m *memoryIdempotency) Complete(_ context.Context, key string, statusCode int, contentType string, response []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec := m.records[key]
+	rec.Completed = true
+	rec.StatusCode = statusCode
+	rec.ContentType = contentType
+	rec.Response = append([]byte(nil), response...)
+	return nil
+}
+
+func (m *memoryIdempotency) Release(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, key)
+	return nil
+}
+
+func setupRouter(svc IdempotencyService, status int, calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/songs", Idempotency(svc), func(c *gin.Context) {
+		*calls++
+		c.JSON(status, gin.H{"call": *calls})
+	})
+	return r
+}
+
+func send(r http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/songs", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotency_ReplaysResponse(t *testing.T) {
+	calls := 0
+	r := setupRouter(newMemoryIdempotency(), http.StatusCreated, &calls)
+
+	first := send(r, "key-1", `{"title":"a"}`)
+	second := send(r, "key-1", `{"title":"a"}`)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader))
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+	assert.Contains(t, second.Header().Get("Content-Type"), "application/json")
+}
+
+func TestIdempotency_KeyReused(t *testing.T) {
+	calls := 0
+	r := setupRouter(newMemoryIdempotency(), http.StatusCreated, &calls)
+
+	send(r, "key-1", `{"title":"a"}`)
+	w := send(r, "key-1", `{"title":"b"}`)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestIdempotency_ServerErrorReleasesKey(t *testing.T) {
+	calls := 0
+	r := setupRouter(newMemoryIdempotency(), http.StatusInternalServerError, &calls)
+
+	send(r, "key-1", `{}`)
+	send(r, "key-1", `{}`)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotency_WithoutKey(t *testing.T) {
+	calls := 0
+	r := setupRouter(newMemoryIdempotency(), http.StatusCreated, &calls)
+
+	send(r, "", `{}`)
+	send(r, "", `{}`)
+
+	assert.Equal(t, 2, calls)
+}
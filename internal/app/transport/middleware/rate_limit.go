@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimiter charges requests to per-client token buckets
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, cost int) domain.RateLimitDecision
+}
+
+// RateLimitKey identifies the bucket of a caller: authenticated callers are
+// limited per credential, anonymous ones per client IP
+func RateLimitKey(ctx context.Context, clientIP string) string {
+	if principal := domain.PrincipalFromContext(ctx); principal != nil {
+		return principal.Subject
+	}
+	return "ip:" + clientIP
+}
+
+// RateLimitHeaders returns the RateLimit-* headers describing a decision,
+// plus Retry-After when the request was denied. Durations are whole seconds.
+func RateLimitHeaders(decision domain.RateLimitDecision) map[string]string {
+	headers := map[string]string{
+		"RateLimit-Limit":     strconv.Itoa(decision.Limit),
+		"RateLimit-Remaining": strconv.Itoa(decision.Remaining),
+		"RateLimit-Reset":     strconv.Itoa(seconds(decision.Reset)),
+	}
+	if !decision.Allowed {
+		retryAfter := seconds(decision.RetryAfter)
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		headers["Retry-After"] = strconv.Itoa(retryAfter)
+	}
+	return headers
+}
+
+// RateLimit charges cost tokens to the caller's bucket and rejects the
+// request with 429 when the bucket cannot afford it. It must run after
+// Authenticate so authenticated callers get their own bucket.
+func RateLimit(limiter RateLimiter, cost int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		decision := limiter.Allow(c.Request.Context(), RateLimitKey(c.Request.Context(), c.ClientIP()), cost)
+		for name, value := range RateLimitHeaders(decision) {
+			c.Header(name, value)
+		}
+		if !decision.Allowed {
+			server.TooManyRequests(domain.ErrRateLimited.Slug(), domain.ErrRateLimited, c.Writer)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
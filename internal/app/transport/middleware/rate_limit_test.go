@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"songs/internal/app/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingLimiter allows a fixed number of tokens per key and records the keys it saw
+type recordingLimiter struct {
+	budget int
+	spent  map[string]int
+}
+
+func (l *recordingLimiter) Allow(_ context.Context, key string, cost int) domain.RateLimitDecision {
+	if l.spent[key]+cost > l.budget {
+		return domain.RateLimitDecision{Limit: l.budget, Remaining: l.budget - l.spent[key], Reset: 2 * time.Second, RetryAfter: 1500 * time.Millisecond}
+	}
+	l.spent[key] += cost
+	return domain.RateLimitDecision{Allowed: true, Limit: l.budget, Remaining: l.budget - l.spent[key], Reset: 2 * time.Second}
+}
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := &recordingLimiter{budget: 5, spent: map[string]int{}}
+	r := gin.New()
+	r.Use(Authenticate(staticAuthenticator{"reader-key": domain.RoleReader}))
+	r.GET("/songs", RateLimit(limiter, 3), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	get := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/songs", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		if key != "" {
+			req.Header.Set(APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "5", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "2", w.Header().Get("RateLimit-Reset"))
+	assert.Empty(t, w.Header().Get("Retry-After"))
+
+	w = get("")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), domain.ErrRateLimited.Slug())
+
+	// Authenticated callers are limited per credential, not per IP
+	assert.Equal(t, http.StatusOK, get("reader-key").Code)
+	assert.Equal(t, map[string]int{"ip:10.0.0.1": 3, "test:reader-key": 3}, limiter.spent)
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"songs/internal/app/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader carries the ID of a request, given by the client or
+// generated, and is echoed in the response
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds the request IDs accepted from clients
+const maxRequestIDLength = 128
+
+// RequestIDOrNew returns id when it is a usable request ID, printable ASCII
+// of at most 128 characters, and a new random one otherwise
+func RequestIDOrNew(id string) string {
+	if id != "" && len(id) <= maxRequestIDLength && printable(id) {
+		return id
+	}
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+func printable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// RequestID identifies every request by the X-Request-ID header, generating
+// an ID when the client sent none, and stores it in the request context
+// along with the HTTP transport, for the audit log
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := RequestIDOrNew(c.GetHeader(RequestIDHeader))
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(domain.WithRequestInfo(c.Request.Context(), domain.RequestInfo{ID: id, Transport: domain.TransportHTTP}))
+		c.Next()
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"songs/internal/app/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RequestID())
+	var info domain.RequestInfo
+	r.GET("/songs", func(c *gin.Context) {
+		info = domain.RequestInfoFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/songs", nil)
+	req.Header.Set(RequestIDHeader, "req-42")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, domain.RequestInfo{ID: "req-42", Transport: domain.TransportHTTP}, info)
+	assert.Equal(t, "req-42", w.Header().Get(RequestIDHeader))
+
+	req = httptest.NewRequest(http.MethodGet, "/songs", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Len(t, info.ID, 32, "an ID is generated when none is given")
+	assert.Equal(t, info.ID, w.Header().Get(RequestIDHeader))
+}
+
+func TestRequestIDOrNew(t *testing.T) {
+	assert.Equal(t, "abc-123", RequestIDOrNew("abc-123"))
+	assert.Len(t, RequestIDOrNew(""), 32)
+	assert.Len(t, RequestIDOrNew("bad\nid"), 32)
+	assert.Len(t, RequestIDOrNew(strings.Repeat("a", 129)), 32)
+}
@@ -1,6 +1,7 @@
 package transport
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -11,6 +12,18 @@ type SongRequest struct {
 	ReleaseDate string `json:"release_date"`
 	Text        string `json:"text"`
 	Link        string `json:"link"`
+	// Credits replaces the credited artists; when omitted they are kept.
+	// The group is always credited as primary artist.
+	Credits []CreditRequest `json:"credits,omitempty"`
+	// Explicit flags the song manually; when omitted the flag is computed
+	// from the text
+	Explicit *bool `json:"explicit,omitempty"`
+}
+
+type CreditRequest struct {
+	ArtistID int `json:"artist_id"`
+	// Role is primary, featured, composer, lyricist or producer
+	Role string `json:"role"`
 }
 
 func (r *SongRequest) Validate() error {
@@ -27,10 +40,458 @@ func (r *SongRequest) Validate() error {
 }
 
 type SongResponse struct {
-	ID          int    `json:"id"`
+	ID          int              `json:"id"`
+	GroupID     int              `json:"group_id"`
+	Title       string           `json:"title"`
+	ReleaseDate string           `json:"release_date"`
+	Text        string           `json:"text"`
+	Link        string           `json:"link"`
+	Credits     []CreditResponse `json:"credits,omitempty"`
+	// Language is the language of Text when known
+	Language string `json:"language,omitempty"`
+	// LanguageConfidence and Script come with detected languages
+	LanguageConfidence float64 `json:"language_confidence,omitempty"`
+	Script             string  `json:"script,omitempty"`
+	Explicit           bool    `json:"explicit"`
+	// ExplicitManual tells whether an editor set Explicit
+	ExplicitManual bool `json:"explicit_manual"`
+	// Relations are only included when asked for with include=relations
+	Relations []RelationResponse `json:"relations,omitempty"`
+	// NearDuplicates warns, on written songs, of existing songs with nearly
+	// the same lyrics
+	NearDuplicates []NearDuplicateResponse `json:"near_duplicates,omitempty"`
+}
+
+type CreditResponse struct {
+	ArtistID   int    `json:"artist_id"`
+	ArtistName string `json:"artist_name"`
+	Role       string `json:"role"`
+}
+
+type ImportRowResponse struct {
+	Row     int    `json:"row"`
+	Status  string `json:"status"`
+	SongID  int    `json:"song_id,omitempty"`
+	Slug    string `json:"slug,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type ImportReportResponse struct {
+	DryRun  bool                `json:"dry_run"`
+	Created int                 `json:"created"`
+	Skipped int                 `json:"skipped"`
+	Failed  int                 `json:"failed"`
+	Rows    []ImportRowResponse `json:"rows"`
+}
+
+type BatchCreateRequest struct {
+	Mode  string        `json:"mode"`
+	Songs []SongRequest `json:"songs"`
+}
+
+type BatchUpdateItem struct {
+	ID int `json:"id"`
+	SongRequest
+}
+
+type BatchUpdateRequest struct {
+	Mode  string            `json:"mode"`
+	Songs []BatchUpdateItem `json:"songs"`
+}
+
+type BatchDeleteRequest struct {
+	Mode string `json:"mode"`
+	IDs  []int  `json:"ids"`
+}
+
+type BatchItemResponse struct {
+	Index  int           `json:"index"`
+	ID     int           `json:"id,omitempty"`
+	Status string        `json:"status"`
+	Song   *SongResponse `json:"song,omitempty"`
+	Slug   string        `json:"slug,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+type BatchResponse struct {
+	Mode      string              `json:"mode"`
+	Committed bool                `json:"committed"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+	Results   []BatchItemResponse `json:"results"`
+}
+
+type DuplicateSetResponse struct {
+	GroupID  int            `json:"group_id"`
+	TitleKey string         `json:"title_key"`
+	Songs    []SongResponse `json:"songs"`
+}
+
+type DuplicatesResponse struct {
+	Total      int                    `json:"total"`
+	Duplicates []DuplicateSetResponse `json:"duplicates"`
+}
+
+type NearDuplicateResponse struct {
+	SongID     int     `json:"song_id"`
+	Title      string  `json:"title"`
+	Similarity float64 `json:"similarity"`
+}
+
+type NearDuplicatePairResponse struct {
+	SongID     int     `json:"song_id"`
+	OtherID    int     `json:"other_id"`
+	Similarity float64 `json:"similarity"`
+}
+
+type NearDuplicateClusterResponse struct {
+	Songs []SongResponse              `json:"songs"`
+	Pairs []NearDuplicatePairResponse `json:"pairs"`
+}
+
+type NearDuplicatesResponse struct {
+	Total    int                            `json:"total"`
+	Clusters []NearDuplicateClusterResponse `json:"clusters"`
+}
+
+type MergeSongsRequest struct {
+	SourceIDs []int `json:"source_ids"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+type APIKeyResponse struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Prefix    string `json:"prefix"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+	RevokedAt string `json:"revoked_at,omitempty"`
+}
+
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	// Key is the secret, returned only once
+	Key string `json:"key"`
+}
+
+type PlaylistRequest struct {
+	Name string `json:"name"`
+}
+
+type AddPlaylistSongRequest struct {
+	SongID int `json:"song_id"`
+	// Position is 1-based; omitted or 0 appends the song
+	Position int `json:"position"`
+}
+
+type MovePlaylistSongRequest struct {
+	Position int `json:"position"`
+}
+
+type PlaylistResponse struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	SongCount int    `json:"song_count"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type PlaylistEntryResponse struct {
+	Position int          `json:"position"`
+	AddedAt  string       `json:"added_at"`
+	Song     SongResponse `json:"song"`
+}
+
+type PlaylistDetailResponse struct {
+	PlaylistResponse
+	Songs []PlaylistEntryResponse `json:"songs"`
+}
+
+type PlaylistsResponse struct {
+	Playlists []PlaylistResponse `json:"playlists"`
+	Total     int64              `json:"total"`
+	Page      int                `json:"page,omitempty"`
+	Pages     int                `json:"pages,omitempty"`
+}
+
+type AlbumTrackRequest struct {
+	SongID int `json:"song_id"`
+	// DiscNumber defaults to 1
+	DiscNumber         int  `json:"disc_number"`
+	TrackNumber        int  `json:"track_number"`
+	InheritReleaseDate bool `json:"inherit_release_date"`
+}
+
+type AlbumRequest struct {
 	GroupID     int    `json:"group_id"`
 	Title       string `json:"title"`
 	ReleaseDate string `json:"release_date"`
-	Text        string `json:"text"`
-	Link        string `json:"link"`
+	// Type is album (default), single or ep
+	Type     string `json:"type"`
+	CoverURL string `json:"cover_url"`
+	// Tracks replaces the track listing; when omitted on update the listing is kept
+	Tracks []AlbumTrackRequest `json:"tracks"`
+}
+
+type AlbumTrackResponse struct {
+	DiscNumber         int          `json:"disc_number"`
+	TrackNumber        int          `json:"track_number"`
+	InheritReleaseDate bool         `json:"inherit_release_date"`
+	Song               SongResponse `json:"song"`
+}
+
+type AlbumResponse struct {
+	ID          int                  `json:"id"`
+	GroupID     int                  `json:"group_id"`
+	Title       string               `json:"title"`
+	ReleaseDate string               `json:"release_date"`
+	Type        string               `json:"type"`
+	CoverURL    string               `json:"cover_url,omitempty"`
+	Tracks      []AlbumTrackResponse `json:"tracks,omitempty"`
+}
+
+type AlbumsResponse struct {
+	Albums []AlbumResponse `json:"albums"`
+	Total  int64           `json:"total"`
+	Page   int             `json:"page"`
+	Pages  int             `json:"pages"`
+}
+
+type TagSongsRequest struct {
+	SongIDs []int    `json:"song_ids"`
+	Tags    []string `json:"tags"`
+}
+
+type TagChangeResponse struct {
+	// Tags are the normalized tags applied or removed
+	Tags       []string `json:"tags"`
+	SongIDs    []int    `json:"song_ids"`
+	MissingIDs []int    `json:"missing_ids"`
+	// Changed counts the song-tag links added or removed
+	Changed int64 `json:"changed"`
+}
+
+type TagCountResponse struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type GenreRequest struct {
+	Name string `json:"name"`
+	// ParentID is omitted for top-level genres
+	ParentID int `json:"parent_id"`
+}
+
+type GenreResponse struct {
+	ID        int    `json:"id"`
+	ParentID  int    `json:"parent_id,omitempty"`
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	SongCount int64  `json:"song_count"`
+}
+
+type SongGenresRequest struct {
+	GenreIDs []int `json:"genre_ids"`
+}
+
+type RelationRequest struct {
+	OriginalID int `json:"original_id"`
+	// Type is one of cover, remix, live or translation
+	Type string `json:"type"`
+}
+
+type RelationResponse struct {
+	SongID        int    `json:"song_id"`
+	SongTitle     string `json:"song_title"`
+	OriginalID    int    `json:"original_id"`
+	OriginalTitle string `json:"original_title"`
+	Type          string `json:"type"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type FamilySongResponse struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	// Original is set for songs that are not a version of another song
+	Original bool `json:"original"`
+}
+
+type VersionFamilyResponse struct {
+	Songs     []FamilySongResponse `json:"songs"`
+	Relations []RelationResponse   `json:"relations"`
+}
+
+type LyricsRequest struct {
+	Text string `json:"text"`
+	// Original marks the lyrics the song was written with; they become its text
+	Original   bool   `json:"original"`
+	Translator string `json:"translator"`
+	Source     string `json:"source"`
+}
+
+type LyricsResponse struct {
+	SongID     int    `json:"song_id"`
+	Language   string `json:"language"`
+	Text       string `json:"text"`
+	Original   bool   `json:"original"`
+	Translator string `json:"translator,omitempty"`
+	Source     string `json:"source,omitempty"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+type AlignedLineResponse struct {
+	Verse       int    `json:"verse"`
+	Line        int    `json:"line"`
+	Original    string `json:"original"`
+	Translation string `json:"translation"`
+}
+
+type AlignedLyricsResponse struct {
+	SongID int `json:"song_id"`
+	// OriginalLanguage is empty when the language of the original is unknown
+	OriginalLanguage string                `json:"original_language,omitempty"`
+	Language         string                `json:"language"`
+	Translator       string                `json:"translator,omitempty"`
+	Source           string                `json:"source,omitempty"`
+	Lines            []AlignedLineResponse `json:"lines"`
+}
+
+type WordCountResponse struct {
+	Word  string `json:"word"`
+	Count int    `json:"count"`
+}
+
+type LyricsStatsResponse struct {
+	Lines              int                 `json:"lines"`
+	Words              int                 `json:"words"`
+	Characters         int                 `json:"characters"`
+	CharactersNoSpaces int                 `json:"characters_no_spaces"`
+	Verses             int                 `json:"verses"`
+	UniqueWords        int                 `json:"unique_words"`
+	UniqueWordRatio    float64             `json:"unique_word_ratio"`
+	TopWords           []WordCountResponse `json:"top_words"`
+	ReadingSeconds     int                 `json:"reading_seconds"`
+	SingingSeconds     int                 `json:"singing_seconds"`
+}
+
+type SongStatsResponse struct {
+	SongID int `json:"song_id"`
+	LyricsStatsResponse
+}
+
+type GroupStatsResponse struct {
+	GroupID int `json:"group_id"`
+	Songs   int `json:"songs"`
+	LyricsStatsResponse
+	AverageWords float64 `json:"average_words"`
+}
+
+type SimilarSongResponse struct {
+	Song  SongResponse `json:"song"`
+	Score float64      `json:"score"`
+}
+
+type SongEventResponse struct {
+	Seq  int64  `json:"seq"`
+	Type string `json:"type"`
+	// SongID and Song are left out of reset events; Song is left out of deleted ones
+	SongID int           `json:"song_id,omitempty"`
+	Song   *SongResponse `json:"song,omitempty"`
+	Time   string        `json:"time"`
+}
+
+type OutboxEventResponse struct {
+	ID            int64  `json:"id"`
+	Type          string `json:"type"`
+	SongID        int    `json:"song_id"`
+	Attempts      int    `json:"attempts"`
+	LastError     string `json:"last_error,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	NextAttemptAt string `json:"next_attempt_at"`
+}
+
+type OutboxStatusResponse struct {
+	Pending       int64                 `json:"pending"`
+	OldestPending string                `json:"oldest_pending,omitempty"`
+	Stuck         []OutboxEventResponse `json:"stuck"`
+}
+
+type WebhookRequest struct {
+	URL string `json:"url"`
+	// EventTypes lists the song events notified: created, updated, deleted
+	EventTypes []string `json:"event_types"`
+	// Secret signs the deliveries; when omitted one is generated on
+	// creation and kept on update
+	Secret string `json:"secret,omitempty"`
+	// Active defaults to true
+	Active *bool `json:"active,omitempty"`
+}
+
+type WebhookResponse struct {
+	ID         int      `json:"id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Active     bool     `json:"active"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+type CreatedWebhookResponse struct {
+	WebhookResponse
+	// Secret signs the deliveries, returned only once
+	Secret string `json:"secret"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID        int64  `json:"id"`
+	EventID   int64  `json:"event_id"`
+	EventType string `json:"event_type"`
+	// Status is pending, delivered or failed
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	// ResponseCode is that of the last attempt, 0 when none was received
+	ResponseCode  int    `json:"response_code"`
+	LastError     string `json:"last_error,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	NextAttemptAt string `json:"next_attempt_at,omitempty"`
+	DeliveredAt   string `json:"delivered_at,omitempty"`
+	// Log lists the attempts, oldest first, on single deliveries only
+	Log []WebhookAttemptResponse `json:"log,omitempty"`
+}
+
+type WebhookAttemptResponse struct {
+	At           string `json:"at"`
+	ResponseCode int    `json:"response_code"`
+	Error        string `json:"error,omitempty"`
+	DurationMs   int64  `json:"duration_ms"`
+}
+
+type AuditEntryResponse struct {
+	ID   int64  `json:"id"`
+	Time string `json:"time"`
+	// Actor is the subject of the caller, empty for anonymous calls and
+	// commands run on the server
+	Actor      string `json:"actor,omitempty"`
+	ActorName  string `json:"actor_name,omitempty"`
+	RequestID  string `json:"request_id,omitempty"`
+	Transport  string `json:"transport,omitempty"`
+	Resource   string `json:"resource"`
+	ResourceID int    `json:"resource_id"`
+	Action     string `json:"action"`
+	// Before and After are the record before and after the change, left out
+	// before its creation and after its deletion
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After  json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+}
+
+type AuditLogResponse struct {
+	Entries []AuditEntryResponse `json:"entries"`
+	Total   int64                `json:"total"`
+	Page    int                  `json:"page"`
+	Pages   int                  `json:"pages"`
 }
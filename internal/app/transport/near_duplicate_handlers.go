@@ -0,0 +1,50 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type NearDuplicateHandler struct {
+	nearDuplicateService NearDuplicateService
+}
+
+func NewNearDuplicateHandler(nearDuplicateService NearDuplicateService) *NearDuplicateHandler {
+	return &NearDuplicateHandler{
+		nearDuplicateService: nearDuplicateService,
+	}
+}
+
+// GetNearDuplicates godoc
+// @Summary List songs with nearly the same lyrics
+// @Description List clusters of songs linked by lyrics sharing at least the threshold share of their word sequences, whatever their titles, with the similarity of every linked pair
+// @Tags songs
+// @Produce json
+// @Param threshold query number false "Similarity from which lyrics are near duplicates, above 0 and at most 1; NEAR_DUPLICATE_THRESHOLD by default"
+// @Success 200 {object} NearDuplicatesResponse
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/songs/near-duplicates [get]
+func (h *NearDuplicateHandler) GetNearDuplicates(r common.RequestReader, w http.ResponseWriter) error {
+	threshold := 0.0
+	if thresholdStr := r.QueryParam("threshold"); thresholdStr != "" {
+		var err error
+		threshold, err = strconv.ParseFloat(thresholdStr, 64)
+		if err != nil || threshold <= 0 || threshold > 1 {
+			server.BadRequest("invalid-threshold", fmt.Errorf("%w: threshold must be above 0 and at most 1", domain.ErrInvalidData), w)
+			return nil
+		}
+	}
+
+	clusters, err := h.nearDuplicateService.NearDuplicateClusters(r.Context(), threshold)
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToNearDuplicatesResponse(clusters), w)
+	return nil
+}
@@ -0,0 +1,127 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock near duplicate service
+type MockNearDuplicateService struct {
+	mock.Mock
+}
+
+func (m *MockNearDuplicateService) NearDuplicateClusters(ctx context.Context, threshold float64) ([]domain.NearDuplicateCluster, error) {
+	args := m.Called(ctx, threshold)
+	clusters, _ := args.Get(0).([]domain.NearDuplicateCluster)
+	return clusters, args.Error(1)
+}
+
+func setupNearDuplicateTestRouter(nearDuplicateService *MockNearDuplicateService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	nearDuplicateHandler := NewNearDuplicateHandler(nearDuplicateService)
+	router.GET("/api/v1/songs/near-duplicates", adapter.ToGinHandler(nearDuplicateHandler.GetNearDuplicates))
+
+	return router
+}
+
+func TestNearDuplicateHandler_GetNearDuplicates(t *testing.T) {
+	nearDuplicateService := new(MockNearDuplicateService)
+	router := setupNearDuplicateTestRouter(nearDuplicateService)
+
+	nearDuplicateService.On("NearDuplicateClusters", mock.Anything, 0.9).Return([]domain.NearDuplicateCluster{{
+		Songs: []*domain.Song{{ID: 1, Title: "Yesterday"}, {ID: 4, Title: "Yesterday (lyrics)"}},
+		Pairs: []domain.NearDuplicatePair{{SongID: 1, OtherID: 4, Similarity: 0.95}},
+	}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/near-duplicates?threshold=0.9", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response NearDuplicatesResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Total)
+	assert.Len(t, response.Clusters[0].Songs, 2)
+	assert.Equal(t, []NearDuplicatePairResponse{{SongID: 1, OtherID: 4, Similarity: 0.95}}, response.Clusters[0].Pairs)
+	nearDuplicateService.AssertExpectations(t)
+}
+
+func TestNearDuplicateHandler_GetNearDuplicates_DefaultThreshold(t *testing.T) {
+	nearDuplicateService := new(MockNearDuplicateService)
+	router := setupNearDuplicateTestRouter(nearDuplicateService)
+
+	nearDuplicateService.On("NearDuplicateClusters", mock.Anything, 0.0).Return([]domain.NearDuplicateCluster{}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/near-duplicates", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"total":0,"clusters":[]}`, w.Body.String())
+}
+
+func TestNearDuplicateHandler_GetNearDuplicates_InvalidThreshold(t *testing.T) {
+	nearDuplicateService := new(MockNearDuplicateService)
+	router := setupNearDuplicateTestRouter(nearDuplicateService)
+
+	for _, threshold := range []string{"0", "1.5", "high"} {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/near-duplicates?threshold="+threshold, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, threshold)
+		assert.Contains(t, w.Body.String(), "invalid-threshold")
+	}
+	nearDuplicateService.AssertNotCalled(t, "NearDuplicateClusters", mock.Anything, mock.Anything)
+}
+
+func TestHandler_CreateSong_NearDuplicateWarning(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("CreateSong", mock.Anything, mock.Anything).Return(&domain.Song{
+		ID: 5, Title: "Yesterday (live)",
+		NearDuplicates: []domain.NearDuplicate{{SongID: 1, Title: "Yesterday", Similarity: 0.92}},
+	}, nil)
+
+	body := `{"group_id":1,"title":"Yesterday (live)","release_date":"2020-01-01T00:00:00Z","text":"Yesterday"}`
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"near_duplicates":[{"song_id":1,"title":"Yesterday","similarity":0.92}]`)
+}
+
+func TestHandler_CreateSong_NearDuplicateRejected(t *testing.T) {
+	mockService := new(MockSongService)
+	router := setupTestRouter(mockService)
+
+	mockService.On("CreateSong", mock.Anything, mock.Anything).Return((*domain.Song)(nil), &domain.NearDuplicateError{
+		Matches: []domain.NearDuplicate{{SongID: 1, Title: "Yesterday", Similarity: 0.92}},
+	})
+
+	body := `{"group_id":1,"title":"Yesterday (live)","release_date":"2020-01-01T00:00:00Z","text":"Yesterday"}`
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "/api/v1/songs/1", w.Header().Get("Location"))
+	assert.Contains(t, w.Body.String(), `"slug":"near-duplicate-lyrics"`)
+}
@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type OutboxHandler struct {
+	outboxService OutboxService
+}
+
+func NewOutboxHandler(outboxService OutboxService) *OutboxHandler {
+	return &OutboxHandler{
+		outboxService: outboxService,
+	}
+}
+
+// GetOutboxStatus godoc
+// @Summary Watch over the outbox
+// @Description Count the song events waiting in the outbox for delivery to the sinks and list those stuck, pending for longer than OUTBOX_STUCK_AFTER, with the number of attempts and the last error
+// @Tags admin
+// @Produce json
+// @Success 200 {object} OutboxStatusResponse
+// @Failure 401,403,500 {object} map[string]string
+// @Router /api/v1/admin/outbox [get]
+func (h *OutboxHandler) GetOutboxStatus(r common.RequestReader, w http.ResponseWriter) error {
+	status, err := h.outboxService.Status(r.Context())
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToOutboxStatusResponse(status), w)
+	return nil
+}
+
+// RetryOutboxEvent godoc
+// @Summary Retry an outbox event
+// @Description Make a pending event due now rather than at the end of its backoff, for instance once the failing sink was fixed
+// @Tags admin
+// @Produce json
+// @Param id path int true "Outbox event ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/outbox/{id}/retry [post]
+func (h *OutboxHandler) RetryOutboxEvent(r common.RequestReader, w http.ResponseWriter) error {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-event-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		server.BadRequest("invalid-event-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	if err := h.outboxService.Retry(r.Context(), id); err != nil {
+		if errors.Is(err, domain.ErrOutboxEventNotFound) {
+			server.NotFound(domain.ErrOutboxEventNotFound.Slug(), err, w)
+			return nil
+		}
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Scheduled outbox event for delivery", w)
+	return nil
+}
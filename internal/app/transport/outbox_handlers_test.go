@@ -0,0 +1,94 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock outbox service
+type MockOutboxService struct {
+	mock.Mock
+}
+
+func (m *MockOutboxService) Status(ctx context.Context) (*domain.OutboxStatus, error) {
+	args := m.Called(ctx)
+	status, _ := args.Get(0).(*domain.OutboxStatus)
+	return status, args.Error(1)
+}
+
+func (m *MockOutboxService) Retry(ctx context.Context, id int64) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func setupOutboxTestRouter(outboxService *MockOutboxService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	outboxHandler := NewOutboxHandler(outboxService)
+	router.GET("/api/v1/admin/outbox", adapter.ToGinHandler(outboxHandler.GetOutboxStatus))
+	router.POST("/api/v1/admin/outbox/:id/retry", adapter.ToGinHandler(outboxHandler.RetryOutboxEvent))
+
+	return router
+}
+
+func TestOutboxHandler_GetOutboxStatus(t *testing.T) {
+	outboxService := new(MockOutboxService)
+	router := setupOutboxTestRouter(outboxService)
+
+	oldest := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	outboxService.On("Status", mock.Anything).Return(&domain.OutboxStatus{
+		Pending:       4,
+		OldestPending: &oldest,
+		Stuck: []domain.OutboxEvent{
+			{ID: 3, Type: domain.SongEventUpdated, SongID: 7, Attempts: 5, LastError: "webhook: responded 503", CreatedAt: oldest, NextAttemptAt: oldest.Add(time.Minute)},
+		},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/outbox", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response OutboxStatusResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(4), response.Pending)
+	assert.Equal(t, "2024-05-01T12:00:00Z", response.OldestPending)
+	assert.Len(t, response.Stuck, 1)
+	assert.Equal(t, "webhook: responded 503", response.Stuck[0].LastError)
+	assert.Equal(t, "2024-05-01T12:01:00Z", response.Stuck[0].NextAttemptAt)
+}
+
+func TestOutboxHandler_RetryOutboxEvent(t *testing.T) {
+	outboxService := new(MockOutboxService)
+	router := setupOutboxTestRouter(outboxService)
+
+	outboxService.On("Retry", mock.Anything, int64(3)).Return(nil)
+	outboxService.On("Retry", mock.Anything, int64(9)).Return(domain.ErrOutboxEventNotFound)
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/api/v1/admin/outbox/3/retry", http.StatusOK},
+		{"/api/v1/admin/outbox/9/retry", http.StatusNotFound},
+		{"/api/v1/admin/outbox/abc/retry", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodPost, tt.path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tt.status, w.Code, tt.path)
+	}
+	outboxService.AssertExpectations(t)
+}
@@ -0,0 +1,390 @@
+package transport
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type PlaylistHandler struct {
+	playlistService PlaylistService
+}
+
+func NewPlaylistHandler(playlistService PlaylistService) *PlaylistHandler {
+	return &PlaylistHandler{
+		playlistService: playlistService,
+	}
+}
+
+// ListPlaylists godoc
+// @Summary List playlists
+// @Description Get playlists with their number of songs, with pagination
+// @Tags playlists
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} PlaylistsResponse
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/playlists [get]
+func (h *PlaylistHandler) ListPlaylists(r common.RequestReader, w http.ResponseWriter) error {
+	page, err := strconv.Atoi(r.DefaultQueryParam("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(r.DefaultQueryParam("page_size", "10"))
+	if err != nil || pageSize < 1 {
+		pageSize = 10
+	}
+
+	playlists, total, err := h.playlistService.ListPlaylists(r.Context(), page, pageSize)
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(PlaylistsResponse{
+		Playlists: ToPlaylistsResponse(playlists),
+		Total:     total,
+		Page:      page,
+		Pages:     (int(total) + pageSize - 1) / pageSize,
+	}, w)
+	return nil
+}
+
+// CreatePlaylist godoc
+// @Summary Create a playlist
+// @Description Create an empty playlist
+// @Tags playlists
+// @Accept json
+// @Produce json
+// @Param playlist body PlaylistRequest true "Playlist name"
+// @Success 200 {object} PlaylistDetailResponse
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/playlists [post]
+func (h *PlaylistHandler) CreatePlaylist(r common.RequestReader, w http.ResponseWriter) error {
+	var req PlaylistRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	playlist, err := h.playlistService.CreatePlaylist(r.Context(), req.Name)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToPlaylistDetailResponse(playlist), w)
+	return nil
+}
+
+// GetPlaylist godoc
+// @Summary Get a playlist
+// @Description Get a playlist with its songs in order
+// @Tags playlists
+// @Produce json
+// @Param id path int true "Playlist ID"
+// @Success 200 {object} PlaylistDetailResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/playlists/{id} [get]
+func (h *PlaylistHandler) GetPlaylist(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := playlistID(r, w)
+	if !ok {
+		return nil
+	}
+
+	playlist, err := h.playlistService.GetPlaylist(r.Context(), id)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToPlaylistDetailResponse(playlist), w)
+	return nil
+}
+
+// RenamePlaylist godoc
+// @Summary Rename a playlist
+// @Tags playlists
+// @Accept json
+// @Produce json
+// @Param id path int true "Playlist ID"
+// @Param playlist body PlaylistRequest true "New name"
+// @Success 200 {object} PlaylistResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/playlists/{id} [patch]
+func (h *PlaylistHandler) RenamePlaylist(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := playlistID(r, w)
+	if !ok {
+		return nil
+	}
+
+	var req PlaylistRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	playlist, err := h.playlistService.RenamePlaylist(r.Context(), id, req.Name)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToPlaylistResponse(playlist), w)
+	return nil
+}
+
+// DeletePlaylist godoc
+// @Summary Delete a playlist
+// @Description Delete a playlist; its songs are kept
+// @Tags playlists
+// @Produce json
+// @Param id path int true "Playlist ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/playlists/{id} [delete]
+func (h *PlaylistHandler) DeletePlaylist(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := playlistID(r, w)
+	if !ok {
+		return nil
+	}
+
+	if err := h.playlistService.DeletePlaylist(r.Context(), id); err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Deleted playlist", w)
+	return nil
+}
+
+// AddPlaylistSong godoc
+// @Summary Add a song to a playlist
+// @Description Insert a song at a 1-based position, moving the following songs down; without a position the song is appended
+// @Tags playlists
+// @Accept json
+// @Produce json
+// @Param id path int true "Playlist ID"
+// @Param song body AddPlaylistSongRequest true "Song and position"
+// @Success 200 {object} PlaylistDetailResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/playlists/{id}/songs [post]
+func (h *PlaylistHandler) AddPlaylistSong(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := playlistID(r, w)
+	if !ok {
+		return nil
+	}
+
+	var req AddPlaylistSongRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	playlist, err := h.playlistService.AddSong(r.Context(), id, req.SongID, req.Position)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToPlaylistDetailResponse(playlist), w)
+	return nil
+}
+
+// RemovePlaylistSong godoc
+// @Summary Remove a song from a playlist
+// @Description Remove the song at a position; the following songs move up
+// @Tags playlists
+// @Produce json
+// @Param id path int true "Playlist ID"
+// @Param position path int true "1-based position"
+// @Success 200 {object} PlaylistDetailResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/playlists/{id}/songs/{position} [delete]
+func (h *PlaylistHandler) RemovePlaylistSong(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := playlistID(r, w)
+	if !ok {
+		return nil
+	}
+	position, ok := playlistPosition(r, w)
+	if !ok {
+		return nil
+	}
+
+	playlist, err := h.playlistService.RemoveSong(r.Context(), id, position)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToPlaylistDetailResponse(playlist), w)
+	return nil
+}
+
+// MovePlaylistSong godoc
+// @Summary Reorder a playlist
+// @Description Move the song at a position to another position; the songs in between shift by one
+// @Tags playlists
+// @Accept json
+// @Produce json
+// @Param id path int true "Playlist ID"
+// @Param position path int true "Current 1-based position"
+// @Param move body MovePlaylistSongRequest true "New position"
+// @Success 200 {object} PlaylistDetailResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/playlists/{id}/songs/{position}/move [post]
+func (h *PlaylistHandler) MovePlaylistSong(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := playlistID(r, w)
+	if !ok {
+		return nil
+	}
+	position, ok := playlistPosition(r, w)
+	if !ok {
+		return nil
+	}
+
+	var req MovePlaylistSongRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	playlist, err := h.playlistService.MoveSong(r.Context(), id, position, req.Position)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToPlaylistDetailResponse(playlist), w)
+	return nil
+}
+
+// DuplicatePlaylist godoc
+// @Summary Duplicate a playlist
+// @Description Copy a playlist and its songs; without a name the copy is called "<name> (copy)"
+// @Tags playlists
+// @Accept json
+// @Produce json
+// @Param id path int true "Playlist ID"
+// @Param playlist body PlaylistRequest false "Name of the copy"
+// @Success 200 {object} PlaylistDetailResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/playlists/{id}/duplicate [post]
+func (h *PlaylistHandler) DuplicatePlaylist(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := playlistID(r, w)
+	if !ok {
+		return nil
+	}
+
+	// The body is optional
+	var req PlaylistRequest
+	if body := r.Body(); body != nil {
+		if err := json.NewDecoder(body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			server.BadRequest("invalid-request-body", err, w)
+			return nil
+		}
+	}
+
+	playlist, err := h.playlistService.DuplicatePlaylist(r.Context(), id, req.Name)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToPlaylistDetailResponse(playlist), w)
+	return nil
+}
+
+// GetSongPlaylists godoc
+// @Summary List the playlists of a song
+// @Description List the playlists containing a song
+// @Tags playlists
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {object} PlaylistsResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/playlists [get]
+func (h *PlaylistHandler) GetSongPlaylists(r common.RequestReader, w http.ResponseWriter) error {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	songID, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	playlists, err := h.playlistService.PlaylistsWithSong(r.Context(), songID)
+	if err != nil {
+		respondPlaylistError(err, w)
+		return nil
+	}
+
+	server.RespondOK(PlaylistsResponse{
+		Playlists: ToPlaylistsResponse(playlists),
+		Total:     int64(len(playlists)),
+	}, w)
+	return nil
+}
+
+// playlistID parses the playlist ID path parameter, answering 400 when it is invalid
+func playlistID(r common.RequestReader, w http.ResponseWriter) (int, bool) {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-playlist-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-playlist-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+	return id, true
+}
+
+// playlistPosition parses the position path parameter, answering 400 when it is invalid
+func playlistPosition(r common.RequestReader, w http.ResponseWriter) (int, bool) {
+	positionStr, err := r.PathParam("position")
+	if err != nil {
+		server.BadRequest(domain.ErrInvalidPosition.Slug(), domain.ErrInvalidPosition, w)
+		return 0, false
+	}
+
+	position, err := strconv.Atoi(positionStr)
+	if err != nil {
+		server.BadRequest(domain.ErrInvalidPosition.Slug(), domain.ErrInvalidPosition, w)
+		return 0, false
+	}
+	return position, true
+}
+
+// respondPlaylistError answers with the status matching a playlist service error
+func respondPlaylistError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrSongNotFound):
+		server.NotFound(domain.ErrSongNotFound.Slug(), err, w)
+	case errors.Is(err, domain.ErrNotFound):
+		server.NotFound("playlist-not-found", err, w)
+	case errors.Is(err, domain.ErrInvalidID):
+		server.BadRequest("invalid-playlist-id", err, w)
+	case errors.Is(err, domain.ErrInvalidPosition):
+		server.BadRequest(domain.ErrInvalidPosition.Slug(), err, w)
+	case errors.Is(err, domain.ErrRequired):
+		server.BadRequest("name-required", err, w)
+	case errors.Is(err, domain.ErrValidation):
+		server.BadRequest("name-too-long", err, w)
+	default:
+		server.RespondWithError(err, w)
+	}
+}
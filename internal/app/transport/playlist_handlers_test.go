@@ -0,0 +1,206 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock playlist service
+type MockPlaylistService struct {
+	mock.Mock
+}
+
+func (m *MockPlaylistService) CreatePlaylist(ctx context.Context, name string) (*domain.Playlist, error) {
+	args := m.Called(ctx, name)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistService) GetPlaylist(ctx context.Context, id int) (*domain.Playlist, error) {
+	args := m.Called(ctx, id)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistService) ListPlaylists(ctx context.Context, page, pageSize int) ([]*domain.Playlist, int64, error) {
+	args := m.Called(ctx, page, pageSize)
+	playlists, _ := args.Get(0).([]*domain.Playlist)
+	return playlists, args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockPlaylistService) RenamePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error) {
+	args := m.Called(ctx, id, name)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistService) DeletePlaylist(ctx context.Context, id int) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockPlaylistService) AddSong(ctx context.Context, playlistID, songID, position int) (*domain.Playlist, error) {
+	args := m.Called(ctx, playlistID, songID, position)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistService) RemoveSong(ctx context.Context, playlistID, position int) (*domain.Playlist, error) {
+	args := m.Called(ctx, playlistID, position)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistService) MoveSong(ctx context.Context, playlistID, from, to int) (*domain.Playlist, error) {
+	args := m.Called(ctx, playlistID, from, to)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistService) DuplicatePlaylist(ctx context.Context, id int, name string) (*domain.Playlist, error) {
+	args := m.Called(ctx, id, name)
+	playlist, _ := args.Get(0).(*domain.Playlist)
+	return playlist, args.Error(1)
+}
+
+func (m *MockPlaylistService) PlaylistsWithSong(ctx context.Context, songID int) ([]*domain.Playlist, error) {
+	args := m.Called(ctx, songID)
+	playlists, _ := args.Get(0).([]*domain.Playlist)
+	return playlists, args.Error(1)
+}
+
+func setupPlaylistTestRouter(mockService *MockPlaylistService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewPlaylistHandler(mockService)
+	router.GET("/api/v1/playlists/:id", adapter.ToGinHandler(handler.GetPlaylist))
+	router.POST("/api/v1/playlists/:id/songs", adapter.ToGinHandler(handler.AddPlaylistSong))
+	router.POST("/api/v1/playlists/:id/songs/:position/move", adapter.ToGinHandler(handler.MovePlaylistSong))
+	router.POST("/api/v1/playlists/:id/duplicate", adapter.ToGinHandler(handler.DuplicatePlaylist))
+	router.GET("/api/v1/songs/:id/playlists", adapter.ToGinHandler(handler.GetSongPlaylists))
+
+	return router
+}
+
+func TestPlaylistHandler_GetPlaylist(t *testing.T) {
+	mockService := new(MockPlaylistService)
+	router := setupPlaylistTestRouter(mockService)
+
+	mockService.On("GetPlaylist", mock.Anything, 1).Return(&domain.Playlist{
+		ID:        1,
+		Name:      "Road trip",
+		SongCount: 2,
+		Entries: []domain.PlaylistEntry{
+			{Position: 1, Song: &domain.Song{ID: 4, Title: "Hey Jude"}},
+			{Position: 2, Song: &domain.Song{ID: 9, Title: "Let It Be"}},
+		},
+	}, nil)
+	mockService.On("GetPlaylist", mock.Anything, 2).Return(nil, domain.ErrNotFound)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/playlists/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response PlaylistDetailResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Road trip", response.Name)
+	assert.Len(t, response.Songs, 2)
+	assert.Equal(t, 2, response.Songs[1].Position)
+	assert.Equal(t, 9, response.Songs[1].Song.ID)
+
+	req, _ = http.NewRequest(http.MethodGet, "/api/v1/playlists/2", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "playlist-not-found")
+}
+
+func TestPlaylistHandler_AddPlaylistSong(t *testing.T) {
+	mockService := new(MockPlaylistService)
+	router := setupPlaylistTestRouter(mockService)
+
+	mockService.On("AddSong", mock.Anything, 1, 4, 0).Return(&domain.Playlist{ID: 1, SongCount: 1}, nil)
+	mockService.On("AddSong", mock.Anything, 1, 5, 0).Return(nil, domain.ErrSongNotFound)
+	mockService.On("AddSong", mock.Anything, 1, 4, 9).Return(nil, domain.ErrInvalidPosition)
+
+	tests := []struct {
+		name       string
+		body       AddPlaylistSongRequest
+		wantStatus int
+		wantSlug   string
+	}{
+		{name: "appended", body: AddPlaylistSongRequest{SongID: 4}, wantStatus: http.StatusOK},
+		{name: "unknown song", body: AddPlaylistSongRequest{SongID: 5}, wantStatus: http.StatusNotFound, wantSlug: "song-not-found"},
+		{name: "bad position", body: AddPlaylistSongRequest{SongID: 4, Position: 9}, wantStatus: http.StatusBadRequest, wantSlug: "invalid-position"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/playlists/1/songs", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantSlug)
+		})
+	}
+}
+
+func TestPlaylistHandler_MovePlaylistSong(t *testing.T) {
+	mockService := new(MockPlaylistService)
+	router := setupPlaylistTestRouter(mockService)
+
+	mockService.On("MoveSong", mock.Anything, 1, 3, 1).Return(&domain.Playlist{ID: 1, SongCount: 3}, nil)
+
+	body, _ := json.Marshal(MovePlaylistSongRequest{Position: 1})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/playlists/1/songs/3/move", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestPlaylistHandler_DuplicatePlaylist_WithoutBody(t *testing.T) {
+	mockService := new(MockPlaylistService)
+	router := setupPlaylistTestRouter(mockService)
+
+	mockService.On("DuplicatePlaylist", mock.Anything, 1, "").Return(&domain.Playlist{ID: 2, Name: "Road trip (copy)"}, nil)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/playlists/1/duplicate", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Road trip (copy)")
+}
+
+func TestPlaylistHandler_GetSongPlaylists(t *testing.T) {
+	mockService := new(MockPlaylistService)
+	router := setupPlaylistTestRouter(mockService)
+
+	mockService.On("PlaylistsWithSong", mock.Anything, 4).Return([]*domain.Playlist{{ID: 1, Name: "Road trip"}, {ID: 3, Name: "Chill"}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/4/playlists", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response PlaylistsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(2), response.Total)
+	assert.Equal(t, "Chill", response.Playlists[1].Name)
+}
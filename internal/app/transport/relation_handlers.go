@@ -0,0 +1,157 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type RelationHandler struct {
+	relationService RelationService
+}
+
+func NewRelationHandler(relationService RelationService) *RelationHandler {
+	return &RelationHandler{
+		relationService: relationService,
+	}
+}
+
+// LinkSong godoc
+// @Summary Link a song to its original
+// @Description Record that a song is a cover, remix, live version or translation of another song. A song cannot become a version of one of its own versions.
+// @Tags relations
+// @Accept json
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param relation body RelationRequest true "Original and relation type"
+// @Success 200 {object} RelationResponse
+// @Failure 400,404,409,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/relations [post]
+func (h *RelationHandler) LinkSong(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	var req RelationRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	relation, err := h.relationService.LinkSongs(r.Context(), id, req.OriginalID, domain.RelationType(req.Type))
+	if err != nil {
+		respondRelationError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToRelationResponse(*relation), w)
+	return nil
+}
+
+// UnlinkSong godoc
+// @Summary Unlink a song from its original
+// @Description Remove the relation between a song and one of its originals
+// @Tags relations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param original_id path int true "Original song ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/relations/{original_id} [delete]
+func (h *RelationHandler) UnlinkSong(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	originalStr, err := r.PathParam("original_id")
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return nil
+	}
+	originalID, err := strconv.Atoi(originalStr)
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return nil
+	}
+
+	if err := h.relationService.UnlinkSongs(r.Context(), id, originalID); err != nil {
+		respondRelationError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Deleted relation", w)
+	return nil
+}
+
+// GetSongRelations godoc
+// @Summary List the relations of a song
+// @Description Get the songs a song is a version of and the versions of the song
+// @Tags relations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {array} RelationResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/relations [get]
+func (h *RelationHandler) GetSongRelations(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	relations, err := h.relationService.SongRelations(r.Context(), id)
+	if err != nil {
+		respondRelationError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToRelationResponses(relations), w)
+	return nil
+}
+
+// GetSongFamily godoc
+// @Summary Get the version family of a song
+// @Description Get every song connected to a song through relations in either direction, such as the original, its covers and their translations
+// @Tags relations
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {object} VersionFamilyResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/family [get]
+func (h *RelationHandler) GetSongFamily(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	family, err := h.relationService.SongFamily(r.Context(), id)
+	if err != nil {
+		respondRelationError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToVersionFamilyResponse(family), w)
+	return nil
+}
+
+// respondRelationError answers with the status matching a relation service error
+func respondRelationError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrSongNotFound):
+		server.NotFound(domain.ErrSongNotFound.Slug(), err, w)
+	case errors.Is(err, domain.ErrNotFound):
+		server.NotFound("relation-not-found", err, w)
+	case errors.Is(err, domain.ErrInvalidRelation):
+		server.BadRequest(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrRelationCycle):
+		server.Conflict(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrDuplicate):
+		server.Conflict("duplicate-relation", err, w)
+	default:
+		server.RespondWithError(err, w)
+	}
+}
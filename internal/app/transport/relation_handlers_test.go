@@ -0,0 +1,200 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock relation service
+type MockRelationService struct {
+	mock.Mock
+}
+
+func (m *MockRelationService) LinkSongs(ctx context.Context, songID, originalID int, relationType domain.RelationType) (*domain.SongRelation, error) {
+	args := m.Called(ctx, songID, originalID, relationType)
+	relation, _ := args.Get(0).(*domain.SongRelation)
+	return relation, args.Error(1)
+}
+
+func (m *MockRelationService) UnlinkSongs(ctx context.Context, songID, originalID int) error {
+	return m.Called(ctx, songID, originalID).Error(0)
+}
+
+func (m *MockRelationService) SongRelations(ctx context.Context, songID int) ([]domain.SongRelation, error) {
+	args := m.Called(ctx, songID)
+	relations, _ := args.Get(0).([]domain.SongRelation)
+	return relations, args.Error(1)
+}
+
+func (m *MockRelationService) SongFamily(ctx context.Context, songID int) (*domain.VersionFamily, error) {
+	args := m.Called(ctx, songID)
+	family, _ := args.Get(0).(*domain.VersionFamily)
+	return family, args.Error(1)
+}
+
+func setupRelationTestRouter(songService *MockSongService, relationService *MockRelationService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewHandler(songService, relationService, nil)
+	relationHandler := NewRelationHandler(relationService)
+	router.GET("/api/v1/songs/:id", adapter.ToGinHandler(handler.GetSong))
+	router.GET("/api/v1/songs/:id/relations", adapter.ToGinHandler(relationHandler.GetSongRelations))
+	router.POST("/api/v1/songs/:id/relations", adapter.ToGinHandler(relationHandler.LinkSong))
+	router.DELETE("/api/v1/songs/:id/relations/:original_id", adapter.ToGinHandler(relationHandler.UnlinkSong))
+	router.GET("/api/v1/songs/:id/family", adapter.ToGinHandler(relationHandler.GetSongFamily))
+
+	return router
+}
+
+func TestRelationHandler_LinkSong(t *testing.T) {
+	relationService := new(MockRelationService)
+	router := setupRelationTestRouter(new(MockSongService), relationService)
+
+	relationService.On("LinkSongs", mock.Anything, 2, 1, domain.RelationCover).Return(&domain.SongRelation{
+		SongID: 2, SongTitle: "Cover", OriginalID: 1, OriginalTitle: "Original", Type: domain.RelationCover,
+	}, nil)
+
+	body, _ := json.Marshal(RelationRequest{OriginalID: 1, Type: "cover"})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs/2/relations", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response RelationResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Original", response.OriginalTitle)
+	assert.Equal(t, "cover", response.Type)
+}
+
+func TestRelationHandler_LinkSong_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantSlug   string
+	}{
+		{name: "cycle", err: domain.ErrRelationCycle, wantStatus: http.StatusConflict, wantSlug: "relation-cycle"},
+		{name: "duplicate", err: domain.ErrDuplicate, wantStatus: http.StatusConflict, wantSlug: "duplicate-relation"},
+		{name: "invalid", err: domain.ErrInvalidRelation, wantStatus: http.StatusBadRequest, wantSlug: "invalid-relation"},
+		{name: "unknown song", err: domain.ErrSongNotFound, wantStatus: http.StatusNotFound, wantSlug: "song-not-found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relationService := new(MockRelationService)
+			router := setupRelationTestRouter(new(MockSongService), relationService)
+
+			relationService.On("LinkSongs", mock.Anything, 1, 2, domain.RelationRemix).Return(nil, tt.err)
+
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs/1/relations", bytes.NewBufferString(`{"original_id":2,"type":"remix"}`))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantSlug)
+		})
+	}
+}
+
+func TestRelationHandler_UnlinkSong_NotFound(t *testing.T) {
+	relationService := new(MockRelationService)
+	router := setupRelationTestRouter(new(MockSongService), relationService)
+
+	relationService.On("UnlinkSongs", mock.Anything, 2, 1).Return(domain.ErrNotFound)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/songs/2/relations/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "relation-not-found")
+}
+
+func TestRelationHandler_GetSongFamily(t *testing.T) {
+	relationService := new(MockRelationService)
+	router := setupRelationTestRouter(new(MockSongService), relationService)
+
+	relationService.On("SongFamily", mock.Anything, 2).Return(&domain.VersionFamily{
+		Songs: []domain.FamilySong{{ID: 1, Title: "Original", Original: true}, {ID: 2, Title: "Cover"}},
+		Relations: []domain.SongRelation{
+			{SongID: 2, SongTitle: "Cover", OriginalID: 1, OriginalTitle: "Original", Type: domain.RelationCover},
+		},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/2/family", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response VersionFamilyResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.Songs, 2)
+	assert.True(t, response.Songs[0].Original)
+	assert.Equal(t, 1, response.Relations[0].OriginalID)
+}
+
+func TestHandler_GetSong_IncludeRelations(t *testing.T) {
+	songService := new(MockSongService)
+	relationService := new(MockRelationService)
+	router := setupRelationTestRouter(songService, relationService)
+
+	songService.On("GetSong", mock.Anything, 2).Return(&domain.Song{ID: 2, Title: "Cover"}, nil)
+	relationService.On("SongRelations", mock.Anything, 2).Return([]domain.SongRelation{
+		{SongID: 2, SongTitle: "Cover", OriginalID: 1, OriginalTitle: "Original", Type: domain.RelationCover},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/2?include=relations", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response SongResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.Relations, 1)
+	assert.Equal(t, "Original", response.Relations[0].OriginalTitle)
+}
+
+func TestHandler_GetSong_WithoutRelations(t *testing.T) {
+	songService := new(MockSongService)
+	relationService := new(MockRelationService)
+	router := setupRelationTestRouter(songService, relationService)
+
+	songService.On("GetSong", mock.Anything, 2).Return(&domain.Song{ID: 2, Title: "Cover"}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/2", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "relations")
+	relationService.AssertNotCalled(t, "SongRelations", mock.Anything, mock.Anything)
+}
+
+func TestHandler_GetSong_UnknownInclude(t *testing.T) {
+	router := setupRelationTestRouter(new(MockSongService), new(MockRelationService))
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/2?include=lyrics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid-include")
+}
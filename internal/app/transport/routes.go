@@ -4,26 +4,220 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/swaggo/files"
 	"github.com/swaggo/gin-swagger"
+	"log"
+	"net/http"
 	_ "songs/docs"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
 	"songs/internal/app/transport/adapter"
+	"songs/internal/app/transport/middleware"
+	"strings"
 )
 
-func SetupRouter(svc SongService) *gin.Engine {
+// Services groups the application services exposed over HTTP
+type Services struct {
+	Songs          SongService
+	Import         ImportService
+	Batch          BatchService
+	Duplicates     DuplicateService
+	APIKeys        APIKeyService
+	Playlists      PlaylistService
+	Albums         AlbumService
+	Tags           TagService
+	Genres         GenreService
+	Relations      RelationService
+	Lyrics         LyricsService
+	Stats          StatsService
+	Similarity     SimilarityService
+	NearDuplicates NearDuplicateService
+	ChangeFeed     ChangeFeedService
+	// Outbox is optional; without it the outbox routes are not registered
+	Outbox OutboxService
+	// Webhooks is optional; without it the webhook routes are not registered
+	Webhooks WebhookService
+	// Audit is optional; without it the audit log route is not registered
+	Audit AuditService
+	// Auth is optional; without it every route is public
+	Auth middleware.Authenticator
+	// Idempotency is optional; without it Idempotency-Key headers are ignored
+	Idempotency middleware.IdempotencyService
+	// RateLimit is optional; without it requests are not throttled
+	RateLimit middleware.RateLimiter
+	// AuthThrottle is optional; without it invalid credentials are not
+	// throttled
+	AuthThrottle *middleware.AuthThrottle
+	// TrustedProxies lists the IPs or CIDRs of the reverse proxies whose
+	// X-Forwarded-For header is believed; without them the client IP is the
+	// remote address, so anonymous callers cannot pick their own bucket
+	TrustedProxies []string
+}
+
+// Rate limit costs of the routes in tokens. Searches and exports scan far
+// more rows than single-song calls and hold a connection for longer.
+const (
+	costDefault = 1
+	costSearch  = 3
+	costBulk    = 5
+	costExport  = 10
+)
+
+type handlerFunc = func(common.RequestReader, http.ResponseWriter) error
+
+func SetupRouter(services Services) *gin.Engine {
 	r := gin.Default()
+	if err := r.SetTrustedProxies(services.TrustedProxies); err != nil {
+		log.Printf("router: %v, trusting no proxy", err)
+		_ = r.SetTrustedProxies(nil)
+	}
+
+	handler := NewHandler(services.Songs, services.Relations, services.Lyrics)
+	importHandler := NewImportHandler(services.Import)
+	batchHandler := NewBatchHandler(services.Batch)
+	duplicateHandler := NewDuplicateHandler(services.Duplicates)
+	apiKeyHandler := NewAPIKeyHandler(services.APIKeys)
+	playlistHandler := NewPlaylistHandler(services.Playlists)
+	albumHandler := NewAlbumHandler(services.Albums)
+	tagHandler := NewTagHandler(services.Tags)
+	genreHandler := NewGenreHandler(services.Genres)
+	relationHandler := NewRelationHandler(services.Relations)
+	lyricsHandler := NewLyricsHandler(services.Lyrics)
+	statsHandler := NewStatsHandler(services.Stats)
+	similarityHandler := NewSimilarityHandler(services.Similarity)
+	nearDuplicateHandler := NewNearDuplicateHandler(services.NearDuplicates)
+	changeFeedHandler := NewChangeFeedHandler(services.ChangeFeed)
+	outboxHandler := NewOutboxHandler(services.Outbox)
+	webhookHandler := NewWebhookHandler(services.Webhooks)
+	auditHandler := NewAuditHandler(services.Audit)
 
-	handler := NewHandler(svc)
+	// as returns the middleware chain of a route needing the given role and
+	// costing the given number of rate limit tokens. Song writes also honour
+	// the Idempotency-Key header, checked after authorization so anonymous
+	// calls never reach the idempotency store.
+	as := func(role domain.Role, cost int, h handlerFunc) []gin.HandlerFunc {
+		var chain []gin.HandlerFunc
+		if services.RateLimit != nil {
+			chain = append(chain, middleware.RateLimit(services.RateLimit, cost))
+		}
+		if services.Auth != nil {
+			chain = append(chain, middleware.Require(role))
+		}
+		if role == domain.RoleEditor && services.Idempotency != nil {
+			chain = append(chain, middleware.Idempotency(services.Idempotency))
+		}
+		return append(chain, adapter.ToGinHandler(h))
+	}
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	api := r.Group("/api/v1")
+	api.Use(middleware.RequestID())
+	if services.Auth != nil {
+		if services.AuthThrottle != nil {
+			api.Use(middleware.ThrottleAuth(services.AuthThrottle))
+		}
+		api.Use(middleware.Authenticate(services.Auth))
+	}
 	{
-		api.GET("/songs", adapter.ToGinHandler(handler.GetSongs))
-		api.GET("/songs/:id", adapter.ToGinHandler(handler.GetSong))
-		api.POST("/songs", adapter.ToGinHandler(handler.CreateSong))
-		api.PUT("/songs/:id", adapter.ToGinHandler(handler.UpdateSong))
-		api.PATCH("/songs/:id", adapter.ToGinHandler(handler.PartialUpdateSong))
-		api.DELETE("/songs/:id", adapter.ToGinHandler(handler.DeleteSong))
-		api.GET("/songs/:id/verses", adapter.ToGinHandler(handler.GetSongVerses))
+		api.GET("/songs", as(domain.RoleReader, costSearch, handler.GetSongs)...)
+		api.GET("/songs/duplicates", as(domain.RoleReader, costSearch, duplicateHandler.GetDuplicates)...)
+		api.GET("/songs/near-duplicates", as(domain.RoleReader, costExport, nearDuplicateHandler.GetNearDuplicates)...)
+		api.GET("/songs/:id", as(domain.RoleReader, costDefault, handler.GetSong)...)
+		api.POST("/songs", as(domain.RoleEditor, costDefault, handler.CreateSong)...)
+		api.PUT("/songs/:id", as(domain.RoleEditor, costDefault, handler.UpdateSong)...)
+		api.PATCH("/songs/:id", as(domain.RoleEditor, costDefault, handler.PartialUpdateSong)...)
+		api.DELETE("/songs/:id", as(domain.RoleEditor, costDefault, handler.DeleteSong)...)
+		api.GET("/songs/:id/verses", as(domain.RoleReader, costDefault, handler.GetSongVerses)...)
+		api.POST("/songs/:id/merge", as(domain.RoleEditor, costBulk, duplicateHandler.MergeSongs)...)
+		api.GET("/songs/:id/playlists", as(domain.RoleReader, costDefault, playlistHandler.GetSongPlaylists)...)
+		api.GET("/songs/:id/tags", as(domain.RoleReader, costDefault, tagHandler.GetSongTags)...)
+		api.GET("/songs/:id/genres", as(domain.RoleReader, costDefault, genreHandler.GetSongGenres)...)
+		api.PUT("/songs/:id/genres", as(domain.RoleEditor, costDefault, genreHandler.SetSongGenres)...)
+		api.GET("/songs/:id/relations", as(domain.RoleReader, costDefault, relationHandler.GetSongRelations)...)
+		api.POST("/songs/:id/relations", as(domain.RoleEditor, costDefault, relationHandler.LinkSong)...)
+		api.DELETE("/songs/:id/relations/:original_id", as(domain.RoleEditor, costDefault, relationHandler.UnlinkSong)...)
+		api.GET("/songs/:id/family", as(domain.RoleReader, costSearch, relationHandler.GetSongFamily)...)
+		api.GET("/songs/:id/lyrics", as(domain.RoleReader, costDefault, lyricsHandler.ListSongLyrics)...)
+		api.PUT("/songs/:id/lyrics/:lang", as(domain.RoleEditor, costDefault, lyricsHandler.PutSongLyrics)...)
+		api.DELETE("/songs/:id/lyrics/:lang", as(domain.RoleEditor, costDefault, lyricsHandler.DeleteSongLyrics)...)
+		api.GET("/songs/:id/lyrics/:lang/aligned", as(domain.RoleReader, costDefault, lyricsHandler.GetAlignedLyrics)...)
+		api.GET("/songs/:id/stats", as(domain.RoleReader, costDefault, statsHandler.GetSongStats)...)
+		api.GET("/songs/:id/similar", as(domain.RoleReader, costSearch, similarityHandler.GetSimilarSongs)...)
+
+		// Custom methods on the songs collection, e.g. POST /songs:import
+		api.GET("/songs:method", as(domain.RoleReader, costExport, customMethods(map[string]handlerFunc{
+			"export": handler.ExportSongs,
+		}))...)
+		api.POST("/songs:method", as(domain.RoleEditor, costBulk, customMethods(map[string]handlerFunc{
+			"import":      importHandler.ImportSongs,
+			"batchCreate": batchHandler.BatchCreateSongs,
+			"batchUpdate": batchHandler.BatchUpdateSongs,
+			"batchDelete": batchHandler.BatchDeleteSongs,
+			"tag":         tagHandler.TagSongs,
+			"untag":       tagHandler.UntagSongs,
+		}))...)
+
+		api.GET("/groups/:id/stats", as(domain.RoleReader, costSearch, statsHandler.GetGroupStats)...)
+
+		api.GET("/tags", as(domain.RoleReader, costSearch, tagHandler.GetTagCloud)...)
+		api.GET("/genres", as(domain.RoleReader, costDefault, genreHandler.ListGenres)...)
+		api.POST("/genres", as(domain.RoleEditor, costDefault, genreHandler.CreateGenre)...)
+		api.DELETE("/genres/:id", as(domain.RoleEditor, costDefault, genreHandler.DeleteGenre)...)
+
+		api.GET("/playlists", as(domain.RoleReader, costSearch, playlistHandler.ListPlaylists)...)
+		api.POST("/playlists", as(domain.RoleEditor, costDefault, playlistHandler.CreatePlaylist)...)
+		api.GET("/playlists/:id", as(domain.RoleReader, costDefault, playlistHandler.GetPlaylist)...)
+		api.PATCH("/playlists/:id", as(domain.RoleEditor, costDefault, playlistHandler.RenamePlaylist)...)
+		api.DELETE("/playlists/:id", as(domain.RoleEditor, costDefault, playlistHandler.DeletePlaylist)...)
+		api.POST("/playlists/:id/duplicate", as(domain.RoleEditor, costBulk, playlistHandler.DuplicatePlaylist)...)
+		api.POST("/playlists/:id/songs", as(domain.RoleEditor, costDefault, playlistHandler.AddPlaylistSong)...)
+		api.DELETE("/playlists/:id/songs/:position", as(domain.RoleEditor, costDefault, playlistHandler.RemovePlaylistSong)...)
+		api.POST("/playlists/:id/songs/:position/move", as(domain.RoleEditor, costDefault, playlistHandler.MovePlaylistSong)...)
+
+		api.GET("/albums", as(domain.RoleReader, costSearch, albumHandler.ListAlbums)...)
+		api.POST("/albums", as(domain.RoleEditor, costDefault, albumHandler.CreateAlbum)...)
+		api.GET("/albums/:id", as(domain.RoleReader, costDefault, albumHandler.GetAlbum)...)
+		api.PUT("/albums/:id", as(domain.RoleEditor, costDefault, albumHandler.UpdateAlbum)...)
+		api.DELETE("/albums/:id", as(domain.RoleEditor, costDefault, albumHandler.DeleteAlbum)...)
+		api.GET("/albums/:id/tracks", as(domain.RoleReader, costDefault, albumHandler.GetAlbumTracks)...)
+
+		api.GET("/events", as(domain.RoleReader, costDefault, changeFeedHandler.StreamEvents)...)
+
+		// Key management only makes sense, and is only safe, with auth enabled
+		if services.Auth != nil && services.APIKeys != nil {
+			api.GET("/admin/api-keys", as(domain.RoleAdmin, costDefault, apiKeyHandler.ListAPIKeys)...)
+			api.POST("/admin/api-keys", as(domain.RoleAdmin, costDefault, apiKeyHandler.CreateAPIKey)...)
+			api.DELETE("/admin/api-keys/:id", as(domain.RoleAdmin, costDefault, apiKeyHandler.RevokeAPIKey)...)
+		}
+		if services.Outbox != nil {
+			api.GET("/admin/outbox", as(domain.RoleAdmin, costDefault, outboxHandler.GetOutboxStatus)...)
+			api.POST("/admin/outbox/:id/retry", as(domain.RoleAdmin, costDefault, outboxHandler.RetryOutboxEvent)...)
+		}
+		if services.Audit != nil {
+			api.GET("/audit", as(domain.RoleAdmin, costSearch, auditHandler.GetAuditLog)...)
+		}
+		if services.Webhooks != nil {
+			api.GET("/admin/webhooks", as(domain.RoleAdmin, costDefault, webhookHandler.ListWebhooks)...)
+			api.POST("/admin/webhooks", as(domain.RoleAdmin, costDefault, webhookHandler.CreateWebhook)...)
+			api.GET("/admin/webhooks/:id", as(domain.RoleAdmin, costDefault, webhookHandler.GetWebhook)...)
+			api.PUT("/admin/webhooks/:id", as(domain.RoleAdmin, costDefault, webhookHandler.UpdateWebhook)...)
+			api.DELETE("/admin/webhooks/:id", as(domain.RoleAdmin, costDefault, webhookHandler.DeleteWebhook)...)
+			api.GET("/admin/webhooks/:id/deliveries", as(domain.RoleAdmin, costDefault, webhookHandler.ListWebhookDeliveries)...)
+			api.GET("/admin/webhooks/:id/deliveries/:delivery_id", as(domain.RoleAdmin, costDefault, webhookHandler.GetWebhookDelivery)...)
+			api.POST("/admin/webhooks/:id/deliveries/:delivery_id/redeliver", as(domain.RoleAdmin, costDefault, webhookHandler.RedeliverWebhookDelivery)...)
+		}
 	}
 
 	return r
 }
+
+// customMethods dispatches "collection:method" routes to their handlers
+func customMethods(methods map[string]handlerFunc) handlerFunc {
+	return func(r common.RequestReader, w http.ResponseWriter) error {
+		name, _ := r.PathParam("method")
+		if h, ok := methods[strings.TrimPrefix(name, ":")]; ok {
+			return h(r, w)
+		}
+		server.NotFound("unknown-method", nil, w)
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// countingLimiter allows a fixed number of tokens per key
+type countingLimiter struct {
+	budget int
+	spent  map[string]int
+}
+
+func (l *countingLimiter) Allow(_ context.Context, key string, cost int) domain.RateLimitDecision {
+	if l.spent[key]+cost > l.budget {
+		return domain.RateLimitDecision{Limit: l.budget, Remaining: l.budget - l.spent[key]}
+	}
+	l.spent[key] += cost
+	return domain.RateLimitDecision{Allowed: true, Limit: l.budget, Remaining: l.budget - l.spent[key]}
+}
+
+func TestSetupRouter_RateLimitIgnoresSpoofedForwardedFor(t *testing.T) {
+	songService := new(MockSongService)
+	songService.On("GetSong", mock.Anything, 1).Return(&domain.Song{ID: 1, Title: "Hysteria"}, nil)
+	limiter := &countingLimiter{budget: 2, spent: map[string]int{}}
+	router := SetupRouter(Services{Songs: songService, RateLimit: limiter})
+
+	var codes []int
+	for i := 0; i < 4; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1", nil)
+		req.RemoteAddr = "203.0.113.7:4321"
+		req.Header.Set("X-Forwarded-For", "198.51.100."+strconv.Itoa(i))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		codes = append(codes, w.Code)
+	}
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests, http.StatusTooManyRequests}, codes)
+	assert.Equal(t, map[string]int{"ip:203.0.113.7": 2}, limiter.spent)
+}
+
+func TestSetupRouter_TrustedProxies(t *testing.T) {
+	songService := new(MockSongService)
+	songService.On("GetSong", mock.Anything, 1).Return(&domain.Song{ID: 1, Title: "Hysteria"}, nil)
+	limiter := &countingLimiter{budget: 2, spent: map[string]int{}}
+	router := SetupRouter(Services{Songs: songService, RateLimit: limiter, TrustedProxies: []string{"10.0.0.0/8"}})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1", nil)
+	req.RemoteAddr = "10.1.2.3:4321"
+	req.Header.Set("X-Forwarded-For", "198.51.100.9")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, map[string]int{"ip:198.51.100.9": 1}, limiter.spent)
+}
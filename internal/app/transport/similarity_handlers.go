@@ -0,0 +1,58 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type SimilarityHandler struct {
+	similarityService SimilarityService
+}
+
+func NewSimilarityHandler(similarityService SimilarityService) *SimilarityHandler {
+	return &SimilarityHandler{
+		similarityService: similarityService,
+	}
+}
+
+// GetSimilarSongs godoc
+// @Summary Recommend songs similar to a song
+// @Description Rank the songs whose lyrics and titles are most like those of a song by TF-IDF cosine similarity, most similar first, with their score between 0 and 1
+// @Tags songs
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param limit query int false "Number of songs, at most 50" default(10)
+// @Success 200 {array} SimilarSongResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/similar [get]
+func (h *SimilarityHandler) GetSimilarSongs(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	limit, err := strconv.Atoi(r.DefaultQueryParam("limit", strconv.Itoa(domain.DefaultSimilarSongs)))
+	if err != nil || limit < 1 {
+		limit = domain.DefaultSimilarSongs
+	}
+	if limit > domain.MaxSimilarSongs {
+		limit = domain.MaxSimilarSongs
+	}
+
+	similar, err := h.similarityService.SimilarSongs(r.Context(), id, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrSongNotFound) {
+			server.NotFound(domain.ErrSongNotFound.Slug(), err, w)
+			return nil
+		}
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToSimilarSongResponses(similar), w)
+	return nil
+}
@@ -0,0 +1,98 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock similarity service
+type MockSimilarityService struct {
+	mock.Mock
+}
+
+func (m *MockSimilarityService) SimilarSongs(ctx context.Context, songID, limit int) ([]domain.SimilarSong, error) {
+	args := m.Called(ctx, songID, limit)
+	similar, _ := args.Get(0).([]domain.SimilarSong)
+	return similar, args.Error(1)
+}
+
+func setupSimilarityTestRouter(similarityService *MockSimilarityService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	similarityHandler := NewSimilarityHandler(similarityService)
+	router.GET("/api/v1/songs/:id/similar", adapter.ToGinHandler(similarityHandler.GetSimilarSongs))
+
+	return router
+}
+
+func TestSimilarityHandler_GetSimilarSongs(t *testing.T) {
+	similarityService := new(MockSimilarityService)
+	router := setupSimilarityTestRouter(similarityService)
+
+	similarityService.On("SimilarSongs", mock.Anything, 1, 3).Return([]domain.SimilarSong{
+		{Song: &domain.Song{ID: 2, Title: "Yellow Submarine (Remix)"}, Score: 0.82},
+		{Song: &domain.Song{ID: 5, Title: "Octopus's Garden"}, Score: 0.14},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/similar?limit=3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []SimilarSongResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response, 2)
+	assert.Equal(t, 2, response[0].Song.ID)
+	assert.Equal(t, 0.82, response[0].Score)
+	similarityService.AssertExpectations(t)
+}
+
+func TestSimilarityHandler_GetSimilarSongs_Limit(t *testing.T) {
+	tests := []struct {
+		query     string
+		wantLimit int
+	}{
+		{query: "", wantLimit: domain.DefaultSimilarSongs},
+		{query: "?limit=0", wantLimit: domain.DefaultSimilarSongs},
+		{query: "?limit=1000", wantLimit: domain.MaxSimilarSongs},
+	}
+
+	for _, tt := range tests {
+		similarityService := new(MockSimilarityService)
+		router := setupSimilarityTestRouter(similarityService)
+		similarityService.On("SimilarSongs", mock.Anything, 1, tt.wantLimit).Return([]domain.SimilarSong{}, nil)
+
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/similar"+tt.query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, tt.query)
+		assert.JSONEq(t, "[]", w.Body.String())
+		similarityService.AssertExpectations(t)
+	}
+}
+
+func TestSimilarityHandler_GetSimilarSongs_NotFound(t *testing.T) {
+	similarityService := new(MockSimilarityService)
+	router := setupSimilarityTestRouter(similarityService)
+
+	similarityService.On("SimilarSongs", mock.Anything, 9, domain.DefaultSimilarSongs).Return(nil, domain.ErrSongNotFound)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/9/similar", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "song-not-found")
+}
@@ -0,0 +1,110 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type StatsHandler struct {
+	statsService StatsService
+}
+
+func NewStatsHandler(statsService StatsService) *StatsHandler {
+	return &StatsHandler{
+		statsService: statsService,
+	}
+}
+
+// GetSongStats godoc
+// @Summary Get statistics on the lyrics of a song
+// @Description Count the lines, words, characters and verses of the lyrics of a song, with its unique word ratio, most frequent words (stopwords left out) and estimated reading and singing times
+// @Tags stats
+// @Produce json
+// @Param id path int true "Song ID"
+// @Param top query int false "Number of most frequent words, 1 to 100" default(10)
+// @Success 200 {object} SongStatsResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/stats [get]
+func (h *StatsHandler) GetSongStats(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+	top, ok := topWordsParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	stats, err := h.statsService.SongStats(r.Context(), id, top)
+	if err != nil {
+		respondStatsError(err, w)
+		return nil
+	}
+
+	server.RespondOK(SongStatsResponse{SongID: id, LyricsStatsResponse: ToLyricsStatsResponse(*stats)}, w)
+	return nil
+}
+
+// GetGroupStats godoc
+// @Summary Get statistics on the lyrics of a group
+// @Description Count the lines, words, characters and verses of the lyrics of every song of a group taken together, with their unique word ratio, most frequent words, estimated reading and singing times and the average number of words per song
+// @Tags stats
+// @Produce json
+// @Param id path int true "Group ID"
+// @Param top query int false "Number of most frequent words, 1 to 100" default(10)
+// @Success 200 {object} GroupStatsResponse
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/groups/{id}/stats [get]
+func (h *StatsHandler) GetGroupStats(r common.RequestReader, w http.ResponseWriter) error {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-group-id", domain.ErrInvalidID, w)
+		return nil
+	}
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-group-id", domain.ErrInvalidID, w)
+		return nil
+	}
+	top, ok := topWordsParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	stats, err := h.statsService.GroupStats(r.Context(), id, top)
+	if err != nil {
+		respondStatsError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToGroupStatsResponse(stats), w)
+	return nil
+}
+
+// topWordsParam parses the top query parameter, answering 400 when it is
+// not a number between 1 and domain.MaxTopWords
+func topWordsParam(r common.RequestReader, w http.ResponseWriter) (int, bool) {
+	top, err := strconv.Atoi(r.DefaultQueryParam("top", strconv.Itoa(domain.DefaultTopWords)))
+	if err != nil || top < 1 || top > domain.MaxTopWords {
+		server.BadRequest("invalid-top", fmt.Errorf("%w: top must be between 1 and %d", domain.ErrInvalidData, domain.MaxTopWords), w)
+		return 0, false
+	}
+	return top, true
+}
+
+// respondStatsError answers with the status matching a stats service error
+func respondStatsError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrSongNotFound):
+		server.NotFound(domain.ErrSongNotFound.Slug(), err, w)
+	case errors.Is(err, domain.ErrGroupNotFound):
+		server.NotFound(domain.ErrGroupNotFound.Slug(), err, w)
+	default:
+		server.RespondWithError(err, w)
+	}
+}
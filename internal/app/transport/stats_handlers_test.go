@@ -0,0 +1,137 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock stats service
+type MockStatsService struct {
+	mock.Mock
+}
+
+func (m *MockStatsService) SongStats(ctx context.Context, songID, top int) (*domain.LyricsStats, error) {
+	args := m.Called(ctx, songID, top)
+	stats, _ := args.Get(0).(*domain.LyricsStats)
+	return stats, args.Error(1)
+}
+
+func (m *MockStatsService) GroupStats(ctx context.Context, groupID, top int) (*domain.GroupStats, error) {
+	args := m.Called(ctx, groupID, top)
+	stats, _ := args.Get(0).(*domain.GroupStats)
+	return stats, args.Error(1)
+}
+
+func setupStatsTestRouter(statsService *MockStatsService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	statsHandler := NewStatsHandler(statsService)
+	router.GET("/api/v1/songs/:id/stats", adapter.ToGinHandler(statsHandler.GetSongStats))
+	router.GET("/api/v1/groups/:id/stats", adapter.ToGinHandler(statsHandler.GetGroupStats))
+
+	return router
+}
+
+func TestStatsHandler_GetSongStats(t *testing.T) {
+	statsService := new(MockStatsService)
+	router := setupStatsTestRouter(statsService)
+
+	statsService.On("SongStats", mock.Anything, 1, domain.DefaultTopWords).Return(&domain.LyricsStats{
+		Lines: 2, Words: 8, Verses: 1, UniqueWords: 6, UniqueWordRatio: 0.75,
+		TopWords:    []domain.WordCount{{Word: "love", Count: 2}},
+		ReadingTime: 3 * time.Second, SingingTime: 4 * time.Second,
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/stats", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response SongStatsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.SongID)
+	assert.Equal(t, 8, response.Words)
+	assert.Equal(t, 0.75, response.UniqueWordRatio)
+	assert.Equal(t, []WordCountResponse{{Word: "love", Count: 2}}, response.TopWords)
+	assert.Equal(t, 3, response.ReadingSeconds)
+	assert.Equal(t, 4, response.SingingSeconds)
+	statsService.AssertExpectations(t)
+}
+
+func TestStatsHandler_GetSongStats_InvalidTop(t *testing.T) {
+	statsService := new(MockStatsService)
+	router := setupStatsTestRouter(statsService)
+
+	for _, top := range []string{"0", "101", "many"} {
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/1/stats?top="+top, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, top)
+		assert.Contains(t, w.Body.String(), "invalid-top")
+	}
+	statsService.AssertNotCalled(t, "SongStats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestStatsHandler_GetSongStats_NotFound(t *testing.T) {
+	statsService := new(MockStatsService)
+	router := setupStatsTestRouter(statsService)
+
+	statsService.On("SongStats", mock.Anything, 9, 5).Return(nil, domain.ErrSongNotFound)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/9/stats?top=5", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "song-not-found")
+}
+
+func TestStatsHandler_GetGroupStats(t *testing.T) {
+	statsService := new(MockStatsService)
+	router := setupStatsTestRouter(statsService)
+
+	statsService.On("GroupStats", mock.Anything, 4, 3).Return(&domain.GroupStats{
+		GroupID: 4, Songs: 2, AverageWords: 6.5,
+		LyricsStats: domain.LyricsStats{Words: 13, TopWords: []domain.WordCount{{Word: "love", Count: 3}}},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/groups/4/stats?top=3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response GroupStatsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 4, response.GroupID)
+	assert.Equal(t, 2, response.Songs)
+	assert.Equal(t, 13, response.Words)
+	assert.Equal(t, 6.5, response.AverageWords)
+}
+
+func TestStatsHandler_GetGroupStats_NotFound(t *testing.T) {
+	statsService := new(MockStatsService)
+	router := setupStatsTestRouter(statsService)
+
+	statsService.On("GroupStats", mock.Anything, 4, domain.DefaultTopWords).Return(nil, domain.ErrGroupNotFound)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/groups/4/stats", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "group-not-found")
+}
@@ -0,0 +1,165 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+// defaultTagCloudSize is the number of tags in a tag cloud unless asked otherwise
+const defaultTagCloudSize = 50
+
+// maxTagCloudSize bounds the number of tags in a tag cloud
+const maxTagCloudSize = 500
+
+type TagHandler struct {
+	tagService TagService
+}
+
+func NewTagHandler(tagService TagService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+	}
+}
+
+// TagSongs godoc
+// @Summary Tag songs in bulk
+// @Description Add tags to songs. Tags are lowercased with single spaces; songs that do not exist are listed in missing_ids.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param request body TagSongsRequest true "Songs and tags"
+// @Success 200 {object} TagChangeResponse
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/songs:tag [post]
+func (h *TagHandler) TagSongs(r common.RequestReader, w http.ResponseWriter) error {
+	var req TagSongsRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	change, err := h.tagService.TagSongs(r.Context(), req.SongIDs, req.Tags)
+	if err != nil {
+		respondTagError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToTagChangeResponse(change), w)
+	return nil
+}
+
+// UntagSongs godoc
+// @Summary Untag songs in bulk
+// @Description Remove tags from songs; songs that do not exist are listed in missing_ids
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param request body TagSongsRequest true "Songs and tags"
+// @Success 200 {object} TagChangeResponse
+// @Failure 400,500 {object} map[string]string
+// @Router /api/v1/songs:untag [post]
+func (h *TagHandler) UntagSongs(r common.RequestReader, w http.ResponseWriter) error {
+	var req TagSongsRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return nil
+	}
+
+	change, err := h.tagService.UntagSongs(r.Context(), req.SongIDs, req.Tags)
+	if err != nil {
+		respondTagError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToTagChangeResponse(change), w)
+	return nil
+}
+
+// GetSongTags godoc
+// @Summary List the tags of a song
+// @Description Get the tags of a song in alphabetical order
+// @Tags tags
+// @Produce json
+// @Param id path int true "Song ID"
+// @Success 200 {array} string
+// @Failure 400,404,500 {object} map[string]string
+// @Router /api/v1/songs/{id}/tags [get]
+func (h *TagHandler) GetSongTags(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := songIDParam(r, w)
+	if !ok {
+		return nil
+	}
+
+	tags, err := h.tagService.SongTags(r.Context(), id)
+	if err != nil {
+		respondTagError(err, w)
+		return nil
+	}
+
+	server.RespondOK(tags, w)
+	return nil
+}
+
+// GetTagCloud godoc
+// @Summary Tag cloud
+// @Description Get the most used tags with their number of songs, most used first
+// @Tags tags
+// @Produce json
+// @Param limit query int false "Number of tags" default(50)
+// @Success 200 {array} TagCountResponse
+// @Failure 500 {object} map[string]string
+// @Router /api/v1/tags [get]
+func (h *TagHandler) GetTagCloud(r common.RequestReader, w http.ResponseWriter) error {
+	limit, err := strconv.Atoi(r.DefaultQueryParam("limit", strconv.Itoa(defaultTagCloudSize)))
+	if err != nil || limit < 1 {
+		limit = defaultTagCloudSize
+	}
+	if limit > maxTagCloudSize {
+		limit = maxTagCloudSize
+	}
+
+	counts, err := h.tagService.TagCloud(r.Context(), limit)
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToTagCountResponses(counts), w)
+	return nil
+}
+
+// songIDParam parses the song ID path parameter, answering 400 when it is invalid
+func songIDParam(r common.RequestReader, w http.ResponseWriter) (int, bool) {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-song-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+	return id, true
+}
+
+// respondTagError answers with the status matching a tag service error
+func respondTagError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrSongNotFound):
+		server.NotFound(domain.ErrSongNotFound.Slug(), err, w)
+	case errors.Is(err, domain.ErrInvalidTag), errors.Is(err, domain.ErrBatchTooLarge):
+		server.BadRequest(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrRequired):
+		server.BadRequest("validation-failed", err, w)
+	case errors.Is(err, domain.ErrInvalidID):
+		server.BadRequest("invalid-song-id", err, w)
+	default:
+		server.RespondWithError(err, w)
+	}
+}
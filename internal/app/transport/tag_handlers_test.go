@@ -0,0 +1,135 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock tag service
+type MockTagService struct {
+	mock.Mock
+}
+
+func (m *MockTagService) TagSongs(ctx context.Context, songIDs []int, tags []string) (*domain.TagChange, error) {
+	args := m.Called(ctx, songIDs, tags)
+	change, _ := args.Get(0).(*domain.TagChange)
+	return change, args.Error(1)
+}
+
+func (m *MockTagService) UntagSongs(ctx context.Context, songIDs []int, tags []string) (*domain.TagChange, error) {
+	args := m.Called(ctx, songIDs, tags)
+	change, _ := args.Get(0).(*domain.TagChange)
+	return change, args.Error(1)
+}
+
+func (m *MockTagService) SongTags(ctx context.Context, songID int) ([]string, error) {
+	args := m.Called(ctx, songID)
+	tags, _ := args.Get(0).([]string)
+	return tags, args.Error(1)
+}
+
+func (m *MockTagService) TagCloud(ctx context.Context, limit int) ([]domain.TagCount, error) {
+	args := m.Called(ctx, limit)
+	counts, _ := args.Get(0).([]domain.TagCount)
+	return counts, args.Error(1)
+}
+
+func setupTagTestRouter(mockService *MockTagService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	handler := NewTagHandler(mockService)
+	router.POST("/api/v1/songs:method", adapter.ToGinHandler(customMethods(map[string]handlerFunc{
+		"tag":   handler.TagSongs,
+		"untag": handler.UntagSongs,
+	})))
+	router.GET("/api/v1/songs/:id/tags", adapter.ToGinHandler(handler.GetSongTags))
+	router.GET("/api/v1/tags", adapter.ToGinHandler(handler.GetTagCloud))
+
+	return router
+}
+
+func TestTagHandler_TagSongs(t *testing.T) {
+	mockService := new(MockTagService)
+	router := setupTagTestRouter(mockService)
+
+	mockService.On("TagSongs", mock.Anything, []int{1, 2}, []string{"Live"}).Return(&domain.TagChange{
+		Tags:       []string{"live"},
+		SongIDs:    []int{1},
+		MissingIDs: []int{2},
+		Changed:    1,
+	}, nil)
+
+	body, _ := json.Marshal(TagSongsRequest{SongIDs: []int{1, 2}, Tags: []string{"Live"}})
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:tag", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response TagChangeResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []string{"live"}, response.Tags)
+	assert.Equal(t, []int{2}, response.MissingIDs)
+	assert.Equal(t, int64(1), response.Changed)
+	mockService.AssertExpectations(t)
+}
+
+func TestTagHandler_UntagSongs_InvalidTag(t *testing.T) {
+	mockService := new(MockTagService)
+	router := setupTagTestRouter(mockService)
+
+	mockService.On("UntagSongs", mock.Anything, []int{1}, []string{"a,b"}).Return(nil, domain.ErrInvalidTag)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/songs:untag", bytes.NewBufferString(`{"song_ids":[1],"tags":["a,b"]}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), domain.ErrInvalidTag.Slug())
+}
+
+func TestTagHandler_GetSongTags_NotFound(t *testing.T) {
+	mockService := new(MockTagService)
+	router := setupTagTestRouter(mockService)
+
+	mockService.On("SongTags", mock.Anything, 9).Return(nil, domain.ErrSongNotFound)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/songs/9/tags", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestTagHandler_GetTagCloud(t *testing.T) {
+	mockService := new(MockTagService)
+	router := setupTagTestRouter(mockService)
+
+	mockService.On("TagCloud", mock.Anything, maxTagCloudSize).
+		Return([]domain.TagCount{{Name: "live", Count: 4}}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/tags?limit=10000", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []TagCountResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []TagCountResponse{{Name: "live", Count: 4}}, response)
+}
@@ -0,0 +1,305 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"songs/internal/app/common"
+	"songs/internal/app/common/server"
+	"songs/internal/app/domain"
+	"strconv"
+)
+
+type WebhookHandler struct {
+	webhookService WebhookService
+}
+
+func NewWebhookHandler(webhookService WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// ListWebhooks godoc
+// @Summary List webhook subscriptions
+// @Description List the webhook subscriptions of partners. Secrets are never returned.
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} WebhookResponse
+// @Failure 401,403,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(r common.RequestReader, w http.ResponseWriter) error {
+	subscriptions, err := h.webhookService.ListWebhooks(r.Context())
+	if err != nil {
+		server.RespondWithError(err, w)
+		return nil
+	}
+
+	response := make([]WebhookResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		response[i] = ToWebhookResponse(subscription)
+	}
+	server.RespondOK(response, w)
+	return nil
+}
+
+// CreateWebhook godoc
+// @Summary Subscribe a URL to song events
+// @Description Subscribe a URL to the created, updated and/or deleted song events. Deliveries are signed with HMAC-SHA256 using the secret, generated when omitted and only returned in this response.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body WebhookRequest true "Webhook subscription"
+// @Success 200 {object} CreatedWebhookResponse
+// @Failure 400,401,403,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(r common.RequestReader, w http.ResponseWriter) error {
+	subscription, ok := decodeWebhook(r, w)
+	if !ok {
+		return nil
+	}
+
+	created, err := h.webhookService.CreateWebhook(r.Context(), subscription)
+	if err != nil {
+		respondWebhookError(err, w)
+		return nil
+	}
+
+	server.RespondOK(CreatedWebhookResponse{WebhookResponse: ToWebhookResponse(created), Secret: created.Secret}, w)
+	return nil
+}
+
+// GetWebhook godoc
+// @Summary Get a webhook subscription
+// @Description Get a webhook subscription by ID
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} WebhookResponse
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhook(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := webhookID(r, w)
+	if !ok {
+		return nil
+	}
+
+	subscription, err := h.webhookService.GetWebhook(r.Context(), id)
+	if err != nil {
+		respondWebhookError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToWebhookResponse(subscription), w)
+	return nil
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook subscription
+// @Description Replace the URL, event types and active flag of a subscription. The secret is rotated when given and kept otherwise; inactive subscriptions receive no events.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param webhook body WebhookRequest true "Webhook subscription"
+// @Success 200 {object} WebhookResponse
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := webhookID(r, w)
+	if !ok {
+		return nil
+	}
+
+	subscription, ok := decodeWebhook(r, w)
+	if !ok {
+		return nil
+	}
+	subscription.ID = id
+
+	updated, err := h.webhookService.UpdateWebhook(r.Context(), subscription)
+	if err != nil {
+		respondWebhookError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToWebhookResponse(updated), w)
+	return nil
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook subscription
+// @Description Delete a subscription along with the log of its deliveries
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := webhookID(r, w)
+	if !ok {
+		return nil
+	}
+
+	if err := h.webhookService.DeleteWebhook(r.Context(), id); err != nil {
+		respondWebhookError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Deleted webhook", w)
+	return nil
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List the deliveries of a webhook
+// @Description List the latest 100 deliveries of a subscription, newest first, with the response code of their last attempt
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param status query string false "Only deliveries with this status: pending, delivered or failed"
+// @Success 200 {array} WebhookDeliveryResponse
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListWebhookDeliveries(r common.RequestReader, w http.ResponseWriter) error {
+	id, ok := webhookID(r, w)
+	if !ok {
+		return nil
+	}
+
+	status := domain.WebhookDeliveryStatus(r.QueryParam("status"))
+	deliveries, err := h.webhookService.ListDeliveries(r.Context(), id, status)
+	if err != nil {
+		respondWebhookError(err, w)
+		return nil
+	}
+
+	response := make([]WebhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		response[i] = ToWebhookDeliveryResponse(&deliveries[i])
+	}
+	server.RespondOK(response, w)
+	return nil
+}
+
+// GetWebhookDelivery godoc
+// @Summary Get a webhook delivery
+// @Description Get a delivery of a subscription with the log of its attempts and their response codes
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Success 200 {object} WebhookDeliveryResponse
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks/{id}/deliveries/{delivery_id} [get]
+func (h *WebhookHandler) GetWebhookDelivery(r common.RequestReader, w http.ResponseWriter) error {
+	id, deliveryID, ok := webhookDeliveryID(r, w)
+	if !ok {
+		return nil
+	}
+
+	delivery, err := h.webhookService.GetDelivery(r.Context(), id, deliveryID)
+	if err != nil {
+		respondWebhookError(err, w)
+		return nil
+	}
+
+	server.RespondOK(ToWebhookDeliveryResponse(delivery), w)
+	return nil
+}
+
+// RedeliverWebhookDelivery godoc
+// @Summary Redeliver a webhook delivery
+// @Description Post a delivery again as soon as possible with a fresh count of attempts, whether it failed or was delivered already
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Success 200 {object} map[string]string
+// @Failure 400,401,403,404,500 {object} map[string]string
+// @Router /api/v1/admin/webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *WebhookHandler) RedeliverWebhookDelivery(r common.RequestReader, w http.ResponseWriter) error {
+	id, deliveryID, ok := webhookDeliveryID(r, w)
+	if !ok {
+		return nil
+	}
+
+	if err := h.webhookService.Redeliver(r.Context(), id, deliveryID); err != nil {
+		respondWebhookError(err, w)
+		return nil
+	}
+
+	server.RespondOK("Scheduled webhook delivery", w)
+	return nil
+}
+
+// decodeWebhook reads a subscription from the request body, answering 400 when it is invalid
+func decodeWebhook(r common.RequestReader, w http.ResponseWriter) (domain.WebhookSubscription, bool) {
+	var req WebhookRequest
+	if err := r.DecodeBody(&req); err != nil {
+		server.BadRequest("invalid-request-body", err, w)
+		return domain.WebhookSubscription{}, false
+	}
+
+	subscription := domain.WebhookSubscription{
+		URL:        req.URL,
+		EventTypes: make([]domain.SongEventType, len(req.EventTypes)),
+		Secret:     req.Secret,
+		Active:     req.Active == nil || *req.Active,
+	}
+	for i, eventType := range req.EventTypes {
+		subscription.EventTypes[i] = domain.SongEventType(eventType)
+	}
+	return subscription, true
+}
+
+// webhookID parses the webhook ID path parameter, answering 400 when it is invalid
+func webhookID(r common.RequestReader, w http.ResponseWriter) (int, bool) {
+	idStr, err := r.PathParam("id")
+	if err != nil {
+		server.BadRequest("invalid-webhook-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		server.BadRequest("invalid-webhook-id", domain.ErrInvalidID, w)
+		return 0, false
+	}
+	return id, true
+}
+
+// webhookDeliveryID parses the webhook and delivery ID path parameters,
+// answering 400 when either is invalid
+func webhookDeliveryID(r common.RequestReader, w http.ResponseWriter) (int, int64, bool) {
+	id, ok := webhookID(r, w)
+	if !ok {
+		return 0, 0, false
+	}
+
+	deliveryStr, err := r.PathParam("delivery_id")
+	if err != nil {
+		server.BadRequest("invalid-delivery-id", domain.ErrInvalidID, w)
+		return 0, 0, false
+	}
+
+	deliveryID, err := strconv.ParseInt(deliveryStr, 10, 64)
+	if err != nil {
+		server.BadRequest("invalid-delivery-id", domain.ErrInvalidID, w)
+		return 0, 0, false
+	}
+	return id, deliveryID, true
+}
+
+// respondWebhookError answers with the status matching a webhook service error
+func respondWebhookError(err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound), errors.Is(err, domain.ErrWebhookDeliveryNotFound):
+		server.NotFound(ErrorSlug(err), err, w)
+	case errors.Is(err, domain.ErrInvalidWebhook):
+		server.BadRequest(domain.ErrInvalidWebhook.Slug(), err, w)
+	case errors.Is(err, domain.ErrInvalidData):
+		server.BadRequest("invalid-status", err, w)
+	default:
+		server.RespondWithError(err, w)
+	}
+}
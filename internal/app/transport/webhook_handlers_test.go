@@ -0,0 +1,232 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"songs/internal/app/domain"
+	"songs/internal/app/transport/adapter"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Mock webhook service
+type MockWebhookService struct {
+	mock.Mock
+}
+
+func (m *MockWebhookService) CreateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	args := m.Called(ctx, subscription)
+	created, _ := args.Get(0).(*domain.WebhookSubscription)
+	return created, args.Error(1)
+}
+
+func (m *MockWebhookService) GetWebhook(ctx context.Context, id int) (*domain.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	subscription, _ := args.Get(0).(*domain.WebhookSubscription)
+	return subscription, args.Error(1)
+}
+
+func (m *MockWebhookService) ListWebhooks(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	subscriptions, _ := args.Get(0).([]*domain.WebhookSubscription)
+	return subscriptions, args.Error(1)
+}
+
+func (m *MockWebhookService) UpdateWebhook(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	args := m.Called(ctx, subscription)
+	updated, _ := args.Get(0).(*domain.WebhookSubscription)
+	return updated, args.Error(1)
+}
+
+func (m *MockWebhookService) DeleteWebhook(ctx context.Context, id int) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockWebhookService) ListDeliveries(ctx context.Context, subscriptionID int, status domain.WebhookDeliveryStatus) ([]domain.WebhookDelivery, error) {
+	args := m.Called(ctx, subscriptionID, status)
+	deliveries, _ := args.Get(0).([]domain.WebhookDelivery)
+	return deliveries, args.Error(1)
+}
+
+func (m *MockWebhookService) GetDelivery(ctx context.Context, subscriptionID int, id int64) (*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, subscriptionID, id)
+	delivery, _ := args.Get(0).(*domain.WebhookDelivery)
+	return delivery, args.Error(1)
+}
+
+func (m *MockWebhookService) Redeliver(ctx context.Context, subscriptionID int, id int64) error {
+	return m.Called(ctx, subscriptionID, id).Error(0)
+}
+
+func setupWebhookTestRouter(webhookService *MockWebhookService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	webhookHandler := NewWebhookHandler(webhookService)
+	router.POST("/api/v1/admin/webhooks", adapter.ToGinHandler(webhookHandler.CreateWebhook))
+	router.PUT("/api/v1/admin/webhooks/:id", adapter.ToGinHandler(webhookHandler.UpdateWebhook))
+	router.GET("/api/v1/admin/webhooks/:id/deliveries", adapter.ToGinHandler(webhookHandler.ListWebhookDeliveries))
+	router.GET("/api/v1/admin/webhooks/:id/deliveries/:delivery_id", adapter.ToGinHandler(webhookHandler.GetWebhookDelivery))
+	router.POST("/api/v1/admin/webhooks/:id/deliveries/:delivery_id/redeliver", adapter.ToGinHandler(webhookHandler.RedeliverWebhookDelivery))
+
+	return router
+}
+
+func TestWebhookHandler_CreateWebhook(t *testing.T) {
+	webhookService := new(MockWebhookService)
+	router := setupWebhookTestRouter(webhookService)
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	requested := domain.WebhookSubscription{
+		URL:        "https://partner.example.com/hook",
+		EventTypes: []domain.SongEventType{domain.SongEventCreated, domain.SongEventDeleted},
+		Active:     true,
+	}
+	created := requested
+	created.ID = 3
+	created.Secret = "whsec_abc"
+	created.CreatedAt = now
+	created.UpdatedAt = now
+	webhookService.On("CreateWebhook", mock.Anything, requested).Return(&created, nil)
+
+	body := `{"url":"https://partner.example.com/hook","event_types":["created","deleted"]}`
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/webhooks", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response CreatedWebhookResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 3, response.ID)
+	assert.Equal(t, "whsec_abc", response.Secret)
+	assert.Equal(t, []string{"created", "deleted"}, response.EventTypes)
+	assert.True(t, response.Active)
+	webhookService.AssertExpectations(t)
+}
+
+func TestWebhookHandler_CreateWebhook_Invalid(t *testing.T) {
+	webhookService := new(MockWebhookService)
+	router := setupWebhookTestRouter(webhookService)
+
+	webhookService.On("CreateWebhook", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidWebhook)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/admin/webhooks", bytes.NewBufferString(`{"url":"nope"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "invalid-webhook")
+}
+
+func TestWebhookHandler_UpdateWebhook(t *testing.T) {
+	webhookService := new(MockWebhookService)
+	router := setupWebhookTestRouter(webhookService)
+
+	webhookService.On("UpdateWebhook", mock.Anything, mock.MatchedBy(func(s domain.WebhookSubscription) bool {
+		return s.ID == 3 && !s.Active
+	})).Return(&domain.WebhookSubscription{ID: 3, URL: "https://partner.example.com/hook"}, nil)
+	webhookService.On("UpdateWebhook", mock.Anything, mock.MatchedBy(func(s domain.WebhookSubscription) bool {
+		return s.ID == 9
+	})).Return(nil, domain.ErrWebhookNotFound)
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/api/v1/admin/webhooks/3", http.StatusOK},
+		{"/api/v1/admin/webhooks/9", http.StatusNotFound},
+		{"/api/v1/admin/webhooks/abc", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		body := `{"url":"https://partner.example.com/hook","event_types":["updated"],"active":false}`
+		req, _ := http.NewRequest(http.MethodPut, tt.path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tt.status, w.Code, tt.path)
+	}
+	webhookService.AssertExpectations(t)
+}
+
+func TestWebhookHandler_ListWebhookDeliveries(t *testing.T) {
+	webhookService := new(MockWebhookService)
+	router := setupWebhookTestRouter(webhookService)
+
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	webhookService.On("ListDeliveries", mock.Anything, 3, domain.WebhookDeliveryFailed).Return([]domain.WebhookDelivery{
+		{ID: 8, EventID: 12, EventType: domain.SongEventUpdated, Status: domain.WebhookDeliveryFailed, Attempts: 8, ResponseCode: 503, LastError: "responded 503", CreatedAt: created, NextAttemptAt: created},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/webhooks/3/deliveries?status=failed", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response []WebhookDeliveryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	assert.Equal(t, 503, response[0].ResponseCode)
+	assert.Equal(t, "failed", response[0].Status)
+	assert.Empty(t, response[0].NextAttemptAt, "only pending deliveries are due")
+}
+
+func TestWebhookHandler_GetWebhookDelivery(t *testing.T) {
+	webhookService := new(MockWebhookService)
+	router := setupWebhookTestRouter(webhookService)
+
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	webhookService.On("GetDelivery", mock.Anything, 3, int64(8)).Return(&domain.WebhookDelivery{
+		ID: 8, EventID: 12, EventType: domain.SongEventUpdated, Status: domain.WebhookDeliveryDelivered, Attempts: 2, ResponseCode: 200, CreatedAt: at, DeliveredAt: &at,
+		Log: []domain.WebhookAttempt{
+			{At: at, Error: "context deadline exceeded", Duration: 10 * time.Second},
+			{At: at, ResponseCode: 200, Duration: 42 * time.Millisecond},
+		},
+	}, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/admin/webhooks/3/deliveries/8", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response WebhookDeliveryResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Log, 2)
+	assert.Equal(t, 0, response.Log[0].ResponseCode)
+	assert.Equal(t, int64(10000), response.Log[0].DurationMs)
+	assert.Equal(t, 200, response.Log[1].ResponseCode)
+	assert.Equal(t, "2024-05-01T12:00:00Z", response.DeliveredAt)
+}
+
+func TestWebhookHandler_RedeliverWebhookDelivery(t *testing.T) {
+	webhookService := new(MockWebhookService)
+	router := setupWebhookTestRouter(webhookService)
+
+	webhookService.On("Redeliver", mock.Anything, 3, int64(8)).Return(nil)
+	webhookService.On("Redeliver", mock.Anything, 3, int64(9)).Return(domain.ErrWebhookDeliveryNotFound)
+
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{"/api/v1/admin/webhooks/3/deliveries/8/redeliver", http.StatusOK},
+		{"/api/v1/admin/webhooks/3/deliveries/9/redeliver", http.StatusNotFound},
+		{"/api/v1/admin/webhooks/3/deliveries/abc/redeliver", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodPost, tt.path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, tt.status, w.Code, tt.path)
+	}
+	webhookService.AssertExpectations(t)
+}
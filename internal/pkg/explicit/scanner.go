@@ -0,0 +1,192 @@
+// Package explicit finds explicit words in lyrics with local wordlists.
+//
+// A wordlist holds one lowercase word per line, a trailing * matching every
+// word starting with what precedes it; empty lines and lines starting with #
+// are ignored. Lists are named after the language they are for, such as
+// en.txt.
+package explicit
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"unicode"
+)
+
+//go:embed wordlists/*.txt
+var builtin embed.FS
+
+// wordlist holds the explicit words of a language
+type wordlist struct {
+	words    map[string]bool
+	prefixes []string
+}
+
+func (l wordlist) matches(word string) bool {
+	if l.words[word] {
+		return true
+	}
+	for _, prefix := range l.prefixes {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Scanner flags explicit words by language
+type Scanner struct {
+	lists map[string]wordlist
+	// unknown merges the whole words of every list, for texts of unknown
+	// language; prefixes only match themselves as they would match innocent
+	// words of other languages, such as fick* and fickle
+	unknown wordlist
+}
+
+// Default returns a scanner with the built-in wordlists
+func Default() *Scanner {
+	scanner, err := load(builtin, "wordlists")
+	if err != nil {
+		panic(fmt.Sprintf("explicit: built-in wordlists: %v", err))
+	}
+	return scanner
+}
+
+// Load returns a scanner with the wordlists found in dir, or the built-in
+// ones when dir is empty
+func Load(dir string) (*Scanner, error) {
+	if dir == "" {
+		return Default(), nil
+	}
+	return load(os.DirFS(dir), ".")
+}
+
+func load(fsys fs.FS, dir string) (*Scanner, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := &Scanner{lists: make(map[string]wordlist), unknown: wordlist{words: make(map[string]bool)}}
+	for _, entry := range entries {
+		lang, ok := strings.CutSuffix(entry.Name(), ".txt")
+		if entry.IsDir() || !ok {
+			continue
+		}
+
+		f, err := fsys.Open(path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		list, err := readWordlist(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		scanner.lists[strings.ToLower(lang)] = list
+		for word := range list.words {
+			scanner.unknown.words[word] = true
+		}
+		for _, prefix := range list.prefixes {
+			scanner.unknown.words[prefix] = true
+		}
+	}
+	if len(scanner.lists) == 0 {
+		return nil, fmt.Errorf("no wordlist in %s", dir)
+	}
+	return scanner, nil
+}
+
+func readWordlist(r io.Reader) (wordlist, error) {
+	list := wordlist{words: make(map[string]bool)}
+	lines := bufio.NewScanner(r)
+	for lines.Scan() {
+		line := strings.ToLower(strings.TrimSpace(lines.Text()))
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasSuffix(line, "*"):
+			list.prefixes = append(list.prefixes, strings.TrimSuffix(line, "*"))
+		default:
+			list.words[line] = true
+		}
+	}
+	return list, lines.Err()
+}
+
+// Explicit reports whether text in language lang holds an explicit word.
+// Texts of unknown language are checked against the whole words of every
+// list, texts of a language without a list are never explicit.
+func (s *Scanner) Explicit(text, lang string) bool {
+	list := s.list(lang)
+	explicit := false
+	eachWord(text, func(start, end int) {
+		if !explicit && list.matches(strings.ToLower(text[start:end])) {
+			explicit = true
+		}
+	})
+	return explicit
+}
+
+// Mask replaces every letter of the explicit words of text with an asterisk,
+// leaving the rest of the text as is
+func (s *Scanner) Mask(text, lang string) string {
+	list := s.list(lang)
+	var masked strings.Builder
+	last := 0
+	eachWord(text, func(start, end int) {
+		word := text[start:end]
+		if !list.matches(strings.ToLower(word)) {
+			return
+		}
+		masked.WriteString(text[last:start])
+		for _, r := range word {
+			if unicode.IsLetter(r) {
+				masked.WriteRune('*')
+			} else {
+				masked.WriteRune(r)
+			}
+		}
+		last = end
+	})
+	if last == 0 {
+		return text
+	}
+	masked.WriteString(text[last:])
+	return masked.String()
+}
+
+// list returns the wordlist of a BCP-47 language, regional variants using
+// the list of their base language, and an empty one for languages without a
+// list
+func (s *Scanner) list(lang string) wordlist {
+	base, _, _ := strings.Cut(strings.ToLower(lang), "-")
+	if base == "" || base == "und" {
+		return s.unknown
+	}
+	return s.lists[base]
+}
+
+// eachWord calls fn with the byte offsets of every word of text, a word
+// being a run of letters and combining marks
+func eachWord(text string, fn func(start, end int)) {
+	start := -1
+	for i, r := range text {
+		inWord := unicode.IsLetter(r) || unicode.IsMark(r)
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			fn(start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		fn(start, len(text))
+	}
+}
@@ -0,0 +1,59 @@
+package explicit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanner_Explicit(t *testing.T) {
+	scanner := Default()
+
+	assert.True(t, scanner.Explicit("What the FUCKING hell", "en"))
+	assert.True(t, scanner.Explicit("Me vale madre, carajo", "es-MX"))
+	assert.False(t, scanner.Explicit("Scunthorpe and cocktails", "en"))
+	assert.False(t, scanner.Explicit("Yellow submarine", "en"))
+
+	// Unknown languages are checked against the whole words of every list
+	assert.True(t, scanner.Explicit("Merde alors", ""))
+	assert.True(t, scanner.Explicit("Merde alors", "und"))
+	assert.False(t, scanner.Explicit("A fickle and putative love", ""))
+	// Known ones only against their own, if any
+	assert.False(t, scanner.Explicit("Merde alors", "en"))
+	assert.False(t, scanner.Explicit("Merde alors", "ja"))
+	assert.False(t, scanner.Explicit("Don't bite the hand", "en"))
+	assert.False(t, scanner.Explicit("Fickle fortune", "it"))
+}
+
+func TestScanner_Mask(t *testing.T) {
+	scanner := Default()
+
+	assert.Equal(t, "Oh ****, it's ****-ing great\n\nNo ****",
+		scanner.Mask("Oh shit, it's fuck-ing great\n\nNo shit", "en"))
+	assert.Equal(t, "Clean lyrics", scanner.Mask("Clean lyrics", "en"))
+	assert.Equal(t, "Ах ты ****", scanner.Mask("Ах ты сука", "ru"))
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "en.txt"), []byte("# house rules\nheck\ndarn*\n\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("not a list"), 0o600))
+
+	scanner, err := Load(dir)
+	require.NoError(t, err)
+
+	assert.True(t, scanner.Explicit("Oh heck", "en-GB"))
+	assert.True(t, scanner.Explicit("Darnation", "en"))
+	assert.False(t, scanner.Explicit("Oh shit", "en"))
+}
+
+func TestLoad_NoLists(t *testing.T) {
+	_, err := Load(t.TempDir())
+	assert.Error(t, err)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
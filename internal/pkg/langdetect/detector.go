@@ -0,0 +1,230 @@
+// Package langdetect guesses the language and script of a text offline.
+//
+// The script is the Unicode script most letters belong to. Languages with a
+// script of their own follow from it; languages sharing the Latin or Cyrillic
+// script are told apart by comparing the ranks of their character n-grams
+// with built-in profiles (Cavnar & Trenkle, "N-Gram-Based Text
+// Categorization").
+package langdetect
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxNGram is the length of the longest n-grams profiled
+	maxNGram = 3
+	// profileSize is the number of most frequent n-grams kept per profile
+	profileSize = 300
+	// minLetters is the number of letters below which no language is guessed
+	minLetters = 20
+	// clearMargin is the relative distance between the best two languages
+	// from which a guess is fully trusted
+	clearMargin = 0.25
+)
+
+// Detection is the outcome of detecting the language of a text
+type Detection struct {
+	// Language is a BCP-47 language tag, empty when unknown
+	Language string
+	// Script is an ISO 15924 script code such as Latn or Cyrl, empty when
+	// the text has no letters
+	Script string
+	// Confidence ranges from 0 to 1
+	Confidence float64
+}
+
+// scriptInfo maps a Unicode script to its ISO 15924 code and to the language
+// it implies, if any
+type scriptInfo struct {
+	table    *unicode.RangeTable
+	code     string
+	language string
+}
+
+var scripts = []scriptInfo{
+	{table: unicode.Latin, code: "Latn"},
+	{table: unicode.Cyrillic, code: "Cyrl"},
+	{table: unicode.Greek, code: "Grek", language: "el"},
+	{table: unicode.Arabic, code: "Arab", language: "ar"},
+	{table: unicode.Hebrew, code: "Hebr", language: "he"},
+	{table: unicode.Devanagari, code: "Deva", language: "hi"},
+	{table: unicode.Thai, code: "Thai", language: "th"},
+	{table: unicode.Georgian, code: "Geor", language: "ka"},
+	{table: unicode.Armenian, code: "Armn", language: "hy"},
+	{table: unicode.Hangul, code: "Kore", language: "ko"},
+	{table: unicode.Hiragana, code: "Jpan", language: "ja"},
+	{table: unicode.Katakana, code: "Jpan", language: "ja"},
+	{table: unicode.Han, code: "Hani", language: "zh"},
+}
+
+// Detector detects languages with n-gram profiles built once
+type Detector struct {
+	// profiles holds the rank of every profiled n-gram, by script and language
+	profiles map[string]map[string]map[string]int
+}
+
+// New builds a detector from the built-in language profiles
+func New() *Detector {
+	d := &Detector{profiles: make(map[string]map[string]map[string]int)}
+	for lang, sample := range samples {
+		script := dominantScript(sample).code
+		if d.profiles[script] == nil {
+			d.profiles[script] = make(map[string]map[string]int)
+		}
+		d.profiles[script][lang] = rankNGrams(sample)
+	}
+	return d
+}
+
+// Detect guesses the language and script of text. Texts too short to tell,
+// or in a script without profiles, get no language.
+func (d *Detector) Detect(text string) Detection {
+	counts := countScripts(text)
+	if counts.total == 0 {
+		return Detection{}
+	}
+
+	script := counts.dominant()
+	detection := Detection{Script: script.code}
+	share := float64(counts.byCode[script.code]) / float64(counts.total)
+
+	// Japanese mixes kana with Han characters; kana anywhere makes Han text
+	// Japanese
+	if script.code == "Jpan" || script.code == "Hani" && counts.byCode["Jpan"] > 0 {
+		script = scriptInfo{code: "Jpan", language: "ja"}
+		detection.Script = script.code
+		share = float64(counts.byCode["Jpan"]+counts.byCode["Hani"]) / float64(counts.total)
+	}
+
+	if script.language != "" {
+		detection.Language = script.language
+		detection.Confidence = share
+		return detection
+	}
+
+	profiles := d.profiles[script.code]
+	if len(profiles) == 0 || counts.byCode[script.code] < minLetters {
+		return detection
+	}
+
+	best, second := "", ""
+	distances := make(map[string]int, len(profiles))
+	ranks := rankNGrams(text)
+	for lang, profile := range profiles {
+		distances[lang] = outOfPlace(ranks, profile)
+		switch {
+		case best == "" || distances[lang] < distances[best] || distances[lang] == distances[best] && lang < best:
+			best, second = lang, best
+		case second == "" || distances[lang] < distances[second] || distances[lang] == distances[second] && lang < second:
+			second = lang
+		}
+	}
+
+	detection.Language = best
+	detection.Confidence = share
+	if second != "" && distances[second] > 0 {
+		// The margin over the runner-up tells how sure the guess is; a
+		// runner-up a quarter further away than the best is a clear win
+		margin := float64(distances[second]-distances[best]) / float64(distances[second])
+		detection.Confidence *= min(1, margin/clearMargin)
+	}
+	return detection
+}
+
+// rankNGrams returns the rank of the most frequent n-grams of text, words
+// padded with spaces so n-grams also capture word starts and ends
+func rankNGrams(text string) map[string]int {
+	frequencies := make(map[string]int)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\''
+	}) {
+		runes := []rune(" " + strings.Trim(word, "'") + " ")
+		for n := 1; n <= maxNGram; n++ {
+			for i := 0; i+n <= len(runes); i++ {
+				gram := string(runes[i : i+n])
+				if gram != " " {
+					frequencies[gram]++
+				}
+			}
+		}
+	}
+
+	grams := make([]string, 0, len(frequencies))
+	for gram := range frequencies {
+		grams = append(grams, gram)
+	}
+	sort.Slice(grams, func(i, j int) bool {
+		if frequencies[grams[i]] != frequencies[grams[j]] {
+			return frequencies[grams[i]] > frequencies[grams[j]]
+		}
+		return grams[i] < grams[j]
+	})
+	if len(grams) > profileSize {
+		grams = grams[:profileSize]
+	}
+
+	ranks := make(map[string]int, len(grams))
+	for rank, gram := range grams {
+		ranks[gram] = rank
+	}
+	return ranks
+}
+
+// outOfPlace sums how far the rank of every n-gram of a text is from its
+// rank in a profile, n-grams missing from the profile counting the most
+func outOfPlace(text, profile map[string]int) int {
+	distance := 0
+	for gram, rank := range text {
+		profileRank, ok := profile[gram]
+		switch {
+		case !ok:
+			distance += profileSize
+		case profileRank > rank:
+			distance += profileRank - rank
+		default:
+			distance += rank - profileRank
+		}
+	}
+	return distance
+}
+
+// scriptCounts counts the letters of a text by script code
+type scriptCounts struct {
+	byCode map[string]int
+	total  int
+}
+
+func countScripts(text string) scriptCounts {
+	counts := scriptCounts{byCode: make(map[string]int)}
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		counts.total++
+		for _, script := range scripts {
+			if unicode.Is(script.table, r) {
+				counts.byCode[script.code]++
+				break
+			}
+		}
+	}
+	return counts
+}
+
+// dominant returns the script most letters belong to
+func (c scriptCounts) dominant() scriptInfo {
+	var best scriptInfo
+	for _, script := range scripts {
+		if c.byCode[script.code] > c.byCode[best.code] {
+			best = script
+		}
+	}
+	return best
+}
+
+func dominantScript(text string) scriptInfo {
+	return countScripts(text).dominant()
+}
@@ -0,0 +1,57 @@
+package langdetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	detector := New()
+
+	tests := []struct {
+		name       string
+		text       string
+		wantLang   string
+		wantScript string
+	}{
+		{name: "english", text: "Yesterday, all my troubles seemed so far away\nNow it looks as though they're here to stay", wantLang: "en", wantScript: "Latn"},
+		{name: "spanish", text: "Quizás, quizás, quizás\nSiempre que te pregunto que cuándo, cómo y dónde\nTú siempre me respondes", wantLang: "es", wantScript: "Latn"},
+		{name: "french", text: "Non, je ne regrette rien\nNi le bien qu'on m'a fait, ni le mal, tout ça m'est bien égal", wantLang: "fr", wantScript: "Latn"},
+		{name: "german", text: "Ich hab noch einen Koffer in Berlin\nDeswegen muss ich nächstens wieder hin", wantLang: "de", wantScript: "Latn"},
+		{name: "russian", text: "Расцветали яблони и груши, поплыли туманы над рекой\nВыходила на берег Катюша", wantLang: "ru", wantScript: "Cyrl"},
+		{name: "greek", text: "Τα παιδιά του Πειραιά", wantLang: "el", wantScript: "Grek"},
+		{name: "japanese", text: "上を向いて歩こう 涙がこぼれないように", wantLang: "ja", wantScript: "Jpan"},
+		{name: "chinese", text: "月亮代表我的心", wantLang: "zh", wantScript: "Hani"},
+		{name: "korean", text: "사랑해요 당신을", wantLang: "ko", wantScript: "Kore"},
+		{name: "too short", text: "la la la", wantScript: "Latn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detection := detector.Detect(tt.text)
+
+			assert.Equal(t, tt.wantLang, detection.Language)
+			assert.Equal(t, tt.wantScript, detection.Script)
+			if tt.wantLang == "" {
+				assert.Zero(t, detection.Confidence)
+			} else {
+				assert.Greater(t, detection.Confidence, 0.0)
+				assert.LessOrEqual(t, detection.Confidence, 1.0)
+			}
+		})
+	}
+}
+
+func TestDetect_NoLetters(t *testing.T) {
+	assert.Equal(t, Detection{}, New().Detect("1, 2, 3... 4!"))
+}
+
+func TestDetect_MixedScripts(t *testing.T) {
+	// Japanese lyrics with an English hook are still Japanese, with less confidence
+	pure := New().Detect("上を向いて歩こう 涙がこぼれないように")
+	mixed := New().Detect("上を向いて歩こう 涙がこぼれないように oh yeah")
+
+	assert.Equal(t, "ja", mixed.Language)
+	assert.Less(t, mixed.Confidence, pure.Confidence)
+}
@@ -0,0 +1,54 @@
+package langdetect
+
+// samples are the texts the n-gram profiles of the languages written in
+// Latin or Cyrillic script are built from: everyday phrases and the words
+// songs use most, so short lyrics still share n-grams with them
+var samples = map[string]string{
+	"en": `All human beings are born free and equal in dignity and rights. They are endowed with reason and conscience and should act towards one another in a spirit of brotherhood.
+I love you and I need you, baby, tonight. When you walk away my heart is breaking, and I will never let you go. Oh, the night is young and the stars are shining, come with me and we can dance until the morning light.
+There is nothing that I would not do for you. What have you done to my heart? Where were you when I was down, when the rain was falling all over the town? We were young, we thought the world was ours, and now it is only a song that they sing on the radio.`,
+
+	"es": `Todos los seres humanos nacen libres e iguales en dignidad y derechos y, dotados como están de razón y conciencia, deben comportarse fraternalmente los unos con los otros.
+Te quiero con todo mi corazón y no puedo vivir sin ti. Cuando te vas la noche es muy larga y yo me quedo solo pensando en tu amor. Bailamos hasta que sale el sol, y la luna nos mira desde el cielo.
+¿Qué sería de mi vida si no estás aquí? Porque eres la razón de mi alegría, y cada día que pasa te quiero más. Dime que vuelves, dime que nunca me vas a olvidar.`,
+
+	"fr": `Tous les êtres humains naissent libres et égaux en dignité et en droits. Ils sont doués de raison et de conscience et doivent agir les uns envers les autres dans un esprit de fraternité.
+Je t'aime et je ne peux pas vivre sans toi. Quand tu t'en vas, la nuit est longue et mon cœur pleure. Nous dansions jusqu'au matin sous les étoiles, et la vie était belle comme une chanson d'été.
+Qu'est-ce que tu veux que je fasse de ma vie si tu n'es plus là ? Dis-moi que tu reviendras, dis-moi que tu ne m'oublieras jamais, parce que c'est toi que j'attends depuis toujours.`,
+
+	"de": `Alle Menschen sind frei und gleich an Würde und Rechten geboren. Sie sind mit Vernunft und Gewissen begabt und sollen einander im Geist der Brüderlichkeit begegnen.
+Ich liebe dich und ich kann nicht ohne dich leben. Wenn du gehst, ist die Nacht so lang und mein Herz ist schwer. Wir tanzten bis zum Morgen unter den Sternen, und das Leben war schön wie ein Lied im Sommer.
+Was soll ich nur tun, wenn du nicht mehr bei mir bist? Sag mir, dass du zurückkommst, sag mir, dass du mich nie vergessen wirst, denn ich warte schon so lange auf dich.`,
+
+	"it": `Tutti gli esseri umani nascono liberi ed eguali in dignità e diritti. Essi sono dotati di ragione e di coscienza e devono agire gli uni verso gli altri in spirito di fratellanza.
+Ti amo e non posso vivere senza di te. Quando te ne vai la notte è lunga e il mio cuore piange. Abbiamo ballato fino al mattino sotto le stelle, e la vita era bella come una canzone d'estate.
+Che cosa farò della mia vita se non ci sei più? Dimmi che tornerai, dimmi che non mi dimenticherai mai, perché sei tu che aspetto da sempre.`,
+
+	"pt": `Todos os seres humanos nascem livres e iguais em dignidade e em direitos. Dotados de razão e de consciência, devem agir uns para com os outros em espírito de fraternidade.
+Eu te amo e não posso viver sem você. Quando você vai embora a noite é longa e o meu coração chora. Dançamos até de manhã debaixo das estrelas, e a vida era bonita como uma canção de verão.
+O que vou fazer da minha vida se você não está mais aqui? Diga que vai voltar, diga que nunca vai me esquecer, porque é você que eu espero desde sempre.`,
+
+	"nl": `Alle mensen worden vrij en gelijk in waardigheid en rechten geboren. Zij zijn begiftigd met verstand en geweten, en behoren zich jegens elkander in een geest van broederschap te gedragen.
+Ik hou van jou en ik kan niet leven zonder jou. Als je weggaat is de nacht zo lang en mijn hart is zwaar. We dansten tot de ochtend onder de sterren, en het leven was mooi als een liedje in de zomer.
+Wat moet ik met mijn leven als jij er niet meer bent? Zeg me dat je terugkomt, zeg me dat je me nooit zult vergeten, want ik wacht al zo lang op jou.`,
+
+	"sv": `Alla människor är födda fria och lika i värde och rättigheter. De har utrustats med förnuft och samvete och bör handla gentemot varandra i en anda av broderskap.
+Jag älskar dig och jag kan inte leva utan dig. När du går är natten så lång och mitt hjärta är tungt. Vi dansade till morgonen under stjärnorna, och livet var vackert som en sång om sommaren.
+Vad ska jag göra med mitt liv om du inte är här längre? Säg att du kommer tillbaka, säg att du aldrig kommer att glömma mig, för det är dig jag har väntat på så länge.`,
+
+	"pl": `Wszyscy ludzie rodzą się wolni i równi pod względem swej godności i swych praw. Są oni obdarzeni rozumem i sumieniem i powinni postępować wobec innych w duchu braterstwa.
+Kocham cię i nie mogę żyć bez ciebie. Kiedy odchodzisz, noc jest taka długa, a moje serce płacze. Tańczyliśmy do rana pod gwiazdami, a życie było piękne jak letnia piosenka.
+Co mam zrobić ze swoim życiem, jeśli ciebie już nie ma? Powiedz, że wrócisz, powiedz, że nigdy o mnie nie zapomnisz, bo to na ciebie czekam od zawsze.`,
+
+	"tr": `Bütün insanlar hür, haysiyet ve haklar bakımından eşit doğarlar. Akıl ve vicdana sahiptirler ve birbirlerine karşı kardeşlik zihniyeti ile hareket etmelidirler.
+Seni seviyorum ve sensiz yaşayamam. Sen gidince gece çok uzun oluyor ve kalbim ağlıyor. Yıldızların altında sabaha kadar dans ettik, hayat bir yaz şarkısı kadar güzeldi.
+Sen artık burada değilsen hayatımla ne yapacağım? Bana geri döneceğini söyle, beni asla unutmayacağını söyle, çünkü hep seni bekliyorum.`,
+
+	"ru": `Все люди рождаются свободными и равными в своем достоинстве и правах. Они наделены разумом и совестью и должны поступать в отношении друг друга в духе братства.
+Я люблю тебя и не могу жить без тебя. Когда ты уходишь, ночь такая длинная, и моё сердце плачет. Мы танцевали до утра под звёздами, и жизнь была прекрасна, как летняя песня.
+Что мне делать с моей жизнью, если тебя больше нет? Скажи, что ты вернёшься, скажи, что никогда меня не забудешь, потому что я жду тебя всегда.`,
+
+	"uk": `Всі люди народжуються вільними і рівними у своїй гідності та правах. Вони наділені розумом і совістю і повинні діяти у відношенні один до одного в дусі братерства.
+Я кохаю тебе і не можу жити без тебе. Коли ти йдеш, ніч така довга, і моє серце плаче. Ми танцювали до ранку під зорями, і життя було прекрасне, як літня пісня.
+Що мені робити з моїм життям, якщо тебе більше немає? Скажи, що ти повернешся, скажи, що ніколи мене не забудеш, бо я чекаю на тебе завжди.`,
+}
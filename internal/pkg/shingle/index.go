@@ -0,0 +1,220 @@
+// Package shingle finds near-duplicate texts by the Jaccard similarity of
+// their shingles, the sets of runs of Size consecutive words they hold.
+//
+// Words are compared lowercased and without punctuation, and shingles are
+// sets, so repeating a chorus, reflowing lines or fixing the case of a text
+// leaves its shingles alike. Texts shorter than MinWords words are too
+// short to tell a copy from a coincidence and are never matched.
+package shingle
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	// Size is the number of words of a shingle
+	Size = 3
+	// MinWords is the length under which texts are not compared
+	MinWords = 8
+)
+
+// Match is a text nearly the same as another one
+type Match struct {
+	ID int
+	// Similarity is the Jaccard similarity of the shingles of the texts, in (0, 1]
+	Similarity float64
+}
+
+// Cluster is a set of texts linked by near-duplicate pairs
+type Cluster struct {
+	// IDs are sorted in increasing order
+	IDs   []int
+	Pairs []Pair
+}
+
+// Pair is two near-duplicate texts, A less than B
+type Pair struct {
+	A, B       int
+	Similarity float64
+}
+
+// Shingles returns the hashed shingles of text, nil when it is too short
+func Shingles(text string) map[uint64]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+	if len(words) < MinWords {
+		return nil
+	}
+
+	shingles := make(map[uint64]struct{}, len(words)-Size+1)
+	for i := 0; i+Size <= len(words); i++ {
+		h := fnv.New64a()
+		for _, word := range words[i : i+Size] {
+			_, _ = h.Write([]byte(word))
+			_, _ = h.Write([]byte{0})
+		}
+		shingles[h.Sum64()] = struct{}{}
+	}
+	return shingles
+}
+
+// Index holds the shingles of texts keyed by ID, safe for concurrent use
+type Index struct {
+	mu     sync.RWMutex
+	docs   map[int]map[uint64]struct{}
+	owners map[uint64]map[int]struct{}
+}
+
+// New returns an empty index
+func New() *Index {
+	return &Index{
+		docs:   make(map[int]map[uint64]struct{}),
+		owners: make(map[uint64]map[int]struct{}),
+	}
+}
+
+// Put indexes a text, replacing the previous version of it
+func (ix *Index) Put(id int, text string) {
+	shingles := Shingles(text)
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(id)
+	if len(shingles) == 0 {
+		return
+	}
+	ix.docs[id] = shingles
+	for s := range shingles {
+		if ix.owners[s] == nil {
+			ix.owners[s] = make(map[int]struct{})
+		}
+		ix.owners[s][id] = struct{}{}
+	}
+}
+
+// Remove drops a text from the index
+func (ix *Index) Remove(id int) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(id)
+}
+
+func (ix *Index) remove(id int) {
+	for s := range ix.docs[id] {
+		delete(ix.owners[s], id)
+		if len(ix.owners[s]) == 0 {
+			delete(ix.owners, s)
+		}
+	}
+	delete(ix.docs, id)
+}
+
+// Matches returns the indexed texts, text id left out, whose similarity to
+// text is at least threshold, most similar first
+func (ix *Index) Matches(id int, text string, threshold float64) []Match {
+	shingles := Shingles(text)
+	if len(shingles) == 0 {
+		return nil
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	matches := ix.matches(id, shingles, threshold)
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Similarity != matches[j].Similarity {
+			return matches[i].Similarity > matches[j].Similarity
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	return matches
+}
+
+// matches counts the shingles shared with every other text, giving the
+// size of the intersection of the sets without comparing them one by one
+func (ix *Index) matches(id int, shingles map[uint64]struct{}, threshold float64) []Match {
+	shared := make(map[int]int)
+	for s := range shingles {
+		for other := range ix.owners[s] {
+			if other != id {
+				shared[other]++
+			}
+		}
+	}
+
+	var matches []Match
+	for other, common := range shared {
+		union := len(shingles) + len(ix.docs[other]) - common
+		if similarity := float64(common) / float64(union); similarity >= threshold {
+			matches = append(matches, Match{ID: other, Similarity: similarity})
+		}
+	}
+	return matches
+}
+
+// Clusters groups the indexed texts linked, directly or through other
+// texts, by a similarity of at least threshold. Clusters are ordered by
+// their smallest ID.
+func (ix *Index) Clusters(threshold float64) []Cluster {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	parent := make(map[int]int)
+	var find func(id int) int
+	find = func(id int) int {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+
+	var pairs []Pair
+	for id, shingles := range ix.docs {
+		for _, match := range ix.matches(id, shingles, threshold) {
+			if match.ID < id {
+				continue
+			}
+			pairs = append(pairs, Pair{A: id, B: match.ID, Similarity: match.Similarity})
+			if a, b := find(id), find(match.ID); a != b {
+				parent[max(a, b)] = min(a, b)
+			}
+		}
+	}
+
+	byRoot := make(map[int]*Cluster)
+	for _, pair := range pairs {
+		root := find(pair.A)
+		if byRoot[root] == nil {
+			byRoot[root] = &Cluster{}
+		}
+		byRoot[root].Pairs = append(byRoot[root].Pairs, pair)
+	}
+
+	clusters := make([]Cluster, 0, len(byRoot))
+	for _, cluster := range byRoot {
+		members := make(map[int]bool)
+		for _, pair := range cluster.Pairs {
+			members[pair.A], members[pair.B] = true, true
+		}
+		for id := range members {
+			cluster.IDs = append(cluster.IDs, id)
+		}
+		sort.Ints(cluster.IDs)
+		sort.Slice(cluster.Pairs, func(i, j int) bool {
+			if cluster.Pairs[i].A != cluster.Pairs[j].A {
+				return cluster.Pairs[i].A < cluster.Pairs[j].A
+			}
+			return cluster.Pairs[i].B < cluster.Pairs[j].B
+		})
+		clusters = append(clusters, *cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].IDs[0] < clusters[j].IDs[0] })
+	return clusters
+}
@@ -0,0 +1,72 @@
+package shingle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const yesterday = "Yesterday, all my troubles seemed so far away\nNow it looks as though they're here to stay\nOh, I believe in yesterday"
+
+func TestShingles(t *testing.T) {
+	assert.Nil(t, Shingles("la la la"), "short texts have no shingles")
+	assert.Equal(t, Shingles(yesterday), Shingles("YESTERDAY all my troubles seemed so far away now it looks as though they're here to stay... oh I believe in yesterday!"),
+		"case, punctuation and line breaks do not matter")
+}
+
+func TestMatches(t *testing.T) {
+	ix := New()
+	ix.Put(1, yesterday)
+	ix.Put(2, yesterday+"\nSuddenly, I'm not half the man I used to be")
+	ix.Put(3, "Here comes the sun, and I say it's all right, little darling")
+
+	matches := ix.Matches(0, yesterday, 0.5)
+	require.Len(t, matches, 2)
+	assert.Equal(t, Match{ID: 1, Similarity: 1}, matches[0])
+	assert.Equal(t, 2, matches[1].ID)
+	assert.Less(t, matches[1].Similarity, 1.0)
+
+	assert.Equal(t, []int{2}, ids(ix.Matches(1, yesterday, 0.5)), "the text itself is left out")
+	assert.Empty(t, ix.Matches(0, yesterday, 1.01))
+	assert.Empty(t, ix.Matches(0, "la la la", 0), "short texts are never matched")
+}
+
+func TestPutAndRemove(t *testing.T) {
+	ix := New()
+	ix.Put(1, yesterday)
+	ix.Put(1, "Here comes the sun, and I say it's all right, little darling")
+	assert.Empty(t, ix.Matches(0, yesterday, 0.1), "the replaced version is forgotten")
+
+	ix.Put(2, yesterday)
+	ix.Remove(2)
+	assert.Empty(t, ix.Matches(0, yesterday, 0.1))
+}
+
+func TestClusters(t *testing.T) {
+	sun := "Here comes the sun, and I say it's all right, little darling"
+	ix := New()
+	ix.Put(4, yesterday)
+	ix.Put(1, yesterday+"\nSuddenly, I'm not half the man I used to be")
+	ix.Put(7, "Oh, "+yesterday)
+	ix.Put(2, sun)
+	ix.Put(9, sun+", it's been a long cold lonely winter")
+	ix.Put(5, "Let it be, let it be, let it be, let it be, whisper words of wisdom")
+
+	clusters := ix.Clusters(0.5)
+	require.Len(t, clusters, 2)
+	assert.Equal(t, []int{1, 4, 7}, clusters[0].IDs)
+	assert.Len(t, clusters[0].Pairs, 3)
+	assert.Equal(t, []int{2, 9}, clusters[1].IDs)
+	require.Len(t, clusters[1].Pairs, 1)
+	assert.Equal(t, 2, clusters[1].Pairs[0].A)
+	assert.Equal(t, 9, clusters[1].Pairs[0].B)
+}
+
+func ids(matches []Match) []int {
+	ids := make([]int, len(matches))
+	for i, match := range matches {
+		ids[i] = match.ID
+	}
+	return ids
+}
@@ -0,0 +1,185 @@
+// Package similarity ranks documents by the TF-IDF cosine similarity of
+// their words, with an index held in memory.
+//
+// Documents are a title and a text. Title words weigh titleWeight times as
+// much as words of the text, term frequencies are dampened logarithmically
+// so a chorus repeated ten times does not drown out the verses, and inverse
+// document frequencies are computed at query time so the index stays
+// consistent as documents come and go.
+package similarity
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// titleWeight is how many occurrences in the text a word of the title counts for
+const titleWeight = 3
+
+// Match is a document similar to another one
+type Match struct {
+	ID int
+	// Score is the cosine similarity of the documents, in (0, 1]
+	Score float64
+}
+
+// Index is a TF-IDF index of documents keyed by ID, safe for concurrent use
+type Index struct {
+	mu sync.RWMutex
+	// docs holds the dampened term frequencies of every document
+	docs map[int]map[string]float64
+	// postings lists the documents holding each term
+	postings map[string]map[int]struct{}
+}
+
+// New returns an empty index
+func New() *Index {
+	return &Index{
+		docs:     make(map[int]map[string]float64),
+		postings: make(map[string]map[int]struct{}),
+	}
+}
+
+// Put indexes a document, replacing the previous version of it
+func (ix *Index) Put(id int, title, text string) {
+	terms := termFrequencies(title, text)
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(id)
+	if len(terms) == 0 {
+		return
+	}
+	ix.docs[id] = terms
+	for term := range terms {
+		if ix.postings[term] == nil {
+			ix.postings[term] = make(map[int]struct{})
+		}
+		ix.postings[term][id] = struct{}{}
+	}
+}
+
+// Remove drops a document from the index
+func (ix *Index) Remove(id int) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(id)
+}
+
+func (ix *Index) remove(id int) {
+	for term := range ix.docs[id] {
+		delete(ix.postings[term], id)
+		if len(ix.postings[term]) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+	delete(ix.docs, id)
+}
+
+// Len returns the number of documents indexed
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.docs)
+}
+
+// Similar returns the limit documents most similar to document id, most
+// similar first, leaving out documents sharing no word with it. ok is false
+// when the document is not indexed.
+func (ix *Index) Similar(id, limit int) (matches []Match, ok bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	query, ok := ix.docs[id]
+	if !ok {
+		return nil, false
+	}
+
+	idf := make(map[string]float64, len(query))
+	candidates := make(map[int]struct{})
+	for term := range query {
+		idf[term] = ix.idf(term)
+		for other := range ix.postings[term] {
+			if other != id {
+				candidates[other] = struct{}{}
+			}
+		}
+	}
+	queryNorm := ix.norm(query)
+
+	matches = make([]Match, 0, len(candidates))
+	for other := range candidates {
+		doc := ix.docs[other]
+		dot := 0.0
+		for term, tf := range query {
+			if otherTF, ok := doc[term]; ok {
+				dot += tf * otherTF * idf[term] * idf[term]
+			}
+		}
+		// Scores are rounded so sums taken in map order rank ties alike
+		if score := math.Round(dot/(queryNorm*ix.norm(doc))*1e6) / 1e6; score > 0 {
+			matches = append(matches, Match{ID: other, Score: math.Min(score, 1)})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches, true
+}
+
+// idf is the smoothed inverse document frequency of a term
+func (ix *Index) idf(term string) float64 {
+	return math.Log(float64(1+len(ix.docs))/float64(1+len(ix.postings[term]))) + 1
+}
+
+// norm is the euclidean norm of the TF-IDF vector of a document
+func (ix *Index) norm(doc map[string]float64) float64 {
+	sum := 0.0
+	for term, tf := range doc {
+		weight := tf * ix.idf(term)
+		sum += weight * weight
+	}
+	return math.Sqrt(sum)
+}
+
+// termFrequencies counts the words of a document, title words weighted
+// titleWeight times, dampened as 1+log(count)
+func termFrequencies(title, text string) map[string]float64 {
+	counts := make(map[string]float64)
+	for _, word := range words(title) {
+		counts[word] += titleWeight
+	}
+	for _, word := range words(text) {
+		counts[word]++
+	}
+	for term, count := range counts {
+		counts[term] = 1 + math.Log(count)
+	}
+	return counts
+}
+
+// words returns the lowercased words of text, runs of letters and digits
+// of two characters or more
+func words(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+	words := fields[:0]
+	for _, field := range fields {
+		if utf8.RuneCountInString(field) > 1 {
+			words = append(words, field)
+		}
+	}
+	return words
+}
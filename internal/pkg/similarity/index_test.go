@@ -0,0 +1,59 @@
+package similarity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimilar_RanksByCommonWords(t *testing.T) {
+	ix := New()
+	ix.Put(1, "Yellow Submarine", "In the town where I was born lived a man who sailed to sea. We all live in a yellow submarine")
+	ix.Put(2, "Yellow Submarine (Remix)", "We all live in a yellow submarine, yellow submarine, yellow submarine")
+	ix.Put(3, "Octopus's Garden", "I'd like to be under the sea in an octopus's garden in the shade")
+	ix.Put(4, "Help!", "Help, I need somebody, not just anybody")
+
+	matches, ok := ix.Similar(1, 10)
+	require.True(t, ok)
+	require.Len(t, matches, 2, "songs sharing no word are left out")
+	assert.Equal(t, 2, matches[0].ID)
+	assert.Equal(t, 3, matches[1].ID)
+	assert.Greater(t, matches[0].Score, matches[1].Score)
+	assert.LessOrEqual(t, matches[0].Score, 1.0)
+}
+
+func TestSimilar_Limit(t *testing.T) {
+	ix := New()
+	for id := 1; id <= 5; id++ {
+		ix.Put(id, "Love song", "love love me do")
+	}
+
+	matches, ok := ix.Similar(1, 3)
+	require.True(t, ok)
+	assert.Equal(t, []int{2, 3, 4}, ids(matches), "ties are broken by ID")
+	assert.InDelta(t, 1.0, matches[0].Score, 1e-9)
+}
+
+func TestPutAndRemove(t *testing.T) {
+	ix := New()
+	ix.Put(1, "Let It Be", "let it be, let it be")
+	ix.Put(2, "Let It Go", "let it go")
+	ix.Put(2, "Hey Jude", "hey jude, don't make me sad")
+
+	matches, _ := ix.Similar(1, 10)
+	assert.Empty(t, matches, "the replaced version is forgotten")
+
+	ix.Remove(1)
+	assert.Equal(t, 1, ix.Len())
+	_, ok := ix.Similar(1, 10)
+	assert.False(t, ok)
+}
+
+func ids(matches []Match) []int {
+	ids := make([]int, len(matches))
+	for i, match := range matches {
+		ids[i] = match.ID
+	}
+	return ids
+}
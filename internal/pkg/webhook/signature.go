@@ -0,0 +1,63 @@
+// Package webhook signs the requests of outgoing webhooks so receivers can
+// tell they were sent by the holder of the shared secret and not replayed.
+//
+// The signature, sent in the Webhook-Signature header, is "sha256=" followed
+// by the hex HMAC-SHA256, keyed with the secret, of the Unix time sent in the
+// Webhook-Timestamp header, a dot and the request body.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// SignatureHeader holds the signature of a request
+	SignatureHeader = "Webhook-Signature"
+	// TimestampHeader holds the Unix time a request was signed at
+	TimestampHeader = "Webhook-Timestamp"
+	// Tolerance is how far from the receiver's clock a signed time may be
+	Tolerance = 5 * time.Minute
+)
+
+var (
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+	ErrExpired          = errors.New("webhook: timestamp outside the tolerance")
+)
+
+// Sign returns the signature of body sent at timestamp, in Unix seconds
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest sets the signature headers of a request with body sent now
+func SignRequest(req *http.Request, secret string, body []byte, now time.Time) {
+	timestamp := now.Unix()
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, body))
+}
+
+// Verify checks the signature headers of a request with body received now
+func Verify(secret string, header http.Header, body []byte, now time.Time) error {
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	expected := Sign(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(header.Get(SignatureHeader))) {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(timestamp, 0)); d > Tolerance || d < -Tolerance {
+		return ErrExpired
+	}
+	return nil
+}
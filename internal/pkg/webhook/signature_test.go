@@ -0,0 +1,27 @@
+package webhook
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1714564800.{"id":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=113442f3b59eb48dd6b82d35b084174a901ce83a6a908384e7069ea6983ee3c6", Sign("secret", 1714564800, []byte(`{"id":1}`)))
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1714564800, 0)
+	body := []byte(`{"id":1}`)
+	req, _ := http.NewRequest(http.MethodPost, "http://example.com", nil)
+	SignRequest(req, "secret", body, now)
+
+	assert.NoError(t, Verify("secret", req.Header, body, now.Add(time.Minute)))
+	assert.ErrorIs(t, Verify("other", req.Header, body, now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("secret", req.Header, []byte(`{"id":2}`), now), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify("secret", req.Header, body, now.Add(time.Hour)), ErrExpired)
+	assert.ErrorIs(t, Verify("secret", http.Header{}, body, now), ErrInvalidSignature)
+}